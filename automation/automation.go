@@ -0,0 +1,238 @@
+// Package automation describes board automation rules: a trigger, conditions
+// on the task and the actions to run when they match.
+package automation
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Trigger is the kind of board event a rule reacts to.
+type Trigger string
+
+const (
+	// Created fires when a task is created.
+	Created Trigger = "created"
+	// Moved fires when a task enters a column; the rule's argument optionally
+	// names the column.
+	Moved Trigger = "moved"
+	// Tagged fires when a task gets a tag; the argument is "key" or "key:value".
+	Tagged Trigger = "tagged"
+	// DuePassed fires once a task's due date is over and it isn't done.
+	DuePassed Trigger = "due_passed"
+	// Stuck fires once a task has stayed in its column past the column's
+	// stale threshold; the argument optionally names the column.
+	Stuck Trigger = "stuck"
+)
+
+// Triggers lists every trigger in form order.
+var Triggers = []Trigger{Created, Moved, Tagged, DuePassed, Stuck}
+
+// Event is something that happened to a task.
+type Event struct {
+	Trigger Trigger
+	TaskID  int
+	Column  string // the column entered, for Moved, or stuck in, for Stuck
+	Tag     string // "key:value", for Tagged
+}
+
+// Task is the state of a task that conditions are checked against.
+type Task struct {
+	Column      string
+	Assignee    string
+	Priority    string
+	Title       string
+	Description string
+	Tags        []string // "key:value" pairs
+	Fields      map[string]string
+}
+
+// Condition operators.
+const (
+	OpEquals    = "="
+	OpNotEquals = "!="
+	OpContains  = "~"
+	OpHas       = "has"
+	OpLacks     = "lacks"
+)
+
+// Condition compares a task attribute with a value, e.g. "priority = high",
+// "title ~ outage" or "tag has bug:critical". Attributes are column, assignee,
+// priority, title, description, tag and field.<key>.
+type Condition struct {
+	Attr  string
+	Op    string
+	Value string
+}
+
+// ParseCondition parses a condition line.
+func ParseCondition(line string) (Condition, error) {
+	parts := strings.SplitN(strings.TrimSpace(line), " ", 3)
+	if len(parts) < 2 {
+		return Condition{}, fmt.Errorf("condition %q should look like \"priority = high\"", line)
+	}
+	c := Condition{Attr: parts[0], Op: parts[1]}
+	if len(parts) == 3 {
+		c.Value = strings.TrimSpace(parts[2])
+	}
+
+	switch {
+	case c.Attr == "tag":
+		if c.Op != OpHas && c.Op != OpLacks {
+			return Condition{}, fmt.Errorf("tag conditions use %q or %q", OpHas, OpLacks)
+		}
+		if c.Value == "" {
+			return Condition{}, fmt.Errorf("condition %q needs a tag", line)
+		}
+	case c.Attr == "column", c.Attr == "assignee", c.Attr == "priority", c.Attr == "title", c.Attr == "description",
+		strings.HasPrefix(c.Attr, "field.") && len(c.Attr) > len("field."):
+		if c.Op != OpEquals && c.Op != OpNotEquals && c.Op != OpContains {
+			return Condition{}, fmt.Errorf("%s conditions use %q, %q or %q", c.Attr, OpEquals, OpNotEquals, OpContains)
+		}
+	default:
+		return Condition{}, fmt.Errorf("unknown attribute %q", c.Attr)
+	}
+	return c, nil
+}
+
+func (c Condition) String() string {
+	return strings.TrimSpace(c.Attr + " " + c.Op + " " + c.Value)
+}
+
+// Eval reports whether the task meets the condition.
+func (c Condition) Eval(t Task) bool {
+	if c.Attr == "tag" {
+		has := hasTag(t.Tags, c.Value)
+		return has == (c.Op == OpHas)
+	}
+
+	var actual string
+	switch c.Attr {
+	case "column":
+		actual = t.Column
+	case "assignee":
+		actual = t.Assignee
+	case "priority":
+		actual = t.Priority
+	case "title":
+		actual = t.Title
+	case "description":
+		actual = t.Description
+	default:
+		actual = t.Fields[strings.TrimPrefix(c.Attr, "field.")]
+	}
+	switch c.Op {
+	case OpEquals:
+		return actual == c.Value
+	case OpNotEquals:
+		return actual != c.Value
+	case OpContains:
+		return strings.Contains(strings.ToLower(actual), strings.ToLower(c.Value))
+	}
+	return false
+}
+
+// hasTag matches "key" against any value of the key and "key:value" exactly.
+func hasTag(tags []string, pattern string) bool {
+	for _, tag := range tags {
+		if tag == pattern {
+			return true
+		}
+		if !strings.Contains(pattern, ":") && strings.HasPrefix(tag, pattern+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// Action kinds.
+const (
+	ActionMove    = "move"    // move <column> [top|bottom]
+	ActionAssign  = "assign"  // assign <name>, or "assign" alone to unassign
+	ActionTag     = "tag"     // tag <key:value>
+	ActionComment = "comment" // comment <text>
+	ActionWebhook = "webhook" // webhook <url>
+)
+
+// Action is one step a rule performs, e.g. "assign peter".
+type Action struct {
+	Kind string
+	Arg  string
+}
+
+// ParseAction parses an action line.
+func ParseAction(line string) (Action, error) {
+	kind, arg, _ := strings.Cut(strings.TrimSpace(line), " ")
+	a := Action{Kind: kind, Arg: strings.TrimSpace(arg)}
+	switch a.Kind {
+	case ActionAssign:
+	case ActionMove:
+		column, where, _ := strings.Cut(a.Arg, " ")
+		if column == "" {
+			return Action{}, fmt.Errorf("%q needs a column, e.g. \"move backlog top\"", line)
+		}
+		if where != "" && where != "top" && where != "bottom" {
+			return Action{}, fmt.Errorf("%q: position must be top or bottom", line)
+		}
+	case ActionTag:
+		key, value, ok := strings.Cut(a.Arg, ":")
+		if !ok || key == "" || value == "" {
+			return Action{}, fmt.Errorf("%q needs a key:value tag", line)
+		}
+	case ActionComment:
+		if a.Arg == "" {
+			return Action{}, fmt.Errorf("%q needs some text", line)
+		}
+	case ActionWebhook:
+		u, err := url.Parse(a.Arg)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return Action{}, fmt.Errorf("%q needs an http(s) URL", line)
+		}
+	default:
+		return Action{}, fmt.Errorf("unknown action %q", kind)
+	}
+	return a, nil
+}
+
+func (a Action) String() string {
+	return strings.TrimSpace(a.Kind + " " + a.Arg)
+}
+
+// MoveTarget splits a move action's argument into the column and whether the
+// task goes to the top of it.
+func (a Action) MoveTarget() (column string, top bool) {
+	column, where, _ := strings.Cut(a.Arg, " ")
+	return column, where == "top"
+}
+
+// Rule runs its actions when its trigger fires and every condition holds.
+type Rule struct {
+	Trigger    Trigger
+	TriggerArg string
+	Conditions []Condition
+	Actions    []Action
+}
+
+// Matches reports whether the rule should run for an event on a task.
+func (r Rule) Matches(ev Event, t Task) bool {
+	if ev.Trigger != r.Trigger {
+		return false
+	}
+	switch r.Trigger {
+	case Moved, Stuck:
+		if r.TriggerArg != "" && r.TriggerArg != ev.Column {
+			return false
+		}
+	case Tagged:
+		if r.TriggerArg != "" && !hasTag([]string{ev.Tag}, r.TriggerArg) {
+			return false
+		}
+	}
+	for _, c := range r.Conditions {
+		if !c.Eval(t) {
+			return false
+		}
+	}
+	return true
+}
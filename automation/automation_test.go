@@ -0,0 +1,80 @@
+package automation
+
+import "testing"
+
+func TestRuleMatches(t *testing.T) {
+	mustCondition := func(s string) Condition {
+		c, err := ParseCondition(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	rule := Rule{
+		Trigger:    Tagged,
+		TriggerArg: "bug:critical",
+		Conditions: []Condition{mustCondition("assignee != peter"), mustCondition("tag lacks triaged")},
+	}
+	task := Task{Column: "review", Assignee: "john", Tags: []string{"bug:critical"}}
+
+	if !rule.Matches(Event{Trigger: Tagged, Tag: "bug:critical"}, task) {
+		t.Error("rule should match a critical bug assigned to john")
+	}
+	if rule.Matches(Event{Trigger: Tagged, Tag: "bug:minor"}, task) {
+		t.Error("rule should ignore other tag values")
+	}
+	if rule.Matches(Event{Trigger: Created}, task) {
+		t.Error("rule should ignore other triggers")
+	}
+	task.Tags = append(task.Tags, "triaged:yes")
+	if rule.Matches(Event{Trigger: Tagged, Tag: "bug:critical"}, task) {
+		t.Error("tag lacks triaged should fail once the task is triaged")
+	}
+
+	moved := Rule{Trigger: Moved, TriggerArg: "done"}
+	if !moved.Matches(Event{Trigger: Moved, Column: "done"}, task) || moved.Matches(Event{Trigger: Moved, Column: "review"}, task) {
+		t.Error("moved rule should only match its column")
+	}
+	stuck := Rule{Trigger: Stuck, TriggerArg: "review"}
+	if !stuck.Matches(Event{Trigger: Stuck, Column: "review"}, task) || stuck.Matches(Event{Trigger: Stuck, Column: "backlog"}, task) {
+		t.Error("stuck rule should only match its column")
+	}
+}
+
+func TestConditionEval(t *testing.T) {
+	task := Task{Title: "Checkout outage", Priority: "high", Fields: map[string]string{"env": "prod"}}
+	cases := map[string]bool{
+		"title ~ OUTAGE":     true,
+		"priority = high":    true,
+		"priority != high":   false,
+		"field.env = prod":   true,
+		"field.team = infra": false,
+		"assignee =":         true,
+	}
+	for line, want := range cases {
+		c, err := ParseCondition(line)
+		if err != nil {
+			t.Fatalf("ParseCondition(%q): %v", line, err)
+		}
+		if got := c.Eval(task); got != want {
+			t.Errorf("%q = %v, want %v", line, got, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, bad := range []string{"tag = bug", "colour = red", "priority", "title > x"} {
+		if _, err := ParseCondition(bad); err == nil {
+			t.Errorf("ParseCondition(%q) accepted", bad)
+		}
+	}
+	for _, bad := range []string{"move", "move done middle", "tag bug", "comment", "webhook ftp://x", "explode"} {
+		if _, err := ParseAction(bad); err == nil {
+			t.Errorf("ParseAction(%q) accepted", bad)
+		}
+	}
+	a, err := ParseAction("move backlog top")
+	if column, top := a.MoveTarget(); err != nil || column != "backlog" || !top {
+		t.Errorf("move backlog top = %v %v %v", column, top, err)
+	}
+}
@@ -0,0 +1,144 @@
+// Package customfield defines board-level typed fields and validates the
+// values tasks store for them.
+package customfield
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Type is the kind of value a field holds.
+type Type string
+
+const (
+	Text    Type = "text"
+	Number  Type = "number"
+	Enum    Type = "enum"
+	Date    Type = "date"
+	URL     Type = "url"
+	Boolean Type = "boolean"
+)
+
+// Types lists every field type in display order.
+var Types = []Type{Text, Number, Enum, Date, URL, Boolean}
+
+// DateLayout is the stored format of date values.
+const DateLayout = "2006-01-02"
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// reserved keys would be ambiguous with built-in task fields in diffs and filters.
+var reserved = map[string]bool{
+	"title": true, "description": true, "column": true, "assignee": true,
+	"priority": true, "due": true, "tags": true, "position": true,
+}
+
+// Definition is a board-defined field.
+type Definition struct {
+	Key      string
+	Label    string
+	Type     Type
+	Required bool
+	Default  string
+	Options  []string // allowed values for Enum fields
+}
+
+// Check reports whether the definition itself is usable.
+func (d Definition) Check() error {
+	if !keyPattern.MatchString(d.Key) {
+		return fmt.Errorf("key %q must be lowercase letters, digits and underscores", d.Key)
+	}
+	if reserved[d.Key] {
+		return fmt.Errorf("key %q is reserved", d.Key)
+	}
+	switch d.Type {
+	case Text, Number, Date, URL, Boolean:
+	case Enum:
+		if len(d.Options) == 0 {
+			return fmt.Errorf("enum field %q needs at least one option", d.Key)
+		}
+	default:
+		return fmt.Errorf("unknown field type %q", d.Type)
+	}
+	if d.Default != "" {
+		if _, err := d.Normalize(d.Default); err != nil {
+			return fmt.Errorf("default: %w", err)
+		}
+	}
+	return nil
+}
+
+// Normalize validates a raw value and returns its canonical stored form. An
+// empty value is returned unchanged; required checks happen in Validate.
+func (d Definition) Normalize(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	switch d.Type {
+	case Number:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a number", d.Label)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case Enum:
+		for _, option := range d.Options {
+			if strings.EqualFold(option, raw) {
+				return option, nil
+			}
+		}
+		return "", fmt.Errorf("%s must be one of %s", d.Label, strings.Join(d.Options, ", "))
+	case Date:
+		t, err := time.Parse(DateLayout, raw)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a date (YYYY-MM-DD)", d.Label)
+		}
+		return t.Format(DateLayout), nil
+	case URL:
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", fmt.Errorf("%s must be an http(s) URL", d.Label)
+		}
+		return raw, nil
+	case Boolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return "", fmt.Errorf("%s must be true or false", d.Label)
+		}
+		return strconv.FormatBool(b), nil
+	}
+	return raw, nil
+}
+
+// Validate normalizes submitted values against the definitions. Values for
+// unknown keys are dropped. With applyDefaults, empty fields take their
+// default first (used when creating a task). The returned errors are keyed by
+// field and empty when everything is valid.
+func Validate(defs []Definition, raw map[string]string, applyDefaults bool) (map[string]string, map[string]string) {
+	values := make(map[string]string)
+	errs := make(map[string]string)
+	for _, d := range defs {
+		v := raw[d.Key]
+		if strings.TrimSpace(v) == "" && applyDefaults {
+			v = d.Default
+		}
+		normalized, err := d.Normalize(v)
+		if err != nil {
+			errs[d.Key] = err.Error()
+			continue
+		}
+		if normalized == "" {
+			if d.Required {
+				errs[d.Key] = d.Label + " is required"
+			}
+			continue
+		}
+		values[d.Key] = normalized
+	}
+	return values, errs
+}
@@ -0,0 +1,55 @@
+package customfield
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	defs := []Definition{
+		{Key: "estimate", Label: "Estimate", Type: Number, Required: true},
+		{Key: "env", Label: "Environment", Type: Enum, Options: []string{"staging", "prod"}, Default: "staging"},
+		{Key: "pr", Label: "PR", Type: URL},
+		{Key: "flag", Label: "Flag", Type: Boolean},
+	}
+
+	values, errs := Validate(defs, map[string]string{"estimate": "3.50", "flag": "false", "stray": "x"}, true)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	want := map[string]string{"estimate": "3.5", "env": "staging", "flag": "false"}
+	if len(values) != len(want) {
+		t.Fatalf("values = %v, want %v", values, want)
+	}
+	for k, v := range want {
+		if values[k] != v {
+			t.Errorf("values[%q] = %q, want %q", k, values[k], v)
+		}
+	}
+
+	_, errs = Validate(defs, map[string]string{"env": "Prod", "pr": "not a url"}, false)
+	if errs["estimate"] == "" {
+		t.Error("missing required estimate was accepted")
+	}
+	if errs["pr"] == "" {
+		t.Error("invalid URL was accepted")
+	}
+	if _, ok := errs["env"]; ok {
+		t.Errorf("enum match should be case-insensitive: %v", errs["env"])
+	}
+}
+
+func TestCheck(t *testing.T) {
+	cases := map[string]Definition{
+		"bad key":      {Key: "Estimate", Type: Text},
+		"reserved key": {Key: "column", Type: Text},
+		"empty enum":   {Key: "env", Type: Enum},
+		"bad default":  {Key: "n", Label: "N", Type: Number, Default: "many"},
+		"unknown type": {Key: "x", Type: "color"},
+	}
+	for name, d := range cases {
+		if d.Check() == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if err := (Definition{Key: "pr_url", Label: "PR", Type: URL}).Check(); err != nil {
+		t.Errorf("valid definition rejected: %v", err)
+	}
+}
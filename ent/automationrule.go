@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+)
+
+// AutomationRule is the model entity for the AutomationRule schema.
+type AutomationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger automationrule.Trigger `json:"trigger,omitempty"`
+	// TriggerArg holds the value of the "trigger_arg" field.
+	TriggerArg string `json:"trigger_arg,omitempty"`
+	// Conditions holds the value of the "conditions" field.
+	Conditions []string `json:"conditions,omitempty"`
+	// Actions holds the value of the "actions" field.
+	Actions []string `json:"actions,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AutomationRuleQuery when eager-loading is set.
+	Edges        AutomationRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AutomationRuleEdges holds the relations/edges for other nodes in the graph.
+type AutomationRuleEdges struct {
+	// Runs holds the value of the runs edge.
+	Runs []*AutomationRun `json:"runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e AutomationRuleEdges) RunsOrErr() ([]*AutomationRun, error) {
+	if e.loadedTypes[0] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AutomationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case automationrule.FieldConditions, automationrule.FieldActions:
+			values[i] = new([]byte)
+		case automationrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case automationrule.FieldID:
+			values[i] = new(sql.NullInt64)
+		case automationrule.FieldName, automationrule.FieldTrigger, automationrule.FieldTriggerArg:
+			values[i] = new(sql.NullString)
+		case automationrule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AutomationRule fields.
+func (_m *AutomationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case automationrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case automationrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case automationrule.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = automationrule.Trigger(value.String)
+			}
+		case automationrule.FieldTriggerArg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_arg", values[i])
+			} else if value.Valid {
+				_m.TriggerArg = value.String
+			}
+		case automationrule.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
+		case automationrule.FieldActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Actions); err != nil {
+					return fmt.Errorf("unmarshal field actions: %w", err)
+				}
+			}
+		case automationrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case automationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AutomationRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *AutomationRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRuns queries the "runs" edge of the AutomationRule entity.
+func (_m *AutomationRule) QueryRuns() *AutomationRunQuery {
+	return NewAutomationRuleClient(_m.config).QueryRuns(_m)
+}
+
+// Update returns a builder for updating this AutomationRule.
+// Note that you need to call AutomationRule.Unwrap() before calling this method if this AutomationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AutomationRule) Update() *AutomationRuleUpdateOne {
+	return NewAutomationRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AutomationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AutomationRule) Unwrap() *AutomationRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AutomationRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AutomationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AutomationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Trigger))
+	builder.WriteString(", ")
+	builder.WriteString("trigger_arg=")
+	builder.WriteString(_m.TriggerArg)
+	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Conditions))
+	builder.WriteString(", ")
+	builder.WriteString("actions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Actions))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AutomationRules is a parsable slice of AutomationRule.
+type AutomationRules []*AutomationRule
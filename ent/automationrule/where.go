@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package automationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldName, v))
+}
+
+// TriggerArg applies equality check predicate on the "trigger_arg" field. It's identical to TriggerArgEQ.
+func TriggerArg(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldTriggerArg, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerArgEQ applies the EQ predicate on the "trigger_arg" field.
+func TriggerArgEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldTriggerArg, v))
+}
+
+// TriggerArgNEQ applies the NEQ predicate on the "trigger_arg" field.
+func TriggerArgNEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldTriggerArg, v))
+}
+
+// TriggerArgIn applies the In predicate on the "trigger_arg" field.
+func TriggerArgIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldTriggerArg, vs...))
+}
+
+// TriggerArgNotIn applies the NotIn predicate on the "trigger_arg" field.
+func TriggerArgNotIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldTriggerArg, vs...))
+}
+
+// TriggerArgGT applies the GT predicate on the "trigger_arg" field.
+func TriggerArgGT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldTriggerArg, v))
+}
+
+// TriggerArgGTE applies the GTE predicate on the "trigger_arg" field.
+func TriggerArgGTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldTriggerArg, v))
+}
+
+// TriggerArgLT applies the LT predicate on the "trigger_arg" field.
+func TriggerArgLT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldTriggerArg, v))
+}
+
+// TriggerArgLTE applies the LTE predicate on the "trigger_arg" field.
+func TriggerArgLTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldTriggerArg, v))
+}
+
+// TriggerArgContains applies the Contains predicate on the "trigger_arg" field.
+func TriggerArgContains(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContains(FieldTriggerArg, v))
+}
+
+// TriggerArgHasPrefix applies the HasPrefix predicate on the "trigger_arg" field.
+func TriggerArgHasPrefix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasPrefix(FieldTriggerArg, v))
+}
+
+// TriggerArgHasSuffix applies the HasSuffix predicate on the "trigger_arg" field.
+func TriggerArgHasSuffix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasSuffix(FieldTriggerArg, v))
+}
+
+// TriggerArgEqualFold applies the EqualFold predicate on the "trigger_arg" field.
+func TriggerArgEqualFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEqualFold(FieldTriggerArg, v))
+}
+
+// TriggerArgContainsFold applies the ContainsFold predicate on the "trigger_arg" field.
+func TriggerArgContainsFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContainsFold(FieldTriggerArg, v))
+}
+
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIsNull(FieldConditions))
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotNull(FieldConditions))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.AutomationRule {
+	return predicate.AutomationRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.AutomationRun) predicate.AutomationRule {
+	return predicate.AutomationRule(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AutomationRule) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AutomationRule) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AutomationRule) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.NotPredicates(p))
+}
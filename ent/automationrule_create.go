@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+)
+
+// AutomationRuleCreate is the builder for creating a AutomationRule entity.
+type AutomationRuleCreate struct {
+	config
+	mutation *AutomationRuleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *AutomationRuleCreate) SetName(v string) *AutomationRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *AutomationRuleCreate) SetTrigger(v automationrule.Trigger) *AutomationRuleCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetTriggerArg sets the "trigger_arg" field.
+func (_c *AutomationRuleCreate) SetTriggerArg(v string) *AutomationRuleCreate {
+	_c.mutation.SetTriggerArg(v)
+	return _c
+}
+
+// SetNillableTriggerArg sets the "trigger_arg" field if the given value is not nil.
+func (_c *AutomationRuleCreate) SetNillableTriggerArg(v *string) *AutomationRuleCreate {
+	if v != nil {
+		_c.SetTriggerArg(*v)
+	}
+	return _c
+}
+
+// SetConditions sets the "conditions" field.
+func (_c *AutomationRuleCreate) SetConditions(v []string) *AutomationRuleCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
+// SetActions sets the "actions" field.
+func (_c *AutomationRuleCreate) SetActions(v []string) *AutomationRuleCreate {
+	_c.mutation.SetActions(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AutomationRuleCreate) SetEnabled(v bool) *AutomationRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AutomationRuleCreate) SetNillableEnabled(v *bool) *AutomationRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AutomationRuleCreate) SetCreatedAt(v time.Time) *AutomationRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AutomationRuleCreate) SetNillableCreatedAt(v *time.Time) *AutomationRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// AddRunIDs adds the "runs" edge to the AutomationRun entity by IDs.
+func (_c *AutomationRuleCreate) AddRunIDs(ids ...int) *AutomationRuleCreate {
+	_c.mutation.AddRunIDs(ids...)
+	return _c
+}
+
+// AddRuns adds the "runs" edges to the AutomationRun entity.
+func (_c *AutomationRuleCreate) AddRuns(v ...*AutomationRun) *AutomationRuleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRunIDs(ids...)
+}
+
+// Mutation returns the AutomationRuleMutation object of the builder.
+func (_c *AutomationRuleCreate) Mutation() *AutomationRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the AutomationRule in the database.
+func (_c *AutomationRuleCreate) Save(ctx context.Context) (*AutomationRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AutomationRuleCreate) SaveX(ctx context.Context) *AutomationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutomationRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutomationRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AutomationRuleCreate) defaults() {
+	if _, ok := _c.mutation.TriggerArg(); !ok {
+		v := automationrule.DefaultTriggerArg
+		_c.mutation.SetTriggerArg(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := automationrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := automationrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AutomationRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AutomationRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := automationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AutomationRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "AutomationRule.trigger"`)}
+	}
+	if v, ok := _c.mutation.Trigger(); ok {
+		if err := automationrule.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "AutomationRule.trigger": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TriggerArg(); !ok {
+		return &ValidationError{Name: "trigger_arg", err: errors.New(`ent: missing required field "AutomationRule.trigger_arg"`)}
+	}
+	if _, ok := _c.mutation.Actions(); !ok {
+		return &ValidationError{Name: "actions", err: errors.New(`ent: missing required field "AutomationRule.actions"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AutomationRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AutomationRule.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AutomationRuleCreate) sqlSave(ctx context.Context) (*AutomationRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AutomationRuleCreate) createSpec() (*AutomationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutomationRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(automationrule.Table, sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(automationrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(automationrule.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.TriggerArg(); ok {
+		_spec.SetField(automationrule.FieldTriggerArg, field.TypeString, value)
+		_node.TriggerArg = value
+	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(automationrule.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
+	if value, ok := _c.mutation.Actions(); ok {
+		_spec.SetField(automationrule.FieldActions, field.TypeJSON, value)
+		_node.Actions = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(automationrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(automationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AutomationRuleCreateBulk is the builder for creating many AutomationRule entities in bulk.
+type AutomationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AutomationRuleCreate
+}
+
+// Save creates the AutomationRule entities in the database.
+func (_c *AutomationRuleCreateBulk) Save(ctx context.Context) ([]*AutomationRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AutomationRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutomationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AutomationRuleCreateBulk) SaveX(ctx context.Context) []*AutomationRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutomationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutomationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
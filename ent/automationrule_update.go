@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// AutomationRuleUpdate is the builder for updating AutomationRule entities.
+type AutomationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AutomationRuleMutation
+}
+
+// Where appends a list predicates to the AutomationRuleUpdate builder.
+func (_u *AutomationRuleUpdate) Where(ps ...predicate.AutomationRule) *AutomationRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AutomationRuleUpdate) SetName(v string) *AutomationRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AutomationRuleUpdate) SetNillableName(v *string) *AutomationRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *AutomationRuleUpdate) SetTrigger(v automationrule.Trigger) *AutomationRuleUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *AutomationRuleUpdate) SetNillableTrigger(v *automationrule.Trigger) *AutomationRuleUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetTriggerArg sets the "trigger_arg" field.
+func (_u *AutomationRuleUpdate) SetTriggerArg(v string) *AutomationRuleUpdate {
+	_u.mutation.SetTriggerArg(v)
+	return _u
+}
+
+// SetNillableTriggerArg sets the "trigger_arg" field if the given value is not nil.
+func (_u *AutomationRuleUpdate) SetNillableTriggerArg(v *string) *AutomationRuleUpdate {
+	if v != nil {
+		_u.SetTriggerArg(*v)
+	}
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *AutomationRuleUpdate) SetConditions(v []string) *AutomationRuleUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// AppendConditions appends value to the "conditions" field.
+func (_u *AutomationRuleUpdate) AppendConditions(v []string) *AutomationRuleUpdate {
+	_u.mutation.AppendConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *AutomationRuleUpdate) ClearConditions() *AutomationRuleUpdate {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// SetActions sets the "actions" field.
+func (_u *AutomationRuleUpdate) SetActions(v []string) *AutomationRuleUpdate {
+	_u.mutation.SetActions(v)
+	return _u
+}
+
+// AppendActions appends value to the "actions" field.
+func (_u *AutomationRuleUpdate) AppendActions(v []string) *AutomationRuleUpdate {
+	_u.mutation.AppendActions(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AutomationRuleUpdate) SetEnabled(v bool) *AutomationRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AutomationRuleUpdate) SetNillableEnabled(v *bool) *AutomationRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddRunIDs adds the "runs" edge to the AutomationRun entity by IDs.
+func (_u *AutomationRuleUpdate) AddRunIDs(ids ...int) *AutomationRuleUpdate {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the AutomationRun entity.
+func (_u *AutomationRuleUpdate) AddRuns(v ...*AutomationRun) *AutomationRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// Mutation returns the AutomationRuleMutation object of the builder.
+func (_u *AutomationRuleUpdate) Mutation() *AutomationRuleMutation {
+	return _u.mutation
+}
+
+// ClearRuns clears all "runs" edges to the AutomationRun entity.
+func (_u *AutomationRuleUpdate) ClearRuns() *AutomationRuleUpdate {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to AutomationRun entities by IDs.
+func (_u *AutomationRuleUpdate) RemoveRunIDs(ids ...int) *AutomationRuleUpdate {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to AutomationRun entities.
+func (_u *AutomationRuleUpdate) RemoveRuns(v ...*AutomationRun) *AutomationRuleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AutomationRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutomationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AutomationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutomationRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AutomationRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := automationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AutomationRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := automationrule.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "AutomationRule.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AutomationRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(automationrule.Table, automationrule.Columns, sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(automationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(automationrule.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TriggerArg(); ok {
+		_spec.SetField(automationrule.FieldTriggerArg, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(automationrule.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConditions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automationrule.FieldConditions, value)
+		})
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(automationrule.FieldConditions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Actions(); ok {
+		_spec.SetField(automationrule.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automationrule.FieldActions, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(automationrule.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AutomationRuleUpdateOne is the builder for updating a single AutomationRule entity.
+type AutomationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AutomationRuleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *AutomationRuleUpdateOne) SetName(v string) *AutomationRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AutomationRuleUpdateOne) SetNillableName(v *string) *AutomationRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *AutomationRuleUpdateOne) SetTrigger(v automationrule.Trigger) *AutomationRuleUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *AutomationRuleUpdateOne) SetNillableTrigger(v *automationrule.Trigger) *AutomationRuleUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetTriggerArg sets the "trigger_arg" field.
+func (_u *AutomationRuleUpdateOne) SetTriggerArg(v string) *AutomationRuleUpdateOne {
+	_u.mutation.SetTriggerArg(v)
+	return _u
+}
+
+// SetNillableTriggerArg sets the "trigger_arg" field if the given value is not nil.
+func (_u *AutomationRuleUpdateOne) SetNillableTriggerArg(v *string) *AutomationRuleUpdateOne {
+	if v != nil {
+		_u.SetTriggerArg(*v)
+	}
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *AutomationRuleUpdateOne) SetConditions(v []string) *AutomationRuleUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// AppendConditions appends value to the "conditions" field.
+func (_u *AutomationRuleUpdateOne) AppendConditions(v []string) *AutomationRuleUpdateOne {
+	_u.mutation.AppendConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *AutomationRuleUpdateOne) ClearConditions() *AutomationRuleUpdateOne {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// SetActions sets the "actions" field.
+func (_u *AutomationRuleUpdateOne) SetActions(v []string) *AutomationRuleUpdateOne {
+	_u.mutation.SetActions(v)
+	return _u
+}
+
+// AppendActions appends value to the "actions" field.
+func (_u *AutomationRuleUpdateOne) AppendActions(v []string) *AutomationRuleUpdateOne {
+	_u.mutation.AppendActions(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AutomationRuleUpdateOne) SetEnabled(v bool) *AutomationRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AutomationRuleUpdateOne) SetNillableEnabled(v *bool) *AutomationRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddRunIDs adds the "runs" edge to the AutomationRun entity by IDs.
+func (_u *AutomationRuleUpdateOne) AddRunIDs(ids ...int) *AutomationRuleUpdateOne {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the AutomationRun entity.
+func (_u *AutomationRuleUpdateOne) AddRuns(v ...*AutomationRun) *AutomationRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// Mutation returns the AutomationRuleMutation object of the builder.
+func (_u *AutomationRuleUpdateOne) Mutation() *AutomationRuleMutation {
+	return _u.mutation
+}
+
+// ClearRuns clears all "runs" edges to the AutomationRun entity.
+func (_u *AutomationRuleUpdateOne) ClearRuns() *AutomationRuleUpdateOne {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to AutomationRun entities by IDs.
+func (_u *AutomationRuleUpdateOne) RemoveRunIDs(ids ...int) *AutomationRuleUpdateOne {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to AutomationRun entities.
+func (_u *AutomationRuleUpdateOne) RemoveRuns(v ...*AutomationRun) *AutomationRuleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the AutomationRuleUpdate builder.
+func (_u *AutomationRuleUpdateOne) Where(ps ...predicate.AutomationRule) *AutomationRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AutomationRuleUpdateOne) Select(field string, fields ...string) *AutomationRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AutomationRule entity.
+func (_u *AutomationRuleUpdateOne) Save(ctx context.Context) (*AutomationRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutomationRuleUpdateOne) SaveX(ctx context.Context) *AutomationRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AutomationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutomationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AutomationRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := automationrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AutomationRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := automationrule.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "AutomationRule.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AutomationRuleUpdateOne) sqlSave(ctx context.Context) (_node *AutomationRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(automationrule.Table, automationrule.Columns, sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutomationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, automationrule.FieldID)
+		for _, f := range fields {
+			if !automationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != automationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(automationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(automationrule.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TriggerArg(); ok {
+		_spec.SetField(automationrule.FieldTriggerArg, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(automationrule.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConditions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automationrule.FieldConditions, value)
+		})
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(automationrule.FieldConditions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Actions(); ok {
+		_spec.SetField(automationrule.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automationrule.FieldActions, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(automationrule.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   automationrule.RunsTable,
+			Columns: []string{automationrule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AutomationRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+)
+
+// AutomationRun is the model entity for the AutomationRun schema.
+type AutomationRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int `json:"task_id,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger string `json:"trigger,omitempty"`
+	// Status holds the value of the "status" field.
+	Status automationrun.Status `json:"status,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AutomationRunQuery when eager-loading is set.
+	Edges                AutomationRunEdges `json:"edges"`
+	automation_rule_runs *int
+	selectValues         sql.SelectValues
+}
+
+// AutomationRunEdges holds the relations/edges for other nodes in the graph.
+type AutomationRunEdges struct {
+	// Rule holds the value of the rule edge.
+	Rule *AutomationRule `json:"rule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RuleOrErr returns the Rule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AutomationRunEdges) RuleOrErr() (*AutomationRule, error) {
+	if e.Rule != nil {
+		return e.Rule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: automationrule.Label}
+	}
+	return nil, &NotLoadedError{edge: "rule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AutomationRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case automationrun.FieldID, automationrun.FieldTaskID:
+			values[i] = new(sql.NullInt64)
+		case automationrun.FieldTrigger, automationrun.FieldStatus, automationrun.FieldMessage:
+			values[i] = new(sql.NullString)
+		case automationrun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case automationrun.ForeignKeys[0]: // automation_rule_runs
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AutomationRun fields.
+func (_m *AutomationRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case automationrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case automationrun.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				_m.TaskID = int(value.Int64)
+			}
+		case automationrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case automationrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = automationrun.Status(value.String)
+			}
+		case automationrun.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case automationrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case automationrun.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field automation_rule_runs", value)
+			} else if value.Valid {
+				_m.automation_rule_runs = new(int)
+				*_m.automation_rule_runs = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AutomationRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *AutomationRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRule queries the "rule" edge of the AutomationRun entity.
+func (_m *AutomationRun) QueryRule() *AutomationRuleQuery {
+	return NewAutomationRunClient(_m.config).QueryRule(_m)
+}
+
+// Update returns a builder for updating this AutomationRun.
+// Note that you need to call AutomationRun.Unwrap() before calling this method if this AutomationRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AutomationRun) Update() *AutomationRunUpdateOne {
+	return NewAutomationRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AutomationRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AutomationRun) Unwrap() *AutomationRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AutomationRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AutomationRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("AutomationRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AutomationRuns is a parsable slice of AutomationRun.
+type AutomationRuns []*AutomationRun
@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+)
+
+// AutomationRunCreate is the builder for creating a AutomationRun entity.
+type AutomationRunCreate struct {
+	config
+	mutation *AutomationRunMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *AutomationRunCreate) SetTaskID(v int) *AutomationRunCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *AutomationRunCreate) SetTrigger(v string) *AutomationRunCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AutomationRunCreate) SetStatus(v automationrun.Status) *AutomationRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *AutomationRunCreate) SetMessage(v string) *AutomationRunCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *AutomationRunCreate) SetNillableMessage(v *string) *AutomationRunCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AutomationRunCreate) SetCreatedAt(v time.Time) *AutomationRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AutomationRunCreate) SetNillableCreatedAt(v *time.Time) *AutomationRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRuleID sets the "rule" edge to the AutomationRule entity by ID.
+func (_c *AutomationRunCreate) SetRuleID(id int) *AutomationRunCreate {
+	_c.mutation.SetRuleID(id)
+	return _c
+}
+
+// SetRule sets the "rule" edge to the AutomationRule entity.
+func (_c *AutomationRunCreate) SetRule(v *AutomationRule) *AutomationRunCreate {
+	return _c.SetRuleID(v.ID)
+}
+
+// Mutation returns the AutomationRunMutation object of the builder.
+func (_c *AutomationRunCreate) Mutation() *AutomationRunMutation {
+	return _c.mutation
+}
+
+// Save creates the AutomationRun in the database.
+func (_c *AutomationRunCreate) Save(ctx context.Context) (*AutomationRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AutomationRunCreate) SaveX(ctx context.Context) *AutomationRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutomationRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutomationRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AutomationRunCreate) defaults() {
+	if _, ok := _c.mutation.Message(); !ok {
+		v := automationrun.DefaultMessage
+		_c.mutation.SetMessage(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := automationrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AutomationRunCreate) check() error {
+	if _, ok := _c.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "AutomationRun.task_id"`)}
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "AutomationRun.trigger"`)}
+	}
+	if v, ok := _c.mutation.Trigger(); ok {
+		if err := automationrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "AutomationRun.trigger": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AutomationRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := automationrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AutomationRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "AutomationRun.message"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AutomationRun.created_at"`)}
+	}
+	if len(_c.mutation.RuleIDs()) == 0 {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required edge "AutomationRun.rule"`)}
+	}
+	return nil
+}
+
+func (_c *AutomationRunCreate) sqlSave(ctx context.Context) (*AutomationRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AutomationRunCreate) createSpec() (*AutomationRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutomationRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(automationrun.Table, sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(automationrun.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(automationrun.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(automationrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(automationrun.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(automationrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrun.RuleTable,
+			Columns: []string{automationrun.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.automation_rule_runs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AutomationRunCreateBulk is the builder for creating many AutomationRun entities in bulk.
+type AutomationRunCreateBulk struct {
+	config
+	err      error
+	builders []*AutomationRunCreate
+}
+
+// Save creates the AutomationRun entities in the database.
+func (_c *AutomationRunCreateBulk) Save(ctx context.Context) ([]*AutomationRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AutomationRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutomationRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AutomationRunCreateBulk) SaveX(ctx context.Context) []*AutomationRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AutomationRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AutomationRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// AutomationRunUpdate is the builder for updating AutomationRun entities.
+type AutomationRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AutomationRunMutation
+}
+
+// Where appends a list predicates to the AutomationRunUpdate builder.
+func (_u *AutomationRunUpdate) Where(ps ...predicate.AutomationRun) *AutomationRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *AutomationRunUpdate) SetTaskID(v int) *AutomationRunUpdate {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *AutomationRunUpdate) SetNillableTaskID(v *int) *AutomationRunUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *AutomationRunUpdate) AddTaskID(v int) *AutomationRunUpdate {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *AutomationRunUpdate) SetTrigger(v string) *AutomationRunUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *AutomationRunUpdate) SetNillableTrigger(v *string) *AutomationRunUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AutomationRunUpdate) SetStatus(v automationrun.Status) *AutomationRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AutomationRunUpdate) SetNillableStatus(v *automationrun.Status) *AutomationRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *AutomationRunUpdate) SetMessage(v string) *AutomationRunUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *AutomationRunUpdate) SetNillableMessage(v *string) *AutomationRunUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetRuleID sets the "rule" edge to the AutomationRule entity by ID.
+func (_u *AutomationRunUpdate) SetRuleID(id int) *AutomationRunUpdate {
+	_u.mutation.SetRuleID(id)
+	return _u
+}
+
+// SetRule sets the "rule" edge to the AutomationRule entity.
+func (_u *AutomationRunUpdate) SetRule(v *AutomationRule) *AutomationRunUpdate {
+	return _u.SetRuleID(v.ID)
+}
+
+// Mutation returns the AutomationRunMutation object of the builder.
+func (_u *AutomationRunUpdate) Mutation() *AutomationRunMutation {
+	return _u.mutation
+}
+
+// ClearRule clears the "rule" edge to the AutomationRule entity.
+func (_u *AutomationRunUpdate) ClearRule() *AutomationRunUpdate {
+	_u.mutation.ClearRule()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AutomationRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutomationRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AutomationRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutomationRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AutomationRunUpdate) check() error {
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := automationrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "AutomationRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := automationrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AutomationRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RuleCleared() && len(_u.mutation.RuleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AutomationRun.rule"`)
+	}
+	return nil
+}
+
+func (_u *AutomationRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(automationrun.Table, automationrun.Columns, sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(automationrun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(automationrun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(automationrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(automationrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(automationrun.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrun.RuleTable,
+			Columns: []string{automationrun.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrun.RuleTable,
+			Columns: []string{automationrun.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automationrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AutomationRunUpdateOne is the builder for updating a single AutomationRun entity.
+type AutomationRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AutomationRunMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *AutomationRunUpdateOne) SetTaskID(v int) *AutomationRunUpdateOne {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *AutomationRunUpdateOne) SetNillableTaskID(v *int) *AutomationRunUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *AutomationRunUpdateOne) AddTaskID(v int) *AutomationRunUpdateOne {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *AutomationRunUpdateOne) SetTrigger(v string) *AutomationRunUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *AutomationRunUpdateOne) SetNillableTrigger(v *string) *AutomationRunUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AutomationRunUpdateOne) SetStatus(v automationrun.Status) *AutomationRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AutomationRunUpdateOne) SetNillableStatus(v *automationrun.Status) *AutomationRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *AutomationRunUpdateOne) SetMessage(v string) *AutomationRunUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *AutomationRunUpdateOne) SetNillableMessage(v *string) *AutomationRunUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetRuleID sets the "rule" edge to the AutomationRule entity by ID.
+func (_u *AutomationRunUpdateOne) SetRuleID(id int) *AutomationRunUpdateOne {
+	_u.mutation.SetRuleID(id)
+	return _u
+}
+
+// SetRule sets the "rule" edge to the AutomationRule entity.
+func (_u *AutomationRunUpdateOne) SetRule(v *AutomationRule) *AutomationRunUpdateOne {
+	return _u.SetRuleID(v.ID)
+}
+
+// Mutation returns the AutomationRunMutation object of the builder.
+func (_u *AutomationRunUpdateOne) Mutation() *AutomationRunMutation {
+	return _u.mutation
+}
+
+// ClearRule clears the "rule" edge to the AutomationRule entity.
+func (_u *AutomationRunUpdateOne) ClearRule() *AutomationRunUpdateOne {
+	_u.mutation.ClearRule()
+	return _u
+}
+
+// Where appends a list predicates to the AutomationRunUpdate builder.
+func (_u *AutomationRunUpdateOne) Where(ps ...predicate.AutomationRun) *AutomationRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AutomationRunUpdateOne) Select(field string, fields ...string) *AutomationRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AutomationRun entity.
+func (_u *AutomationRunUpdateOne) Save(ctx context.Context) (*AutomationRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AutomationRunUpdateOne) SaveX(ctx context.Context) *AutomationRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AutomationRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AutomationRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AutomationRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := automationrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "AutomationRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := automationrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AutomationRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RuleCleared() && len(_u.mutation.RuleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AutomationRun.rule"`)
+	}
+	return nil
+}
+
+func (_u *AutomationRunUpdateOne) sqlSave(ctx context.Context) (_node *AutomationRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(automationrun.Table, automationrun.Columns, sqlgraph.NewFieldSpec(automationrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutomationRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, automationrun.FieldID)
+		for _, f := range fields {
+			if !automationrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != automationrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(automationrun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(automationrun.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(automationrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(automationrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(automationrun.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.RuleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrun.RuleTable,
+			Columns: []string{automationrun.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RuleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrun.RuleTable,
+			Columns: []string{automationrun.RuleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AutomationRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automationrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -15,9 +15,20 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/fielddefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +36,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AutomationRule is the client for interacting with the AutomationRule builders.
+	AutomationRule *AutomationRuleClient
+	// AutomationRun is the client for interacting with the AutomationRun builders.
+	AutomationRun *AutomationRunClient
+	// FieldDefinition is the client for interacting with the FieldDefinition builders.
+	FieldDefinition *FieldDefinitionClient
+	// RecurringTask is the client for interacting with the RecurringTask builders.
+	RecurringTask *RecurringTaskClient
+	// StaleThreshold is the client for interacting with the StaleThreshold builders.
+	StaleThreshold *StaleThresholdClient
+	// TagDefinition is the client for interacting with the TagDefinition builders.
+	TagDefinition *TagDefinitionClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskFieldValue is the client for interacting with the TaskFieldValue builders.
+	TaskFieldValue *TaskFieldValueClient
 	// TaskHistory is the client for interacting with the TaskHistory builders.
 	TaskHistory *TaskHistoryClient
+	// TaskRevision is the client for interacting with the TaskRevision builders.
+	TaskRevision *TaskRevisionClient
 	// TaskTag is the client for interacting with the TaskTag builders.
 	TaskTag *TaskTagClient
+	// TaskTemplate is the client for interacting with the TaskTemplate builders.
+	TaskTemplate *TaskTemplateClient
+	// Transition is the client for interacting with the Transition builders.
+	Transition *TransitionClient
+	// WipLimit is the client for interacting with the WipLimit builders.
+	WipLimit *WipLimitClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +75,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AutomationRule = NewAutomationRuleClient(c.config)
+	c.AutomationRun = NewAutomationRunClient(c.config)
+	c.FieldDefinition = NewFieldDefinitionClient(c.config)
+	c.RecurringTask = NewRecurringTaskClient(c.config)
+	c.StaleThreshold = NewStaleThresholdClient(c.config)
+	c.TagDefinition = NewTagDefinitionClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskFieldValue = NewTaskFieldValueClient(c.config)
 	c.TaskHistory = NewTaskHistoryClient(c.config)
+	c.TaskRevision = NewTaskRevisionClient(c.config)
 	c.TaskTag = NewTaskTagClient(c.config)
+	c.TaskTemplate = NewTaskTemplateClient(c.config)
+	c.Transition = NewTransitionClient(c.config)
+	c.WipLimit = NewWipLimitClient(c.config)
 }
 
 type (
@@ -135,11 +179,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Task:        NewTaskClient(cfg),
-		TaskHistory: NewTaskHistoryClient(cfg),
-		TaskTag:     NewTaskTagClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AutomationRule:  NewAutomationRuleClient(cfg),
+		AutomationRun:   NewAutomationRunClient(cfg),
+		FieldDefinition: NewFieldDefinitionClient(cfg),
+		RecurringTask:   NewRecurringTaskClient(cfg),
+		StaleThreshold:  NewStaleThresholdClient(cfg),
+		TagDefinition:   NewTagDefinitionClient(cfg),
+		Task:            NewTaskClient(cfg),
+		TaskFieldValue:  NewTaskFieldValueClient(cfg),
+		TaskHistory:     NewTaskHistoryClient(cfg),
+		TaskRevision:    NewTaskRevisionClient(cfg),
+		TaskTag:         NewTaskTagClient(cfg),
+		TaskTemplate:    NewTaskTemplateClient(cfg),
+		Transition:      NewTransitionClient(cfg),
+		WipLimit:        NewWipLimitClient(cfg),
 	}, nil
 }
 
@@ -157,18 +212,29 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Task:        NewTaskClient(cfg),
-		TaskHistory: NewTaskHistoryClient(cfg),
-		TaskTag:     NewTaskTagClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AutomationRule:  NewAutomationRuleClient(cfg),
+		AutomationRun:   NewAutomationRunClient(cfg),
+		FieldDefinition: NewFieldDefinitionClient(cfg),
+		RecurringTask:   NewRecurringTaskClient(cfg),
+		StaleThreshold:  NewStaleThresholdClient(cfg),
+		TagDefinition:   NewTagDefinitionClient(cfg),
+		Task:            NewTaskClient(cfg),
+		TaskFieldValue:  NewTaskFieldValueClient(cfg),
+		TaskHistory:     NewTaskHistoryClient(cfg),
+		TaskRevision:    NewTaskRevisionClient(cfg),
+		TaskTag:         NewTaskTagClient(cfg),
+		TaskTemplate:    NewTaskTemplateClient(cfg),
+		Transition:      NewTransitionClient(cfg),
+		WipLimit:        NewWipLimitClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Task.
+//		AutomationRule.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,134 +256,164 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Task.Use(hooks...)
-	c.TaskHistory.Use(hooks...)
-	c.TaskTag.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AutomationRule, c.AutomationRun, c.FieldDefinition, c.RecurringTask,
+		c.StaleThreshold, c.TagDefinition, c.Task, c.TaskFieldValue, c.TaskHistory,
+		c.TaskRevision, c.TaskTag, c.TaskTemplate, c.Transition, c.WipLimit,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Task.Intercept(interceptors...)
-	c.TaskHistory.Intercept(interceptors...)
-	c.TaskTag.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AutomationRule, c.AutomationRun, c.FieldDefinition, c.RecurringTask,
+		c.StaleThreshold, c.TagDefinition, c.Task, c.TaskFieldValue, c.TaskHistory,
+		c.TaskRevision, c.TaskTag, c.TaskTemplate, c.Transition, c.WipLimit,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AutomationRuleMutation:
+		return c.AutomationRule.mutate(ctx, m)
+	case *AutomationRunMutation:
+		return c.AutomationRun.mutate(ctx, m)
+	case *FieldDefinitionMutation:
+		return c.FieldDefinition.mutate(ctx, m)
+	case *RecurringTaskMutation:
+		return c.RecurringTask.mutate(ctx, m)
+	case *StaleThresholdMutation:
+		return c.StaleThreshold.mutate(ctx, m)
+	case *TagDefinitionMutation:
+		return c.TagDefinition.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskFieldValueMutation:
+		return c.TaskFieldValue.mutate(ctx, m)
 	case *TaskHistoryMutation:
 		return c.TaskHistory.mutate(ctx, m)
+	case *TaskRevisionMutation:
+		return c.TaskRevision.mutate(ctx, m)
 	case *TaskTagMutation:
 		return c.TaskTag.mutate(ctx, m)
+	case *TaskTemplateMutation:
+		return c.TaskTemplate.mutate(ctx, m)
+	case *TransitionMutation:
+		return c.Transition.mutate(ctx, m)
+	case *WipLimitMutation:
+		return c.WipLimit.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// TaskClient is a client for the Task schema.
-type TaskClient struct {
+// AutomationRuleClient is a client for the AutomationRule schema.
+type AutomationRuleClient struct {
 	config
 }
 
-// NewTaskClient returns a client for the Task from the given config.
-func NewTaskClient(c config) *TaskClient {
-	return &TaskClient{config: c}
+// NewAutomationRuleClient returns a client for the AutomationRule from the given config.
+func NewAutomationRuleClient(c config) *AutomationRuleClient {
+	return &AutomationRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
-func (c *TaskClient) Use(hooks ...Hook) {
-	c.hooks.Task = append(c.hooks.Task, hooks...)
+// A call to `Use(f, g, h)` equals to `automationrule.Hooks(f(g(h())))`.
+func (c *AutomationRuleClient) Use(hooks ...Hook) {
+	c.hooks.AutomationRule = append(c.hooks.AutomationRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
-func (c *TaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Task = append(c.inters.Task, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `automationrule.Intercept(f(g(h())))`.
+func (c *AutomationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AutomationRule = append(c.inters.AutomationRule, interceptors...)
 }
 
-// Create returns a builder for creating a Task entity.
-func (c *TaskClient) Create() *TaskCreate {
-	mutation := newTaskMutation(c.config, OpCreate)
-	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AutomationRule entity.
+func (c *AutomationRuleClient) Create() *AutomationRuleCreate {
+	mutation := newAutomationRuleMutation(c.config, OpCreate)
+	return &AutomationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Task entities.
-func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
-	return &TaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AutomationRule entities.
+func (c *AutomationRuleClient) CreateBulk(builders ...*AutomationRuleCreate) *AutomationRuleCreateBulk {
+	return &AutomationRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+func (c *AutomationRuleClient) MapCreateBulk(slice any, setFunc func(*AutomationRuleCreate, int)) *AutomationRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AutomationRuleCreateBulk{err: fmt.Errorf("calling to AutomationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskCreate, rv.Len())
+	builders := make([]*AutomationRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskCreateBulk{config: c.config, builders: builders}
+	return &AutomationRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Task.
-func (c *TaskClient) Update() *TaskUpdate {
-	mutation := newTaskMutation(c.config, OpUpdate)
-	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AutomationRule.
+func (c *AutomationRuleClient) Update() *AutomationRuleUpdate {
+	mutation := newAutomationRuleMutation(c.config, OpUpdate)
+	return &AutomationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskClient) UpdateOne(_m *Task) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(_m))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AutomationRuleClient) UpdateOne(_m *AutomationRule) *AutomationRuleUpdateOne {
+	mutation := newAutomationRuleMutation(c.config, OpUpdateOne, withAutomationRule(_m))
+	return &AutomationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AutomationRuleClient) UpdateOneID(id int) *AutomationRuleUpdateOne {
+	mutation := newAutomationRuleMutation(c.config, OpUpdateOne, withAutomationRuleID(id))
+	return &AutomationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Task.
-func (c *TaskClient) Delete() *TaskDelete {
-	mutation := newTaskMutation(c.config, OpDelete)
-	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AutomationRule.
+func (c *AutomationRuleClient) Delete() *AutomationRuleDelete {
+	mutation := newAutomationRuleMutation(c.config, OpDelete)
+	return &AutomationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskClient) DeleteOne(_m *Task) *TaskDeleteOne {
+func (c *AutomationRuleClient) DeleteOne(_m *AutomationRule) *AutomationRuleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
-	builder := c.Delete().Where(task.ID(id))
+func (c *AutomationRuleClient) DeleteOneID(id int) *AutomationRuleDeleteOne {
+	builder := c.Delete().Where(automationrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskDeleteOne{builder}
+	return &AutomationRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for Task.
-func (c *TaskClient) Query() *TaskQuery {
-	return &TaskQuery{
+// Query returns a query builder for AutomationRule.
+func (c *AutomationRuleClient) Query() *AutomationRuleQuery {
+	return &AutomationRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTask},
+		ctx:    &QueryContext{Type: TypeAutomationRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Task entity by its id.
-func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
-	return c.Query().Where(task.ID(id)).Only(ctx)
+// Get returns a AutomationRule entity by its id.
+func (c *AutomationRuleClient) Get(ctx context.Context, id int) (*AutomationRule, error) {
+	return c.Query().Where(automationrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
+func (c *AutomationRuleClient) GetX(ctx context.Context, id int) *AutomationRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -325,31 +421,15 @@ func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
 	return obj
 }
 
-// QueryTags queries the tags edge of a Task.
-func (c *TaskClient) QueryTags(_m *Task) *TaskTagQuery {
-	query := (&TaskTagClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(tasktag.Table, tasktag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, task.TagsTable, task.TagsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryHistory queries the history edge of a Task.
-func (c *TaskClient) QueryHistory(_m *Task) *TaskHistoryQuery {
-	query := (&TaskHistoryClient{config: c.config}).Query()
+// QueryRuns queries the runs edge of a AutomationRule.
+func (c *AutomationRuleClient) QueryRuns(_m *AutomationRule) *AutomationRunQuery {
+	query := (&AutomationRunClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(taskhistory.Table, taskhistory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, task.HistoryTable, task.HistoryColumn),
+			sqlgraph.From(automationrule.Table, automationrule.FieldID, id),
+			sqlgraph.To(automationrun.Table, automationrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, automationrule.RunsTable, automationrule.RunsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -358,131 +438,131 @@ func (c *TaskClient) QueryHistory(_m *Task) *TaskHistoryQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TaskClient) Hooks() []Hook {
-	return c.hooks.Task
+func (c *AutomationRuleClient) Hooks() []Hook {
+	return c.hooks.AutomationRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *TaskClient) Interceptors() []Interceptor {
-	return c.inters.Task
+func (c *AutomationRuleClient) Interceptors() []Interceptor {
+	return c.inters.AutomationRule
 }
 
-func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error) {
+func (c *AutomationRuleClient) mutate(ctx context.Context, m *AutomationRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutomationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutomationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutomationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AutomationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Task mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AutomationRule mutation op: %q", m.Op())
 	}
 }
 
-// TaskHistoryClient is a client for the TaskHistory schema.
-type TaskHistoryClient struct {
+// AutomationRunClient is a client for the AutomationRun schema.
+type AutomationRunClient struct {
 	config
 }
 
-// NewTaskHistoryClient returns a client for the TaskHistory from the given config.
-func NewTaskHistoryClient(c config) *TaskHistoryClient {
-	return &TaskHistoryClient{config: c}
+// NewAutomationRunClient returns a client for the AutomationRun from the given config.
+func NewAutomationRunClient(c config) *AutomationRunClient {
+	return &AutomationRunClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `taskhistory.Hooks(f(g(h())))`.
-func (c *TaskHistoryClient) Use(hooks ...Hook) {
-	c.hooks.TaskHistory = append(c.hooks.TaskHistory, hooks...)
+// A call to `Use(f, g, h)` equals to `automationrun.Hooks(f(g(h())))`.
+func (c *AutomationRunClient) Use(hooks ...Hook) {
+	c.hooks.AutomationRun = append(c.hooks.AutomationRun, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `taskhistory.Intercept(f(g(h())))`.
-func (c *TaskHistoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TaskHistory = append(c.inters.TaskHistory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `automationrun.Intercept(f(g(h())))`.
+func (c *AutomationRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AutomationRun = append(c.inters.AutomationRun, interceptors...)
 }
 
-// Create returns a builder for creating a TaskHistory entity.
-func (c *TaskHistoryClient) Create() *TaskHistoryCreate {
-	mutation := newTaskHistoryMutation(c.config, OpCreate)
-	return &TaskHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AutomationRun entity.
+func (c *AutomationRunClient) Create() *AutomationRunCreate {
+	mutation := newAutomationRunMutation(c.config, OpCreate)
+	return &AutomationRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TaskHistory entities.
-func (c *TaskHistoryClient) CreateBulk(builders ...*TaskHistoryCreate) *TaskHistoryCreateBulk {
-	return &TaskHistoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AutomationRun entities.
+func (c *AutomationRunClient) CreateBulk(builders ...*AutomationRunCreate) *AutomationRunCreateBulk {
+	return &AutomationRunCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskHistoryClient) MapCreateBulk(slice any, setFunc func(*TaskHistoryCreate, int)) *TaskHistoryCreateBulk {
+func (c *AutomationRunClient) MapCreateBulk(slice any, setFunc func(*AutomationRunCreate, int)) *AutomationRunCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskHistoryCreateBulk{err: fmt.Errorf("calling to TaskHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AutomationRunCreateBulk{err: fmt.Errorf("calling to AutomationRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskHistoryCreate, rv.Len())
+	builders := make([]*AutomationRunCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskHistoryCreateBulk{config: c.config, builders: builders}
+	return &AutomationRunCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TaskHistory.
-func (c *TaskHistoryClient) Update() *TaskHistoryUpdate {
-	mutation := newTaskHistoryMutation(c.config, OpUpdate)
-	return &TaskHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AutomationRun.
+func (c *AutomationRunClient) Update() *AutomationRunUpdate {
+	mutation := newAutomationRunMutation(c.config, OpUpdate)
+	return &AutomationRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskHistoryClient) UpdateOne(_m *TaskHistory) *TaskHistoryUpdateOne {
-	mutation := newTaskHistoryMutation(c.config, OpUpdateOne, withTaskHistory(_m))
-	return &TaskHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AutomationRunClient) UpdateOne(_m *AutomationRun) *AutomationRunUpdateOne {
+	mutation := newAutomationRunMutation(c.config, OpUpdateOne, withAutomationRun(_m))
+	return &AutomationRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskHistoryClient) UpdateOneID(id int) *TaskHistoryUpdateOne {
-	mutation := newTaskHistoryMutation(c.config, OpUpdateOne, withTaskHistoryID(id))
-	return &TaskHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AutomationRunClient) UpdateOneID(id int) *AutomationRunUpdateOne {
+	mutation := newAutomationRunMutation(c.config, OpUpdateOne, withAutomationRunID(id))
+	return &AutomationRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TaskHistory.
-func (c *TaskHistoryClient) Delete() *TaskHistoryDelete {
-	mutation := newTaskHistoryMutation(c.config, OpDelete)
-	return &TaskHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AutomationRun.
+func (c *AutomationRunClient) Delete() *AutomationRunDelete {
+	mutation := newAutomationRunMutation(c.config, OpDelete)
+	return &AutomationRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskHistoryClient) DeleteOne(_m *TaskHistory) *TaskHistoryDeleteOne {
+func (c *AutomationRunClient) DeleteOne(_m *AutomationRun) *AutomationRunDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskHistoryClient) DeleteOneID(id int) *TaskHistoryDeleteOne {
-	builder := c.Delete().Where(taskhistory.ID(id))
+func (c *AutomationRunClient) DeleteOneID(id int) *AutomationRunDeleteOne {
+	builder := c.Delete().Where(automationrun.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskHistoryDeleteOne{builder}
+	return &AutomationRunDeleteOne{builder}
 }
 
-// Query returns a query builder for TaskHistory.
-func (c *TaskHistoryClient) Query() *TaskHistoryQuery {
-	return &TaskHistoryQuery{
+// Query returns a query builder for AutomationRun.
+func (c *AutomationRunClient) Query() *AutomationRunQuery {
+	return &AutomationRunQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTaskHistory},
+		ctx:    &QueryContext{Type: TypeAutomationRun},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TaskHistory entity by its id.
-func (c *TaskHistoryClient) Get(ctx context.Context, id int) (*TaskHistory, error) {
-	return c.Query().Where(taskhistory.ID(id)).Only(ctx)
+// Get returns a AutomationRun entity by its id.
+func (c *AutomationRunClient) Get(ctx context.Context, id int) (*AutomationRun, error) {
+	return c.Query().Where(automationrun.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskHistoryClient) GetX(ctx context.Context, id int) *TaskHistory {
+func (c *AutomationRunClient) GetX(ctx context.Context, id int) *AutomationRun {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -490,15 +570,15 @@ func (c *TaskHistoryClient) GetX(ctx context.Context, id int) *TaskHistory {
 	return obj
 }
 
-// QueryTask queries the task edge of a TaskHistory.
-func (c *TaskHistoryClient) QueryTask(_m *TaskHistory) *TaskQuery {
-	query := (&TaskClient{config: c.config}).Query()
+// QueryRule queries the rule edge of a AutomationRun.
+func (c *AutomationRunClient) QueryRule(_m *AutomationRun) *AutomationRuleQuery {
+	query := (&AutomationRuleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(taskhistory.Table, taskhistory.FieldID, id),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, taskhistory.TaskTable, taskhistory.TaskColumn),
+			sqlgraph.From(automationrun.Table, automationrun.FieldID, id),
+			sqlgraph.To(automationrule.Table, automationrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, automationrun.RuleTable, automationrun.RuleColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -507,131 +587,131 @@ func (c *TaskHistoryClient) QueryTask(_m *TaskHistory) *TaskQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TaskHistoryClient) Hooks() []Hook {
-	return c.hooks.TaskHistory
+func (c *AutomationRunClient) Hooks() []Hook {
+	return c.hooks.AutomationRun
 }
 
 // Interceptors returns the client interceptors.
-func (c *TaskHistoryClient) Interceptors() []Interceptor {
-	return c.inters.TaskHistory
+func (c *AutomationRunClient) Interceptors() []Interceptor {
+	return c.inters.AutomationRun
 }
 
-func (c *TaskHistoryClient) mutate(ctx context.Context, m *TaskHistoryMutation) (Value, error) {
+func (c *AutomationRunClient) mutate(ctx context.Context, m *AutomationRunMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TaskHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutomationRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TaskHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutomationRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TaskHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AutomationRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TaskHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AutomationRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TaskHistory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AutomationRun mutation op: %q", m.Op())
 	}
 }
 
-// TaskTagClient is a client for the TaskTag schema.
-type TaskTagClient struct {
+// FieldDefinitionClient is a client for the FieldDefinition schema.
+type FieldDefinitionClient struct {
 	config
 }
 
-// NewTaskTagClient returns a client for the TaskTag from the given config.
-func NewTaskTagClient(c config) *TaskTagClient {
-	return &TaskTagClient{config: c}
+// NewFieldDefinitionClient returns a client for the FieldDefinition from the given config.
+func NewFieldDefinitionClient(c config) *FieldDefinitionClient {
+	return &FieldDefinitionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `tasktag.Hooks(f(g(h())))`.
-func (c *TaskTagClient) Use(hooks ...Hook) {
-	c.hooks.TaskTag = append(c.hooks.TaskTag, hooks...)
+// A call to `Use(f, g, h)` equals to `fielddefinition.Hooks(f(g(h())))`.
+func (c *FieldDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.FieldDefinition = append(c.hooks.FieldDefinition, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `tasktag.Intercept(f(g(h())))`.
-func (c *TaskTagClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TaskTag = append(c.inters.TaskTag, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `fielddefinition.Intercept(f(g(h())))`.
+func (c *FieldDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FieldDefinition = append(c.inters.FieldDefinition, interceptors...)
 }
 
-// Create returns a builder for creating a TaskTag entity.
-func (c *TaskTagClient) Create() *TaskTagCreate {
-	mutation := newTaskTagMutation(c.config, OpCreate)
-	return &TaskTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FieldDefinition entity.
+func (c *FieldDefinitionClient) Create() *FieldDefinitionCreate {
+	mutation := newFieldDefinitionMutation(c.config, OpCreate)
+	return &FieldDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TaskTag entities.
-func (c *TaskTagClient) CreateBulk(builders ...*TaskTagCreate) *TaskTagCreateBulk {
-	return &TaskTagCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FieldDefinition entities.
+func (c *FieldDefinitionClient) CreateBulk(builders ...*FieldDefinitionCreate) *FieldDefinitionCreateBulk {
+	return &FieldDefinitionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskTagClient) MapCreateBulk(slice any, setFunc func(*TaskTagCreate, int)) *TaskTagCreateBulk {
+func (c *FieldDefinitionClient) MapCreateBulk(slice any, setFunc func(*FieldDefinitionCreate, int)) *FieldDefinitionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskTagCreateBulk{err: fmt.Errorf("calling to TaskTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FieldDefinitionCreateBulk{err: fmt.Errorf("calling to FieldDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskTagCreate, rv.Len())
+	builders := make([]*FieldDefinitionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskTagCreateBulk{config: c.config, builders: builders}
+	return &FieldDefinitionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TaskTag.
-func (c *TaskTagClient) Update() *TaskTagUpdate {
-	mutation := newTaskTagMutation(c.config, OpUpdate)
-	return &TaskTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FieldDefinition.
+func (c *FieldDefinitionClient) Update() *FieldDefinitionUpdate {
+	mutation := newFieldDefinitionMutation(c.config, OpUpdate)
+	return &FieldDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskTagClient) UpdateOne(_m *TaskTag) *TaskTagUpdateOne {
-	mutation := newTaskTagMutation(c.config, OpUpdateOne, withTaskTag(_m))
-	return &TaskTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FieldDefinitionClient) UpdateOne(_m *FieldDefinition) *FieldDefinitionUpdateOne {
+	mutation := newFieldDefinitionMutation(c.config, OpUpdateOne, withFieldDefinition(_m))
+	return &FieldDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskTagClient) UpdateOneID(id int) *TaskTagUpdateOne {
-	mutation := newTaskTagMutation(c.config, OpUpdateOne, withTaskTagID(id))
-	return &TaskTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FieldDefinitionClient) UpdateOneID(id int) *FieldDefinitionUpdateOne {
+	mutation := newFieldDefinitionMutation(c.config, OpUpdateOne, withFieldDefinitionID(id))
+	return &FieldDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TaskTag.
-func (c *TaskTagClient) Delete() *TaskTagDelete {
-	mutation := newTaskTagMutation(c.config, OpDelete)
-	return &TaskTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FieldDefinition.
+func (c *FieldDefinitionClient) Delete() *FieldDefinitionDelete {
+	mutation := newFieldDefinitionMutation(c.config, OpDelete)
+	return &FieldDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskTagClient) DeleteOne(_m *TaskTag) *TaskTagDeleteOne {
+func (c *FieldDefinitionClient) DeleteOne(_m *FieldDefinition) *FieldDefinitionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskTagClient) DeleteOneID(id int) *TaskTagDeleteOne {
-	builder := c.Delete().Where(tasktag.ID(id))
+func (c *FieldDefinitionClient) DeleteOneID(id int) *FieldDefinitionDeleteOne {
+	builder := c.Delete().Where(fielddefinition.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskTagDeleteOne{builder}
+	return &FieldDefinitionDeleteOne{builder}
 }
 
-// Query returns a query builder for TaskTag.
-func (c *TaskTagClient) Query() *TaskTagQuery {
-	return &TaskTagQuery{
+// Query returns a query builder for FieldDefinition.
+func (c *FieldDefinitionClient) Query() *FieldDefinitionQuery {
+	return &FieldDefinitionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTaskTag},
+		ctx:    &QueryContext{Type: TypeFieldDefinition},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TaskTag entity by its id.
-func (c *TaskTagClient) Get(ctx context.Context, id int) (*TaskTag, error) {
-	return c.Query().Where(tasktag.ID(id)).Only(ctx)
+// Get returns a FieldDefinition entity by its id.
+func (c *FieldDefinitionClient) Get(ctx context.Context, id int) (*FieldDefinition, error) {
+	return c.Query().Where(fielddefinition.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskTagClient) GetX(ctx context.Context, id int) *TaskTag {
+func (c *FieldDefinitionClient) GetX(ctx context.Context, id int) *FieldDefinition {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -639,53 +719,1632 @@ func (c *TaskTagClient) GetX(ctx context.Context, id int) *TaskTag {
 	return obj
 }
 
-// QueryTask queries the task edge of a TaskTag.
-func (c *TaskTagClient) QueryTask(_m *TaskTag) *TaskQuery {
-	query := (&TaskClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tasktag.Table, tasktag.FieldID, id),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, tasktag.TaskTable, tasktag.TaskColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *TaskTagClient) Hooks() []Hook {
-	return c.hooks.TaskTag
+func (c *FieldDefinitionClient) Hooks() []Hook {
+	return c.hooks.FieldDefinition
 }
 
 // Interceptors returns the client interceptors.
-func (c *TaskTagClient) Interceptors() []Interceptor {
-	return c.inters.TaskTag
+func (c *FieldDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.FieldDefinition
 }
 
-func (c *TaskTagClient) mutate(ctx context.Context, m *TaskTagMutation) (Value, error) {
+func (c *FieldDefinitionClient) mutate(ctx context.Context, m *FieldDefinitionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TaskTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FieldDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TaskTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FieldDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TaskTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FieldDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TaskTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FieldDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TaskTag mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FieldDefinition mutation op: %q", m.Op())
+	}
+}
+
+// RecurringTaskClient is a client for the RecurringTask schema.
+type RecurringTaskClient struct {
+	config
+}
+
+// NewRecurringTaskClient returns a client for the RecurringTask from the given config.
+func NewRecurringTaskClient(c config) *RecurringTaskClient {
+	return &RecurringTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recurringtask.Hooks(f(g(h())))`.
+func (c *RecurringTaskClient) Use(hooks ...Hook) {
+	c.hooks.RecurringTask = append(c.hooks.RecurringTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recurringtask.Intercept(f(g(h())))`.
+func (c *RecurringTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecurringTask = append(c.inters.RecurringTask, interceptors...)
+}
+
+// Create returns a builder for creating a RecurringTask entity.
+func (c *RecurringTaskClient) Create() *RecurringTaskCreate {
+	mutation := newRecurringTaskMutation(c.config, OpCreate)
+	return &RecurringTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecurringTask entities.
+func (c *RecurringTaskClient) CreateBulk(builders ...*RecurringTaskCreate) *RecurringTaskCreateBulk {
+	return &RecurringTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecurringTaskClient) MapCreateBulk(slice any, setFunc func(*RecurringTaskCreate, int)) *RecurringTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecurringTaskCreateBulk{err: fmt.Errorf("calling to RecurringTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecurringTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecurringTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecurringTask.
+func (c *RecurringTaskClient) Update() *RecurringTaskUpdate {
+	mutation := newRecurringTaskMutation(c.config, OpUpdate)
+	return &RecurringTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecurringTaskClient) UpdateOne(_m *RecurringTask) *RecurringTaskUpdateOne {
+	mutation := newRecurringTaskMutation(c.config, OpUpdateOne, withRecurringTask(_m))
+	return &RecurringTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecurringTaskClient) UpdateOneID(id int) *RecurringTaskUpdateOne {
+	mutation := newRecurringTaskMutation(c.config, OpUpdateOne, withRecurringTaskID(id))
+	return &RecurringTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecurringTask.
+func (c *RecurringTaskClient) Delete() *RecurringTaskDelete {
+	mutation := newRecurringTaskMutation(c.config, OpDelete)
+	return &RecurringTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecurringTaskClient) DeleteOne(_m *RecurringTask) *RecurringTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecurringTaskClient) DeleteOneID(id int) *RecurringTaskDeleteOne {
+	builder := c.Delete().Where(recurringtask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecurringTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for RecurringTask.
+func (c *RecurringTaskClient) Query() *RecurringTaskQuery {
+	return &RecurringTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecurringTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecurringTask entity by its id.
+func (c *RecurringTaskClient) Get(ctx context.Context, id int) (*RecurringTask, error) {
+	return c.Query().Where(recurringtask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecurringTaskClient) GetX(ctx context.Context, id int) *RecurringTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RecurringTaskClient) Hooks() []Hook {
+	return c.hooks.RecurringTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecurringTaskClient) Interceptors() []Interceptor {
+	return c.inters.RecurringTask
+}
+
+func (c *RecurringTaskClient) mutate(ctx context.Context, m *RecurringTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecurringTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecurringTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecurringTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecurringTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecurringTask mutation op: %q", m.Op())
+	}
+}
+
+// StaleThresholdClient is a client for the StaleThreshold schema.
+type StaleThresholdClient struct {
+	config
+}
+
+// NewStaleThresholdClient returns a client for the StaleThreshold from the given config.
+func NewStaleThresholdClient(c config) *StaleThresholdClient {
+	return &StaleThresholdClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stalethreshold.Hooks(f(g(h())))`.
+func (c *StaleThresholdClient) Use(hooks ...Hook) {
+	c.hooks.StaleThreshold = append(c.hooks.StaleThreshold, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stalethreshold.Intercept(f(g(h())))`.
+func (c *StaleThresholdClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StaleThreshold = append(c.inters.StaleThreshold, interceptors...)
+}
+
+// Create returns a builder for creating a StaleThreshold entity.
+func (c *StaleThresholdClient) Create() *StaleThresholdCreate {
+	mutation := newStaleThresholdMutation(c.config, OpCreate)
+	return &StaleThresholdCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StaleThreshold entities.
+func (c *StaleThresholdClient) CreateBulk(builders ...*StaleThresholdCreate) *StaleThresholdCreateBulk {
+	return &StaleThresholdCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StaleThresholdClient) MapCreateBulk(slice any, setFunc func(*StaleThresholdCreate, int)) *StaleThresholdCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StaleThresholdCreateBulk{err: fmt.Errorf("calling to StaleThresholdClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StaleThresholdCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StaleThresholdCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StaleThreshold.
+func (c *StaleThresholdClient) Update() *StaleThresholdUpdate {
+	mutation := newStaleThresholdMutation(c.config, OpUpdate)
+	return &StaleThresholdUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StaleThresholdClient) UpdateOne(_m *StaleThreshold) *StaleThresholdUpdateOne {
+	mutation := newStaleThresholdMutation(c.config, OpUpdateOne, withStaleThreshold(_m))
+	return &StaleThresholdUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StaleThresholdClient) UpdateOneID(id int) *StaleThresholdUpdateOne {
+	mutation := newStaleThresholdMutation(c.config, OpUpdateOne, withStaleThresholdID(id))
+	return &StaleThresholdUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StaleThreshold.
+func (c *StaleThresholdClient) Delete() *StaleThresholdDelete {
+	mutation := newStaleThresholdMutation(c.config, OpDelete)
+	return &StaleThresholdDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StaleThresholdClient) DeleteOne(_m *StaleThreshold) *StaleThresholdDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StaleThresholdClient) DeleteOneID(id int) *StaleThresholdDeleteOne {
+	builder := c.Delete().Where(stalethreshold.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StaleThresholdDeleteOne{builder}
+}
+
+// Query returns a query builder for StaleThreshold.
+func (c *StaleThresholdClient) Query() *StaleThresholdQuery {
+	return &StaleThresholdQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStaleThreshold},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StaleThreshold entity by its id.
+func (c *StaleThresholdClient) Get(ctx context.Context, id int) (*StaleThreshold, error) {
+	return c.Query().Where(stalethreshold.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StaleThresholdClient) GetX(ctx context.Context, id int) *StaleThreshold {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StaleThresholdClient) Hooks() []Hook {
+	return c.hooks.StaleThreshold
+}
+
+// Interceptors returns the client interceptors.
+func (c *StaleThresholdClient) Interceptors() []Interceptor {
+	return c.inters.StaleThreshold
+}
+
+func (c *StaleThresholdClient) mutate(ctx context.Context, m *StaleThresholdMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StaleThresholdCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StaleThresholdUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StaleThresholdUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StaleThresholdDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StaleThreshold mutation op: %q", m.Op())
+	}
+}
+
+// TagDefinitionClient is a client for the TagDefinition schema.
+type TagDefinitionClient struct {
+	config
+}
+
+// NewTagDefinitionClient returns a client for the TagDefinition from the given config.
+func NewTagDefinitionClient(c config) *TagDefinitionClient {
+	return &TagDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tagdefinition.Hooks(f(g(h())))`.
+func (c *TagDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.TagDefinition = append(c.hooks.TagDefinition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tagdefinition.Intercept(f(g(h())))`.
+func (c *TagDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagDefinition = append(c.inters.TagDefinition, interceptors...)
+}
+
+// Create returns a builder for creating a TagDefinition entity.
+func (c *TagDefinitionClient) Create() *TagDefinitionCreate {
+	mutation := newTagDefinitionMutation(c.config, OpCreate)
+	return &TagDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagDefinition entities.
+func (c *TagDefinitionClient) CreateBulk(builders ...*TagDefinitionCreate) *TagDefinitionCreateBulk {
+	return &TagDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagDefinitionClient) MapCreateBulk(slice any, setFunc func(*TagDefinitionCreate, int)) *TagDefinitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagDefinitionCreateBulk{err: fmt.Errorf("calling to TagDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagDefinitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagDefinition.
+func (c *TagDefinitionClient) Update() *TagDefinitionUpdate {
+	mutation := newTagDefinitionMutation(c.config, OpUpdate)
+	return &TagDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagDefinitionClient) UpdateOne(_m *TagDefinition) *TagDefinitionUpdateOne {
+	mutation := newTagDefinitionMutation(c.config, OpUpdateOne, withTagDefinition(_m))
+	return &TagDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagDefinitionClient) UpdateOneID(id int) *TagDefinitionUpdateOne {
+	mutation := newTagDefinitionMutation(c.config, OpUpdateOne, withTagDefinitionID(id))
+	return &TagDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagDefinition.
+func (c *TagDefinitionClient) Delete() *TagDefinitionDelete {
+	mutation := newTagDefinitionMutation(c.config, OpDelete)
+	return &TagDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagDefinitionClient) DeleteOne(_m *TagDefinition) *TagDefinitionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagDefinitionClient) DeleteOneID(id int) *TagDefinitionDeleteOne {
+	builder := c.Delete().Where(tagdefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for TagDefinition.
+func (c *TagDefinitionClient) Query() *TagDefinitionQuery {
+	return &TagDefinitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagDefinition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagDefinition entity by its id.
+func (c *TagDefinitionClient) Get(ctx context.Context, id int) (*TagDefinition, error) {
+	return c.Query().Where(tagdefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagDefinitionClient) GetX(ctx context.Context, id int) *TagDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TagDefinitionClient) Hooks() []Hook {
+	return c.hooks.TagDefinition
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.TagDefinition
+}
+
+func (c *TagDefinitionClient) mutate(ctx context.Context, m *TagDefinitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagDefinition mutation op: %q", m.Op())
+	}
+}
+
+// TaskClient is a client for the Task schema.
+type TaskClient struct {
+	config
+}
+
+// NewTaskClient returns a client for the Task from the given config.
+func NewTaskClient(c config) *TaskClient {
+	return &TaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
+func (c *TaskClient) Use(hooks ...Hook) {
+	c.hooks.Task = append(c.hooks.Task, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
+// Create returns a builder for creating a Task entity.
+func (c *TaskClient) Create() *TaskCreate {
+	mutation := newTaskMutation(c.config, OpCreate)
+	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Task entities.
+func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Task.
+func (c *TaskClient) Update() *TaskUpdate {
+	mutation := newTaskMutation(c.config, OpUpdate)
+	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskClient) UpdateOne(_m *Task) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(_m))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Task.
+func (c *TaskClient) Delete() *TaskDelete {
+	mutation := newTaskMutation(c.config, OpDelete)
+	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskClient) DeleteOne(_m *Task) *TaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
+	builder := c.Delete().Where(task.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskDeleteOne{builder}
+}
+
+// Query returns a query builder for Task.
+func (c *TaskClient) Query() *TaskQuery {
+	return &TaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Task entity by its id.
+func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
+	return c.Query().Where(task.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTags queries the tags edge of a Task.
+func (c *TaskClient) QueryTags(_m *Task) *TaskTagQuery {
+	query := (&TaskTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(tasktag.Table, tasktag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.TagsTable, task.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHistory queries the history edge of a Task.
+func (c *TaskClient) QueryHistory(_m *Task) *TaskHistoryQuery {
+	query := (&TaskHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskhistory.Table, taskhistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.HistoryTable, task.HistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Task.
+func (c *TaskClient) QueryRevisions(_m *Task) *TaskRevisionQuery {
+	query := (&TaskRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskrevision.Table, taskrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.RevisionsTable, task.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFieldValues queries the field_values edge of a Task.
+func (c *TaskClient) QueryFieldValues(_m *Task) *TaskFieldValueQuery {
+	query := (&TaskFieldValueClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(taskfieldvalue.Table, taskfieldvalue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.FieldValuesTable, task.FieldValuesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskClient) Hooks() []Hook {
+	return c.hooks.Task
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskClient) Interceptors() []Interceptor {
+	return c.inters.Task
+}
+
+func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Task mutation op: %q", m.Op())
+	}
+}
+
+// TaskFieldValueClient is a client for the TaskFieldValue schema.
+type TaskFieldValueClient struct {
+	config
+}
+
+// NewTaskFieldValueClient returns a client for the TaskFieldValue from the given config.
+func NewTaskFieldValueClient(c config) *TaskFieldValueClient {
+	return &TaskFieldValueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskfieldvalue.Hooks(f(g(h())))`.
+func (c *TaskFieldValueClient) Use(hooks ...Hook) {
+	c.hooks.TaskFieldValue = append(c.hooks.TaskFieldValue, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskfieldvalue.Intercept(f(g(h())))`.
+func (c *TaskFieldValueClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskFieldValue = append(c.inters.TaskFieldValue, interceptors...)
+}
+
+// Create returns a builder for creating a TaskFieldValue entity.
+func (c *TaskFieldValueClient) Create() *TaskFieldValueCreate {
+	mutation := newTaskFieldValueMutation(c.config, OpCreate)
+	return &TaskFieldValueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskFieldValue entities.
+func (c *TaskFieldValueClient) CreateBulk(builders ...*TaskFieldValueCreate) *TaskFieldValueCreateBulk {
+	return &TaskFieldValueCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskFieldValueClient) MapCreateBulk(slice any, setFunc func(*TaskFieldValueCreate, int)) *TaskFieldValueCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskFieldValueCreateBulk{err: fmt.Errorf("calling to TaskFieldValueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskFieldValueCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskFieldValueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskFieldValue.
+func (c *TaskFieldValueClient) Update() *TaskFieldValueUpdate {
+	mutation := newTaskFieldValueMutation(c.config, OpUpdate)
+	return &TaskFieldValueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskFieldValueClient) UpdateOne(_m *TaskFieldValue) *TaskFieldValueUpdateOne {
+	mutation := newTaskFieldValueMutation(c.config, OpUpdateOne, withTaskFieldValue(_m))
+	return &TaskFieldValueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskFieldValueClient) UpdateOneID(id int) *TaskFieldValueUpdateOne {
+	mutation := newTaskFieldValueMutation(c.config, OpUpdateOne, withTaskFieldValueID(id))
+	return &TaskFieldValueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskFieldValue.
+func (c *TaskFieldValueClient) Delete() *TaskFieldValueDelete {
+	mutation := newTaskFieldValueMutation(c.config, OpDelete)
+	return &TaskFieldValueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskFieldValueClient) DeleteOne(_m *TaskFieldValue) *TaskFieldValueDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskFieldValueClient) DeleteOneID(id int) *TaskFieldValueDeleteOne {
+	builder := c.Delete().Where(taskfieldvalue.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskFieldValueDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskFieldValue.
+func (c *TaskFieldValueClient) Query() *TaskFieldValueQuery {
+	return &TaskFieldValueQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskFieldValue},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskFieldValue entity by its id.
+func (c *TaskFieldValueClient) Get(ctx context.Context, id int) (*TaskFieldValue, error) {
+	return c.Query().Where(taskfieldvalue.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskFieldValueClient) GetX(ctx context.Context, id int) *TaskFieldValue {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskFieldValue.
+func (c *TaskFieldValueClient) QueryTask(_m *TaskFieldValue) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskfieldvalue.Table, taskfieldvalue.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskfieldvalue.TaskTable, taskfieldvalue.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskFieldValueClient) Hooks() []Hook {
+	return c.hooks.TaskFieldValue
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskFieldValueClient) Interceptors() []Interceptor {
+	return c.inters.TaskFieldValue
+}
+
+func (c *TaskFieldValueClient) mutate(ctx context.Context, m *TaskFieldValueMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskFieldValueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskFieldValueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskFieldValueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskFieldValueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskFieldValue mutation op: %q", m.Op())
+	}
+}
+
+// TaskHistoryClient is a client for the TaskHistory schema.
+type TaskHistoryClient struct {
+	config
+}
+
+// NewTaskHistoryClient returns a client for the TaskHistory from the given config.
+func NewTaskHistoryClient(c config) *TaskHistoryClient {
+	return &TaskHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskhistory.Hooks(f(g(h())))`.
+func (c *TaskHistoryClient) Use(hooks ...Hook) {
+	c.hooks.TaskHistory = append(c.hooks.TaskHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskhistory.Intercept(f(g(h())))`.
+func (c *TaskHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskHistory = append(c.inters.TaskHistory, interceptors...)
+}
+
+// Create returns a builder for creating a TaskHistory entity.
+func (c *TaskHistoryClient) Create() *TaskHistoryCreate {
+	mutation := newTaskHistoryMutation(c.config, OpCreate)
+	return &TaskHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskHistory entities.
+func (c *TaskHistoryClient) CreateBulk(builders ...*TaskHistoryCreate) *TaskHistoryCreateBulk {
+	return &TaskHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskHistoryClient) MapCreateBulk(slice any, setFunc func(*TaskHistoryCreate, int)) *TaskHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskHistoryCreateBulk{err: fmt.Errorf("calling to TaskHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskHistory.
+func (c *TaskHistoryClient) Update() *TaskHistoryUpdate {
+	mutation := newTaskHistoryMutation(c.config, OpUpdate)
+	return &TaskHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskHistoryClient) UpdateOne(_m *TaskHistory) *TaskHistoryUpdateOne {
+	mutation := newTaskHistoryMutation(c.config, OpUpdateOne, withTaskHistory(_m))
+	return &TaskHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskHistoryClient) UpdateOneID(id int) *TaskHistoryUpdateOne {
+	mutation := newTaskHistoryMutation(c.config, OpUpdateOne, withTaskHistoryID(id))
+	return &TaskHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskHistory.
+func (c *TaskHistoryClient) Delete() *TaskHistoryDelete {
+	mutation := newTaskHistoryMutation(c.config, OpDelete)
+	return &TaskHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskHistoryClient) DeleteOne(_m *TaskHistory) *TaskHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskHistoryClient) DeleteOneID(id int) *TaskHistoryDeleteOne {
+	builder := c.Delete().Where(taskhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskHistory.
+func (c *TaskHistoryClient) Query() *TaskHistoryQuery {
+	return &TaskHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskHistory entity by its id.
+func (c *TaskHistoryClient) Get(ctx context.Context, id int) (*TaskHistory, error) {
+	return c.Query().Where(taskhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskHistoryClient) GetX(ctx context.Context, id int) *TaskHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskHistory.
+func (c *TaskHistoryClient) QueryTask(_m *TaskHistory) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskhistory.Table, taskhistory.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskhistory.TaskTable, taskhistory.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskHistoryClient) Hooks() []Hook {
+	return c.hooks.TaskHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskHistoryClient) Interceptors() []Interceptor {
+	return c.inters.TaskHistory
+}
+
+func (c *TaskHistoryClient) mutate(ctx context.Context, m *TaskHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskHistory mutation op: %q", m.Op())
+	}
+}
+
+// TaskRevisionClient is a client for the TaskRevision schema.
+type TaskRevisionClient struct {
+	config
+}
+
+// NewTaskRevisionClient returns a client for the TaskRevision from the given config.
+func NewTaskRevisionClient(c config) *TaskRevisionClient {
+	return &TaskRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrevision.Hooks(f(g(h())))`.
+func (c *TaskRevisionClient) Use(hooks ...Hook) {
+	c.hooks.TaskRevision = append(c.hooks.TaskRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrevision.Intercept(f(g(h())))`.
+func (c *TaskRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRevision = append(c.inters.TaskRevision, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRevision entity.
+func (c *TaskRevisionClient) Create() *TaskRevisionCreate {
+	mutation := newTaskRevisionMutation(c.config, OpCreate)
+	return &TaskRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRevision entities.
+func (c *TaskRevisionClient) CreateBulk(builders ...*TaskRevisionCreate) *TaskRevisionCreateBulk {
+	return &TaskRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRevisionClient) MapCreateBulk(slice any, setFunc func(*TaskRevisionCreate, int)) *TaskRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRevisionCreateBulk{err: fmt.Errorf("calling to TaskRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRevision.
+func (c *TaskRevisionClient) Update() *TaskRevisionUpdate {
+	mutation := newTaskRevisionMutation(c.config, OpUpdate)
+	return &TaskRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRevisionClient) UpdateOne(_m *TaskRevision) *TaskRevisionUpdateOne {
+	mutation := newTaskRevisionMutation(c.config, OpUpdateOne, withTaskRevision(_m))
+	return &TaskRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRevisionClient) UpdateOneID(id int) *TaskRevisionUpdateOne {
+	mutation := newTaskRevisionMutation(c.config, OpUpdateOne, withTaskRevisionID(id))
+	return &TaskRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRevision.
+func (c *TaskRevisionClient) Delete() *TaskRevisionDelete {
+	mutation := newTaskRevisionMutation(c.config, OpDelete)
+	return &TaskRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRevisionClient) DeleteOne(_m *TaskRevision) *TaskRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRevisionClient) DeleteOneID(id int) *TaskRevisionDeleteOne {
+	builder := c.Delete().Where(taskrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRevision.
+func (c *TaskRevisionClient) Query() *TaskRevisionQuery {
+	return &TaskRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRevision entity by its id.
+func (c *TaskRevisionClient) Get(ctx context.Context, id int) (*TaskRevision, error) {
+	return c.Query().Where(taskrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRevisionClient) GetX(ctx context.Context, id int) *TaskRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskRevision.
+func (c *TaskRevisionClient) QueryTask(_m *TaskRevision) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskrevision.Table, taskrevision.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskrevision.TaskTable, taskrevision.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRevisionClient) Hooks() []Hook {
+	return c.hooks.TaskRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRevisionClient) Interceptors() []Interceptor {
+	return c.inters.TaskRevision
+}
+
+func (c *TaskRevisionClient) mutate(ctx context.Context, m *TaskRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRevision mutation op: %q", m.Op())
+	}
+}
+
+// TaskTagClient is a client for the TaskTag schema.
+type TaskTagClient struct {
+	config
+}
+
+// NewTaskTagClient returns a client for the TaskTag from the given config.
+func NewTaskTagClient(c config) *TaskTagClient {
+	return &TaskTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tasktag.Hooks(f(g(h())))`.
+func (c *TaskTagClient) Use(hooks ...Hook) {
+	c.hooks.TaskTag = append(c.hooks.TaskTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tasktag.Intercept(f(g(h())))`.
+func (c *TaskTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskTag = append(c.inters.TaskTag, interceptors...)
+}
+
+// Create returns a builder for creating a TaskTag entity.
+func (c *TaskTagClient) Create() *TaskTagCreate {
+	mutation := newTaskTagMutation(c.config, OpCreate)
+	return &TaskTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskTag entities.
+func (c *TaskTagClient) CreateBulk(builders ...*TaskTagCreate) *TaskTagCreateBulk {
+	return &TaskTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskTagClient) MapCreateBulk(slice any, setFunc func(*TaskTagCreate, int)) *TaskTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskTagCreateBulk{err: fmt.Errorf("calling to TaskTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskTag.
+func (c *TaskTagClient) Update() *TaskTagUpdate {
+	mutation := newTaskTagMutation(c.config, OpUpdate)
+	return &TaskTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskTagClient) UpdateOne(_m *TaskTag) *TaskTagUpdateOne {
+	mutation := newTaskTagMutation(c.config, OpUpdateOne, withTaskTag(_m))
+	return &TaskTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskTagClient) UpdateOneID(id int) *TaskTagUpdateOne {
+	mutation := newTaskTagMutation(c.config, OpUpdateOne, withTaskTagID(id))
+	return &TaskTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskTag.
+func (c *TaskTagClient) Delete() *TaskTagDelete {
+	mutation := newTaskTagMutation(c.config, OpDelete)
+	return &TaskTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskTagClient) DeleteOne(_m *TaskTag) *TaskTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskTagClient) DeleteOneID(id int) *TaskTagDeleteOne {
+	builder := c.Delete().Where(tasktag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskTagDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskTag.
+func (c *TaskTagClient) Query() *TaskTagQuery {
+	return &TaskTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskTag entity by its id.
+func (c *TaskTagClient) Get(ctx context.Context, id int) (*TaskTag, error) {
+	return c.Query().Where(tasktag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskTagClient) GetX(ctx context.Context, id int) *TaskTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a TaskTag.
+func (c *TaskTagClient) QueryTask(_m *TaskTag) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tasktag.Table, tasktag.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tasktag.TaskTable, tasktag.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TaskTagClient) Hooks() []Hook {
+	return c.hooks.TaskTag
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskTagClient) Interceptors() []Interceptor {
+	return c.inters.TaskTag
+}
+
+func (c *TaskTagClient) mutate(ctx context.Context, m *TaskTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskTag mutation op: %q", m.Op())
+	}
+}
+
+// TaskTemplateClient is a client for the TaskTemplate schema.
+type TaskTemplateClient struct {
+	config
+}
+
+// NewTaskTemplateClient returns a client for the TaskTemplate from the given config.
+func NewTaskTemplateClient(c config) *TaskTemplateClient {
+	return &TaskTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tasktemplate.Hooks(f(g(h())))`.
+func (c *TaskTemplateClient) Use(hooks ...Hook) {
+	c.hooks.TaskTemplate = append(c.hooks.TaskTemplate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tasktemplate.Intercept(f(g(h())))`.
+func (c *TaskTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskTemplate = append(c.inters.TaskTemplate, interceptors...)
+}
+
+// Create returns a builder for creating a TaskTemplate entity.
+func (c *TaskTemplateClient) Create() *TaskTemplateCreate {
+	mutation := newTaskTemplateMutation(c.config, OpCreate)
+	return &TaskTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskTemplate entities.
+func (c *TaskTemplateClient) CreateBulk(builders ...*TaskTemplateCreate) *TaskTemplateCreateBulk {
+	return &TaskTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskTemplateClient) MapCreateBulk(slice any, setFunc func(*TaskTemplateCreate, int)) *TaskTemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskTemplateCreateBulk{err: fmt.Errorf("calling to TaskTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskTemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskTemplate.
+func (c *TaskTemplateClient) Update() *TaskTemplateUpdate {
+	mutation := newTaskTemplateMutation(c.config, OpUpdate)
+	return &TaskTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskTemplateClient) UpdateOne(_m *TaskTemplate) *TaskTemplateUpdateOne {
+	mutation := newTaskTemplateMutation(c.config, OpUpdateOne, withTaskTemplate(_m))
+	return &TaskTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskTemplateClient) UpdateOneID(id int) *TaskTemplateUpdateOne {
+	mutation := newTaskTemplateMutation(c.config, OpUpdateOne, withTaskTemplateID(id))
+	return &TaskTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskTemplate.
+func (c *TaskTemplateClient) Delete() *TaskTemplateDelete {
+	mutation := newTaskTemplateMutation(c.config, OpDelete)
+	return &TaskTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskTemplateClient) DeleteOne(_m *TaskTemplate) *TaskTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskTemplateClient) DeleteOneID(id int) *TaskTemplateDeleteOne {
+	builder := c.Delete().Where(tasktemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskTemplate.
+func (c *TaskTemplateClient) Query() *TaskTemplateQuery {
+	return &TaskTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskTemplate entity by its id.
+func (c *TaskTemplateClient) Get(ctx context.Context, id int) (*TaskTemplate, error) {
+	return c.Query().Where(tasktemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskTemplateClient) GetX(ctx context.Context, id int) *TaskTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskTemplateClient) Hooks() []Hook {
+	return c.hooks.TaskTemplate
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskTemplateClient) Interceptors() []Interceptor {
+	return c.inters.TaskTemplate
+}
+
+func (c *TaskTemplateClient) mutate(ctx context.Context, m *TaskTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskTemplate mutation op: %q", m.Op())
+	}
+}
+
+// TransitionClient is a client for the Transition schema.
+type TransitionClient struct {
+	config
+}
+
+// NewTransitionClient returns a client for the Transition from the given config.
+func NewTransitionClient(c config) *TransitionClient {
+	return &TransitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transition.Hooks(f(g(h())))`.
+func (c *TransitionClient) Use(hooks ...Hook) {
+	c.hooks.Transition = append(c.hooks.Transition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transition.Intercept(f(g(h())))`.
+func (c *TransitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Transition = append(c.inters.Transition, interceptors...)
+}
+
+// Create returns a builder for creating a Transition entity.
+func (c *TransitionClient) Create() *TransitionCreate {
+	mutation := newTransitionMutation(c.config, OpCreate)
+	return &TransitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Transition entities.
+func (c *TransitionClient) CreateBulk(builders ...*TransitionCreate) *TransitionCreateBulk {
+	return &TransitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransitionClient) MapCreateBulk(slice any, setFunc func(*TransitionCreate, int)) *TransitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransitionCreateBulk{err: fmt.Errorf("calling to TransitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Transition.
+func (c *TransitionClient) Update() *TransitionUpdate {
+	mutation := newTransitionMutation(c.config, OpUpdate)
+	return &TransitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransitionClient) UpdateOne(_m *Transition) *TransitionUpdateOne {
+	mutation := newTransitionMutation(c.config, OpUpdateOne, withTransition(_m))
+	return &TransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransitionClient) UpdateOneID(id int) *TransitionUpdateOne {
+	mutation := newTransitionMutation(c.config, OpUpdateOne, withTransitionID(id))
+	return &TransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Transition.
+func (c *TransitionClient) Delete() *TransitionDelete {
+	mutation := newTransitionMutation(c.config, OpDelete)
+	return &TransitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransitionClient) DeleteOne(_m *Transition) *TransitionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransitionClient) DeleteOneID(id int) *TransitionDeleteOne {
+	builder := c.Delete().Where(transition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransitionDeleteOne{builder}
+}
+
+// Query returns a query builder for Transition.
+func (c *TransitionClient) Query() *TransitionQuery {
+	return &TransitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Transition entity by its id.
+func (c *TransitionClient) Get(ctx context.Context, id int) (*Transition, error) {
+	return c.Query().Where(transition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransitionClient) GetX(ctx context.Context, id int) *Transition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TransitionClient) Hooks() []Hook {
+	return c.hooks.Transition
+}
+
+// Interceptors returns the client interceptors.
+func (c *TransitionClient) Interceptors() []Interceptor {
+	return c.inters.Transition
+}
+
+func (c *TransitionClient) mutate(ctx context.Context, m *TransitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TransitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TransitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TransitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Transition mutation op: %q", m.Op())
+	}
+}
+
+// WipLimitClient is a client for the WipLimit schema.
+type WipLimitClient struct {
+	config
+}
+
+// NewWipLimitClient returns a client for the WipLimit from the given config.
+func NewWipLimitClient(c config) *WipLimitClient {
+	return &WipLimitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wiplimit.Hooks(f(g(h())))`.
+func (c *WipLimitClient) Use(hooks ...Hook) {
+	c.hooks.WipLimit = append(c.hooks.WipLimit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wiplimit.Intercept(f(g(h())))`.
+func (c *WipLimitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WipLimit = append(c.inters.WipLimit, interceptors...)
+}
+
+// Create returns a builder for creating a WipLimit entity.
+func (c *WipLimitClient) Create() *WipLimitCreate {
+	mutation := newWipLimitMutation(c.config, OpCreate)
+	return &WipLimitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WipLimit entities.
+func (c *WipLimitClient) CreateBulk(builders ...*WipLimitCreate) *WipLimitCreateBulk {
+	return &WipLimitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WipLimitClient) MapCreateBulk(slice any, setFunc func(*WipLimitCreate, int)) *WipLimitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WipLimitCreateBulk{err: fmt.Errorf("calling to WipLimitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WipLimitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WipLimitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WipLimit.
+func (c *WipLimitClient) Update() *WipLimitUpdate {
+	mutation := newWipLimitMutation(c.config, OpUpdate)
+	return &WipLimitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WipLimitClient) UpdateOne(_m *WipLimit) *WipLimitUpdateOne {
+	mutation := newWipLimitMutation(c.config, OpUpdateOne, withWipLimit(_m))
+	return &WipLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WipLimitClient) UpdateOneID(id int) *WipLimitUpdateOne {
+	mutation := newWipLimitMutation(c.config, OpUpdateOne, withWipLimitID(id))
+	return &WipLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WipLimit.
+func (c *WipLimitClient) Delete() *WipLimitDelete {
+	mutation := newWipLimitMutation(c.config, OpDelete)
+	return &WipLimitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WipLimitClient) DeleteOne(_m *WipLimit) *WipLimitDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WipLimitClient) DeleteOneID(id int) *WipLimitDeleteOne {
+	builder := c.Delete().Where(wiplimit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WipLimitDeleteOne{builder}
+}
+
+// Query returns a query builder for WipLimit.
+func (c *WipLimitClient) Query() *WipLimitQuery {
+	return &WipLimitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWipLimit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WipLimit entity by its id.
+func (c *WipLimitClient) Get(ctx context.Context, id int) (*WipLimit, error) {
+	return c.Query().Where(wiplimit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WipLimitClient) GetX(ctx context.Context, id int) *WipLimit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WipLimitClient) Hooks() []Hook {
+	return c.hooks.WipLimit
+}
+
+// Interceptors returns the client interceptors.
+func (c *WipLimitClient) Interceptors() []Interceptor {
+	return c.inters.WipLimit
+}
+
+func (c *WipLimitClient) mutate(ctx context.Context, m *WipLimitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WipLimitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WipLimitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WipLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WipLimitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WipLimit mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Task, TaskHistory, TaskTag []ent.Hook
+		AutomationRule, AutomationRun, FieldDefinition, RecurringTask, StaleThreshold,
+		TagDefinition, Task, TaskFieldValue, TaskHistory, TaskRevision, TaskTag,
+		TaskTemplate, Transition, WipLimit []ent.Hook
 	}
 	inters struct {
-		Task, TaskHistory, TaskTag []ent.Interceptor
+		AutomationRule, AutomationRun, FieldDefinition, RecurringTask, StaleThreshold,
+		TagDefinition, Task, TaskFieldValue, TaskHistory, TaskRevision, TaskTag,
+		TaskTemplate, Transition, WipLimit []ent.Interceptor
 	}
 )
@@ -12,9 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/fielddefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			task.Table:        task.ValidColumn,
-			taskhistory.Table: taskhistory.ValidColumn,
-			tasktag.Table:     tasktag.ValidColumn,
+			automationrule.Table:  automationrule.ValidColumn,
+			automationrun.Table:   automationrun.ValidColumn,
+			fielddefinition.Table: fielddefinition.ValidColumn,
+			recurringtask.Table:   recurringtask.ValidColumn,
+			stalethreshold.Table:  stalethreshold.ValidColumn,
+			tagdefinition.Table:   tagdefinition.ValidColumn,
+			task.Table:            task.ValidColumn,
+			taskfieldvalue.Table:  taskfieldvalue.ValidColumn,
+			taskhistory.Table:     taskhistory.ValidColumn,
+			taskrevision.Table:    taskrevision.ValidColumn,
+			tasktag.Table:         tasktag.ValidColumn,
+			tasktemplate.Table:    tasktemplate.ValidColumn,
+			transition.Table:      transition.ValidColumn,
+			wiplimit.Table:        wiplimit.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
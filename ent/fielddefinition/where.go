@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fielddefinition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldRequired, v))
+}
+
+// DefaultValue applies equality check predicate on the "default_value" field. It's identical to DefaultValueEQ.
+func DefaultValue(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldContainsFold(FieldKey, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldType, vs...))
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldRequired, v))
+}
+
+// DefaultValueEQ applies the EQ predicate on the "default_value" field.
+func DefaultValueEQ(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueNEQ applies the NEQ predicate on the "default_value" field.
+func DefaultValueNEQ(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueIn applies the In predicate on the "default_value" field.
+func DefaultValueIn(vs ...string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueNotIn applies the NotIn predicate on the "default_value" field.
+func DefaultValueNotIn(vs ...string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueGT applies the GT predicate on the "default_value" field.
+func DefaultValueGT(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGT(FieldDefaultValue, v))
+}
+
+// DefaultValueGTE applies the GTE predicate on the "default_value" field.
+func DefaultValueGTE(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGTE(FieldDefaultValue, v))
+}
+
+// DefaultValueLT applies the LT predicate on the "default_value" field.
+func DefaultValueLT(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLT(FieldDefaultValue, v))
+}
+
+// DefaultValueLTE applies the LTE predicate on the "default_value" field.
+func DefaultValueLTE(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLTE(FieldDefaultValue, v))
+}
+
+// DefaultValueContains applies the Contains predicate on the "default_value" field.
+func DefaultValueContains(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldContains(FieldDefaultValue, v))
+}
+
+// DefaultValueHasPrefix applies the HasPrefix predicate on the "default_value" field.
+func DefaultValueHasPrefix(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldHasPrefix(FieldDefaultValue, v))
+}
+
+// DefaultValueHasSuffix applies the HasSuffix predicate on the "default_value" field.
+func DefaultValueHasSuffix(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldHasSuffix(FieldDefaultValue, v))
+}
+
+// DefaultValueEqualFold applies the EqualFold predicate on the "default_value" field.
+func DefaultValueEqualFold(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEqualFold(FieldDefaultValue, v))
+}
+
+// DefaultValueContainsFold applies the ContainsFold predicate on the "default_value" field.
+func DefaultValueContainsFold(v string) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldContainsFold(FieldDefaultValue, v))
+}
+
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotNull(FieldOptions))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLTE(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FieldDefinition) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FieldDefinition) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FieldDefinition) predicate.FieldDefinition {
+	return predicate.FieldDefinition(sql.NotPredicates(p))
+}
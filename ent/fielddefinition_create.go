@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/fielddefinition"
+)
+
+// FieldDefinitionCreate is the builder for creating a FieldDefinition entity.
+type FieldDefinitionCreate struct {
+	config
+	mutation *FieldDefinitionMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *FieldDefinitionCreate) SetKey(v string) *FieldDefinitionCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *FieldDefinitionCreate) SetLabel(v string) *FieldDefinitionCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *FieldDefinitionCreate) SetType(v fielddefinition.Type) *FieldDefinitionCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetRequired sets the "required" field.
+func (_c *FieldDefinitionCreate) SetRequired(v bool) *FieldDefinitionCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *FieldDefinitionCreate) SetNillableRequired(v *bool) *FieldDefinitionCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (_c *FieldDefinitionCreate) SetDefaultValue(v string) *FieldDefinitionCreate {
+	_c.mutation.SetDefaultValue(v)
+	return _c
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (_c *FieldDefinitionCreate) SetNillableDefaultValue(v *string) *FieldDefinitionCreate {
+	if v != nil {
+		_c.SetDefaultValue(*v)
+	}
+	return _c
+}
+
+// SetOptions sets the "options" field.
+func (_c *FieldDefinitionCreate) SetOptions(v []string) *FieldDefinitionCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *FieldDefinitionCreate) SetPosition(v int) *FieldDefinitionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *FieldDefinitionCreate) SetNillablePosition(v *int) *FieldDefinitionCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FieldDefinitionCreate) SetCreatedAt(v time.Time) *FieldDefinitionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FieldDefinitionCreate) SetNillableCreatedAt(v *time.Time) *FieldDefinitionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FieldDefinitionMutation object of the builder.
+func (_c *FieldDefinitionCreate) Mutation() *FieldDefinitionMutation {
+	return _c.mutation
+}
+
+// Save creates the FieldDefinition in the database.
+func (_c *FieldDefinitionCreate) Save(ctx context.Context) (*FieldDefinition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FieldDefinitionCreate) SaveX(ctx context.Context) *FieldDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FieldDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FieldDefinitionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FieldDefinitionCreate) defaults() {
+	if _, ok := _c.mutation.Required(); !ok {
+		v := fielddefinition.DefaultRequired
+		_c.mutation.SetRequired(v)
+	}
+	if _, ok := _c.mutation.DefaultValue(); !ok {
+		v := fielddefinition.DefaultDefaultValue
+		_c.mutation.SetDefaultValue(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := fielddefinition.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := fielddefinition.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FieldDefinitionCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "FieldDefinition.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := fielddefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "FieldDefinition.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "FieldDefinition.label"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := fielddefinition.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "FieldDefinition.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FieldDefinition.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := fielddefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FieldDefinition.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "FieldDefinition.required"`)}
+	}
+	if _, ok := _c.mutation.DefaultValue(); !ok {
+		return &ValidationError{Name: "default_value", err: errors.New(`ent: missing required field "FieldDefinition.default_value"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "FieldDefinition.position"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FieldDefinition.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *FieldDefinitionCreate) sqlSave(ctx context.Context) (*FieldDefinition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FieldDefinitionCreate) createSpec() (*FieldDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FieldDefinition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fielddefinition.Table, sqlgraph.NewFieldSpec(fielddefinition.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(fielddefinition.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(fielddefinition.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(fielddefinition.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(fielddefinition.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if value, ok := _c.mutation.DefaultValue(); ok {
+		_spec.SetField(fielddefinition.FieldDefaultValue, field.TypeString, value)
+		_node.DefaultValue = value
+	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(fielddefinition.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(fielddefinition.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(fielddefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// FieldDefinitionCreateBulk is the builder for creating many FieldDefinition entities in bulk.
+type FieldDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*FieldDefinitionCreate
+}
+
+// Save creates the FieldDefinition entities in the database.
+func (_c *FieldDefinitionCreateBulk) Save(ctx context.Context) ([]*FieldDefinition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FieldDefinition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FieldDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FieldDefinitionCreateBulk) SaveX(ctx context.Context) []*FieldDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FieldDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FieldDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,6 +9,78 @@ import (
 	"github.com/j0hnsmith/botTaskTracker/ent"
 )
 
+// The AutomationRuleFunc type is an adapter to allow the use of ordinary
+// function as AutomationRule mutator.
+type AutomationRuleFunc func(context.Context, *ent.AutomationRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AutomationRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AutomationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AutomationRuleMutation", m)
+}
+
+// The AutomationRunFunc type is an adapter to allow the use of ordinary
+// function as AutomationRun mutator.
+type AutomationRunFunc func(context.Context, *ent.AutomationRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AutomationRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AutomationRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AutomationRunMutation", m)
+}
+
+// The FieldDefinitionFunc type is an adapter to allow the use of ordinary
+// function as FieldDefinition mutator.
+type FieldDefinitionFunc func(context.Context, *ent.FieldDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FieldDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FieldDefinitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FieldDefinitionMutation", m)
+}
+
+// The RecurringTaskFunc type is an adapter to allow the use of ordinary
+// function as RecurringTask mutator.
+type RecurringTaskFunc func(context.Context, *ent.RecurringTaskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecurringTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecurringTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecurringTaskMutation", m)
+}
+
+// The StaleThresholdFunc type is an adapter to allow the use of ordinary
+// function as StaleThreshold mutator.
+type StaleThresholdFunc func(context.Context, *ent.StaleThresholdMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StaleThresholdFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StaleThresholdMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StaleThresholdMutation", m)
+}
+
+// The TagDefinitionFunc type is an adapter to allow the use of ordinary
+// function as TagDefinition mutator.
+type TagDefinitionFunc func(context.Context, *ent.TagDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagDefinitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagDefinitionMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *ent.TaskMutation) (ent.Value, error)
@@ -21,6 +93,18 @@ func (f TaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskMutation", m)
 }
 
+// The TaskFieldValueFunc type is an adapter to allow the use of ordinary
+// function as TaskFieldValue mutator.
+type TaskFieldValueFunc func(context.Context, *ent.TaskFieldValueMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskFieldValueFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskFieldValueMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskFieldValueMutation", m)
+}
+
 // The TaskHistoryFunc type is an adapter to allow the use of ordinary
 // function as TaskHistory mutator.
 type TaskHistoryFunc func(context.Context, *ent.TaskHistoryMutation) (ent.Value, error)
@@ -33,6 +117,18 @@ func (f TaskHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskHistoryMutation", m)
 }
 
+// The TaskRevisionFunc type is an adapter to allow the use of ordinary
+// function as TaskRevision mutator.
+type TaskRevisionFunc func(context.Context, *ent.TaskRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskRevisionMutation", m)
+}
+
 // The TaskTagFunc type is an adapter to allow the use of ordinary
 // function as TaskTag mutator.
 type TaskTagFunc func(context.Context, *ent.TaskTagMutation) (ent.Value, error)
@@ -45,6 +141,42 @@ func (f TaskTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskTagMutation", m)
 }
 
+// The TaskTemplateFunc type is an adapter to allow the use of ordinary
+// function as TaskTemplate mutator.
+type TaskTemplateFunc func(context.Context, *ent.TaskTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaskTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaskTemplateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaskTemplateMutation", m)
+}
+
+// The TransitionFunc type is an adapter to allow the use of ordinary
+// function as Transition mutator.
+type TransitionFunc func(context.Context, *ent.TransitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TransitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TransitionMutation", m)
+}
+
+// The WipLimitFunc type is an adapter to allow the use of ordinary
+// function as WipLimit mutator.
+type WipLimitFunc func(context.Context, *ent.WipLimitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WipLimitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WipLimitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WipLimitMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
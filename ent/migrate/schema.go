@@ -8,6 +8,127 @@ import (
 )
 
 var (
+	// AutomationRulesColumns holds the columns for the "automation_rules" table.
+	AutomationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"created", "moved", "tagged", "due_passed", "stuck"}},
+		{Name: "trigger_arg", Type: field.TypeString, Default: ""},
+		{Name: "conditions", Type: field.TypeJSON, Nullable: true},
+		{Name: "actions", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AutomationRulesTable holds the schema information for the "automation_rules" table.
+	AutomationRulesTable = &schema.Table{
+		Name:       "automation_rules",
+		Columns:    AutomationRulesColumns,
+		PrimaryKey: []*schema.Column{AutomationRulesColumns[0]},
+	}
+	// AutomationRunsColumns holds the columns for the "automation_runs" table.
+	AutomationRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "task_id", Type: field.TypeInt},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"success", "failed", "loop_blocked"}},
+		{Name: "message", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "automation_rule_runs", Type: field.TypeInt},
+	}
+	// AutomationRunsTable holds the schema information for the "automation_runs" table.
+	AutomationRunsTable = &schema.Table{
+		Name:       "automation_runs",
+		Columns:    AutomationRunsColumns,
+		PrimaryKey: []*schema.Column{AutomationRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "automation_runs_automation_rules_runs",
+				Columns:    []*schema.Column{AutomationRunsColumns[6]},
+				RefColumns: []*schema.Column{AutomationRulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "automationrun_task_id_trigger",
+				Unique:  false,
+				Columns: []*schema.Column{AutomationRunsColumns[1], AutomationRunsColumns[2]},
+			},
+		},
+	}
+	// FieldDefinitionsColumns holds the columns for the "field_definitions" table.
+	FieldDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "label", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "number", "enum", "date", "url", "boolean"}},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "default_value", Type: field.TypeString, Default: ""},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// FieldDefinitionsTable holds the schema information for the "field_definitions" table.
+	FieldDefinitionsTable = &schema.Table{
+		Name:       "field_definitions",
+		Columns:    FieldDefinitionsColumns,
+		PrimaryKey: []*schema.Column{FieldDefinitionsColumns[0]},
+	}
+	// RecurringTasksColumns holds the columns for the "recurring_tasks" table.
+	RecurringTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "column", Type: field.TypeString, Default: "backlog"},
+		{Name: "assignee", Type: field.TypeString, Default: ""},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"none", "low", "medium", "high", "urgent"}, Default: "none"},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "rule", Type: field.TypeString},
+		{Name: "skip_if_open", Type: field.TypeBool, Default: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_task_id", Type: field.TypeInt, Nullable: true},
+		{Name: "last_result", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RecurringTasksTable holds the schema information for the "recurring_tasks" table.
+	RecurringTasksTable = &schema.Table{
+		Name:       "recurring_tasks",
+		Columns:    RecurringTasksColumns,
+		PrimaryKey: []*schema.Column{RecurringTasksColumns[0]},
+	}
+	// StaleThresholdsColumns holds the columns for the "stale_thresholds" table.
+	StaleThresholdsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "column", Type: field.TypeString, Unique: true},
+		{Name: "days", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// StaleThresholdsTable holds the schema information for the "stale_thresholds" table.
+	StaleThresholdsTable = &schema.Table{
+		Name:       "stale_thresholds",
+		Columns:    StaleThresholdsColumns,
+		PrimaryKey: []*schema.Column{StaleThresholdsColumns[0]},
+	}
+	// TagDefinitionsColumns holds the columns for the "tag_definitions" table.
+	TagDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "display_name", Type: field.TypeString, Default: ""},
+		{Name: "emoji", Type: field.TypeString, Default: ""},
+		{Name: "color", Type: field.TypeString, Default: "neutral"},
+		{Name: "allowed_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "multi_valued", Type: field.TypeBool, Default: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TagDefinitionsTable holds the schema information for the "tag_definitions" table.
+	TagDefinitionsTable = &schema.Table{
+		Name:       "tag_definitions",
+		Columns:    TagDefinitionsColumns,
+		PrimaryKey: []*schema.Column{TagDefinitionsColumns[0]},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -16,8 +137,13 @@ var (
 		{Name: "column", Type: field.TypeString, Default: "backlog"},
 		{Name: "assignee", Type: field.TypeString, Default: ""},
 		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"none", "low", "medium", "high", "urgent"}, Default: "none"},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "column_entered_at", Type: field.TypeTime, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -25,14 +151,36 @@ var (
 		Columns:    TasksColumns,
 		PrimaryKey: []*schema.Column{TasksColumns[0]},
 	}
+	// TaskFieldValuesColumns holds the columns for the "task_field_values" table.
+	TaskFieldValuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString},
+		{Name: "task_field_values", Type: field.TypeInt},
+	}
+	// TaskFieldValuesTable holds the schema information for the "task_field_values" table.
+	TaskFieldValuesTable = &schema.Table{
+		Name:       "task_field_values",
+		Columns:    TaskFieldValuesColumns,
+		PrimaryKey: []*schema.Column{TaskFieldValuesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_field_values_tasks_field_values",
+				Columns:    []*schema.Column{TaskFieldValuesColumns[3]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// TaskHistoriesColumns holds the columns for the "task_histories" table.
 	TaskHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "action", Type: field.TypeString},
 		{Name: "details", Type: field.TypeString, Nullable: true},
 		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "task_history", Type: field.TypeInt},
+		{Name: "task_history", Type: field.TypeInt, Nullable: true},
 	}
 	// TaskHistoriesTable holds the schema information for the "task_histories" table.
 	TaskHistoriesTable = &schema.Table{
@@ -42,11 +190,48 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "task_histories_tasks_history",
-				Columns:    []*schema.Column{TaskHistoriesColumns[5]},
+				Columns:    []*schema.Column{TaskHistoriesColumns[6]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TaskRevisionsColumns holds the columns for the "task_revisions" table.
+	TaskRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "column", Type: field.TypeString},
+		{Name: "assignee", Type: field.TypeString, Default: ""},
+		{Name: "priority", Type: field.TypeString, Default: "none"},
+		{Name: "due", Type: field.TypeString, Default: ""},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_revisions", Type: field.TypeInt},
+	}
+	// TaskRevisionsTable holds the schema information for the "task_revisions" table.
+	TaskRevisionsTable = &schema.Table{
+		Name:       "task_revisions",
+		Columns:    TaskRevisionsColumns,
+		PrimaryKey: []*schema.Column{TaskRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_revisions_tasks_revisions",
+				Columns:    []*schema.Column{TaskRevisionsColumns[12]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskrevision_revision_task_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{TaskRevisionsColumns[1], TaskRevisionsColumns[12]},
+			},
+		},
 	}
 	// TaskTagsColumns holds the columns for the "task_tags" table.
 	TaskTagsColumns = []*schema.Column{
@@ -70,15 +255,89 @@ var (
 			},
 		},
 	}
+	// TaskTemplatesColumns holds the columns for the "task_templates" table.
+	TaskTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "title", Type: field.TypeString, Default: ""},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "column", Type: field.TypeString, Default: "backlog"},
+		{Name: "assignee", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TaskTemplatesTable holds the schema information for the "task_templates" table.
+	TaskTemplatesTable = &schema.Table{
+		Name:       "task_templates",
+		Columns:    TaskTemplatesColumns,
+		PrimaryKey: []*schema.Column{TaskTemplatesColumns[0]},
+	}
+	// TransitionsColumns holds the columns for the "transitions" table.
+	TransitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_column", Type: field.TypeString},
+		{Name: "to_column", Type: field.TypeString},
+		{Name: "guards", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TransitionsTable holds the schema information for the "transitions" table.
+	TransitionsTable = &schema.Table{
+		Name:       "transitions",
+		Columns:    TransitionsColumns,
+		PrimaryKey: []*schema.Column{TransitionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transition_from_column_to_column",
+				Unique:  true,
+				Columns: []*schema.Column{TransitionsColumns[1], TransitionsColumns[2]},
+			},
+		},
+	}
+	// WipLimitsColumns holds the columns for the "wip_limits" table.
+	WipLimitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "column", Type: field.TypeString},
+		{Name: "assignee", Type: field.TypeString, Default: ""},
+		{Name: "max_tasks", Type: field.TypeInt},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"warn", "soft", "hard"}, Default: "warn"},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WipLimitsTable holds the schema information for the "wip_limits" table.
+	WipLimitsTable = &schema.Table{
+		Name:       "wip_limits",
+		Columns:    WipLimitsColumns,
+		PrimaryKey: []*schema.Column{WipLimitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wiplimit_column_assignee",
+				Unique:  true,
+				Columns: []*schema.Column{WipLimitsColumns[1], WipLimitsColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AutomationRulesTable,
+		AutomationRunsTable,
+		FieldDefinitionsTable,
+		RecurringTasksTable,
+		StaleThresholdsTable,
+		TagDefinitionsTable,
 		TasksTable,
+		TaskFieldValuesTable,
 		TaskHistoriesTable,
+		TaskRevisionsTable,
 		TaskTagsTable,
+		TaskTemplatesTable,
+		TransitionsTable,
+		WipLimitsTable,
 	}
 )
 
 func init() {
+	AutomationRunsTable.ForeignKeys[0].RefTable = AutomationRulesTable
+	TaskFieldValuesTable.ForeignKeys[0].RefTable = TasksTable
 	TaskHistoriesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskRevisionsTable.ForeignKeys[0].RefTable = TasksTable
 	TaskTagsTable.ForeignKeys[0].RefTable = TasksTable
 }
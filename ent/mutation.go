@@ -11,10 +11,22 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/fielddefinition"
 	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+	"github.com/j0hnsmith/botTaskTracker/history"
 )
 
 const (
@@ -26,48 +38,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeTask        = "Task"
-	TypeTaskHistory = "TaskHistory"
-	TypeTaskTag     = "TaskTag"
+	TypeAutomationRule  = "AutomationRule"
+	TypeAutomationRun   = "AutomationRun"
+	TypeFieldDefinition = "FieldDefinition"
+	TypeRecurringTask   = "RecurringTask"
+	TypeStaleThreshold  = "StaleThreshold"
+	TypeTagDefinition   = "TagDefinition"
+	TypeTask            = "Task"
+	TypeTaskFieldValue  = "TaskFieldValue"
+	TypeTaskHistory     = "TaskHistory"
+	TypeTaskRevision    = "TaskRevision"
+	TypeTaskTag         = "TaskTag"
+	TypeTaskTemplate    = "TaskTemplate"
+	TypeTransition      = "Transition"
+	TypeWipLimit        = "WipLimit"
 )
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
+// AutomationRuleMutation represents an operation that mutates the AutomationRule nodes in the graph.
+type AutomationRuleMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	title          *string
-	description    *string
-	column         *string
-	assignee       *string
-	position       *int
-	addposition    *int
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	tags           map[int]struct{}
-	removedtags    map[int]struct{}
-	clearedtags    bool
-	history        map[int]struct{}
-	removedhistory map[int]struct{}
-	clearedhistory bool
-	done           bool
-	oldValue       func(context.Context) (*Task, error)
-	predicates     []predicate.Task
-}
-
-var _ ent.Mutation = (*TaskMutation)(nil)
-
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
-
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	trigger          *automationrule.Trigger
+	trigger_arg      *string
+	conditions       *[]string
+	appendconditions []string
+	actions          *[]string
+	appendactions    []string
+	enabled          *bool
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	runs             map[int]struct{}
+	removedruns      map[int]struct{}
+	clearedruns      bool
+	done             bool
+	oldValue         func(context.Context) (*AutomationRule, error)
+	predicates       []predicate.AutomationRule
+}
+
+var _ ent.Mutation = (*AutomationRuleMutation)(nil)
+
+// automationruleOption allows management of the mutation configuration using functional options.
+type automationruleOption func(*AutomationRuleMutation)
+
+// newAutomationRuleMutation creates new mutation for the AutomationRule entity.
+func newAutomationRuleMutation(c config, op Op, opts ...automationruleOption) *AutomationRuleMutation {
+	m := &AutomationRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTask,
+		typ:           TypeAutomationRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -76,20 +97,20 @@ func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
 	return m
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id int) taskOption {
-	return func(m *TaskMutation) {
+// withAutomationRuleID sets the ID field of the mutation.
+func withAutomationRuleID(id int) automationruleOption {
+	return func(m *AutomationRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Task
+			value *AutomationRule
 		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
+		m.oldValue = func(ctx context.Context) (*AutomationRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Task.Get(ctx, id)
+					value, err = m.Client().AutomationRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -98,10 +119,10 @@ func withTaskID(id int) taskOption {
 	}
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
+// withAutomationRule sets the old AutomationRule of the mutation.
+func withAutomationRule(node *AutomationRule) automationruleOption {
+	return func(m *AutomationRuleMutation) {
+		m.oldValue = func(context.Context) (*AutomationRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -110,7 +131,7 @@ func withTask(node *Task) taskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
+func (m AutomationRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -118,7 +139,7 @@ func (m TaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
+func (m AutomationRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -129,7 +150,7 @@ func (m TaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id int, exists bool) {
+func (m *AutomationRuleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -140,7 +161,7 @@ func (m *TaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AutomationRuleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -149,460 +170,417 @@ func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AutomationRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *TaskMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *AutomationRuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *TaskMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *AutomationRuleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AutomationRuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *TaskMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *AutomationRuleMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TaskMutation) SetDescription(s string) {
-	m.description = &s
+// SetTrigger sets the "trigger" field.
+func (m *AutomationRuleMutation) SetTrigger(a automationrule.Trigger) {
+	m.trigger = &a
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TaskMutation) Description() (r string, exists bool) {
-	v := m.description
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *AutomationRuleMutation) Trigger() (r automationrule.Trigger, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AutomationRuleMutation) OldTrigger(ctx context.Context) (v automationrule.Trigger, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *TaskMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[task.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[task.FieldDescription]
-	return ok
+	return oldValue.Trigger, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TaskMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, task.FieldDescription)
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *AutomationRuleMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// SetColumn sets the "column" field.
-func (m *TaskMutation) SetColumn(s string) {
-	m.column = &s
+// SetTriggerArg sets the "trigger_arg" field.
+func (m *AutomationRuleMutation) SetTriggerArg(s string) {
+	m.trigger_arg = &s
 }
 
-// Column returns the value of the "column" field in the mutation.
-func (m *TaskMutation) Column() (r string, exists bool) {
-	v := m.column
+// TriggerArg returns the value of the "trigger_arg" field in the mutation.
+func (m *AutomationRuleMutation) TriggerArg() (r string, exists bool) {
+	v := m.trigger_arg
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColumn returns the old "column" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggerArg returns the old "trigger_arg" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldColumn(ctx context.Context) (v string, err error) {
+func (m *AutomationRuleMutation) OldTriggerArg(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggerArg is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColumn requires an ID field in the mutation")
+		return v, errors.New("OldTriggerArg requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggerArg: %w", err)
 	}
-	return oldValue.Column, nil
+	return oldValue.TriggerArg, nil
 }
 
-// ResetColumn resets all changes to the "column" field.
-func (m *TaskMutation) ResetColumn() {
-	m.column = nil
+// ResetTriggerArg resets all changes to the "trigger_arg" field.
+func (m *AutomationRuleMutation) ResetTriggerArg() {
+	m.trigger_arg = nil
 }
 
-// SetAssignee sets the "assignee" field.
-func (m *TaskMutation) SetAssignee(s string) {
-	m.assignee = &s
+// SetConditions sets the "conditions" field.
+func (m *AutomationRuleMutation) SetConditions(s []string) {
+	m.conditions = &s
+	m.appendconditions = nil
 }
 
-// Assignee returns the value of the "assignee" field in the mutation.
-func (m *TaskMutation) Assignee() (r string, exists bool) {
-	v := m.assignee
+// Conditions returns the value of the "conditions" field in the mutation.
+func (m *AutomationRuleMutation) Conditions() (r []string, exists bool) {
+	v := m.conditions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAssignee returns the old "assignee" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldConditions returns the old "conditions" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldAssignee(ctx context.Context) (v string, err error) {
+func (m *AutomationRuleMutation) OldConditions(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+		return v, errors.New("OldConditions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignee requires an ID field in the mutation")
+		return v, errors.New("OldConditions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+		return v, fmt.Errorf("querying old value for OldConditions: %w", err)
 	}
-	return oldValue.Assignee, nil
+	return oldValue.Conditions, nil
 }
 
-// ResetAssignee resets all changes to the "assignee" field.
-func (m *TaskMutation) ResetAssignee() {
-	m.assignee = nil
+// AppendConditions adds s to the "conditions" field.
+func (m *AutomationRuleMutation) AppendConditions(s []string) {
+	m.appendconditions = append(m.appendconditions, s...)
 }
 
-// SetPosition sets the "position" field.
-func (m *TaskMutation) SetPosition(i int) {
-	m.position = &i
-	m.addposition = nil
+// AppendedConditions returns the list of values that were appended to the "conditions" field in this mutation.
+func (m *AutomationRuleMutation) AppendedConditions() ([]string, bool) {
+	if len(m.appendconditions) == 0 {
+		return nil, false
+	}
+	return m.appendconditions, true
 }
 
-// Position returns the value of the "position" field in the mutation.
-func (m *TaskMutation) Position() (r int, exists bool) {
-	v := m.position
+// ClearConditions clears the value of the "conditions" field.
+func (m *AutomationRuleMutation) ClearConditions() {
+	m.conditions = nil
+	m.appendconditions = nil
+	m.clearedFields[automationrule.FieldConditions] = struct{}{}
+}
+
+// ConditionsCleared returns if the "conditions" field was cleared in this mutation.
+func (m *AutomationRuleMutation) ConditionsCleared() bool {
+	_, ok := m.clearedFields[automationrule.FieldConditions]
+	return ok
+}
+
+// ResetConditions resets all changes to the "conditions" field.
+func (m *AutomationRuleMutation) ResetConditions() {
+	m.conditions = nil
+	m.appendconditions = nil
+	delete(m.clearedFields, automationrule.FieldConditions)
+}
+
+// SetActions sets the "actions" field.
+func (m *AutomationRuleMutation) SetActions(s []string) {
+	m.actions = &s
+	m.appendactions = nil
+}
+
+// Actions returns the value of the "actions" field in the mutation.
+func (m *AutomationRuleMutation) Actions() (r []string, exists bool) {
+	v := m.actions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosition returns the old "position" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldActions returns the old "actions" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPosition(ctx context.Context) (v int, err error) {
+func (m *AutomationRuleMutation) OldActions(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+		return v, errors.New("OldActions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosition requires an ID field in the mutation")
+		return v, errors.New("OldActions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+		return v, fmt.Errorf("querying old value for OldActions: %w", err)
 	}
-	return oldValue.Position, nil
+	return oldValue.Actions, nil
 }
 
-// AddPosition adds i to the "position" field.
-func (m *TaskMutation) AddPosition(i int) {
-	if m.addposition != nil {
-		*m.addposition += i
-	} else {
-		m.addposition = &i
-	}
+// AppendActions adds s to the "actions" field.
+func (m *AutomationRuleMutation) AppendActions(s []string) {
+	m.appendactions = append(m.appendactions, s...)
 }
 
-// AddedPosition returns the value that was added to the "position" field in this mutation.
-func (m *TaskMutation) AddedPosition() (r int, exists bool) {
-	v := m.addposition
-	if v == nil {
-		return
+// AppendedActions returns the list of values that were appended to the "actions" field in this mutation.
+func (m *AutomationRuleMutation) AppendedActions() ([]string, bool) {
+	if len(m.appendactions) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendactions, true
 }
 
-// ResetPosition resets all changes to the "position" field.
-func (m *TaskMutation) ResetPosition() {
-	m.position = nil
-	m.addposition = nil
+// ResetActions resets all changes to the "actions" field.
+func (m *AutomationRuleMutation) ResetActions() {
+	m.actions = nil
+	m.appendactions = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TaskMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEnabled sets the "enabled" field.
+func (m *AutomationRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *AutomationRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AutomationRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Enabled, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *AutomationRuleMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TaskMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *AutomationRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AutomationRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AutomationRule entity.
+// If the AutomationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AutomationRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TaskMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AutomationRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddTagIDs adds the "tags" edge to the TaskTag entity by ids.
-func (m *TaskMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+// AddRunIDs adds the "runs" edge to the AutomationRun entity by ids.
+func (m *AutomationRuleMutation) AddRunIDs(ids ...int) {
+	if m.runs == nil {
+		m.runs = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+		m.runs[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTags clears the "tags" edge to the TaskTag entity.
-func (m *TaskMutation) ClearTags() {
-	m.clearedtags = true
+// ClearRuns clears the "runs" edge to the AutomationRun entity.
+func (m *AutomationRuleMutation) ClearRuns() {
+	m.clearedruns = true
 }
 
-// TagsCleared reports if the "tags" edge to the TaskTag entity was cleared.
-func (m *TaskMutation) TagsCleared() bool {
-	return m.clearedtags
+// RunsCleared reports if the "runs" edge to the AutomationRun entity was cleared.
+func (m *AutomationRuleMutation) RunsCleared() bool {
+	return m.clearedruns
 }
 
-// RemoveTagIDs removes the "tags" edge to the TaskTag entity by IDs.
-func (m *TaskMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// RemoveRunIDs removes the "runs" edge to the AutomationRun entity by IDs.
+func (m *AutomationRuleMutation) RemoveRunIDs(ids ...int) {
+	if m.removedruns == nil {
+		m.removedruns = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+		delete(m.runs, ids[i])
+		m.removedruns[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the TaskTag entity.
-func (m *TaskMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
+// RemovedRuns returns the removed IDs of the "runs" edge to the AutomationRun entity.
+func (m *AutomationRuleMutation) RemovedRunsIDs() (ids []int) {
+	for id := range m.removedruns {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *TaskMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
+// RunsIDs returns the "runs" edge IDs in the mutation.
+func (m *AutomationRuleMutation) RunsIDs() (ids []int) {
+	for id := range m.runs {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *TaskMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetRuns resets all changes to the "runs" edge.
+func (m *AutomationRuleMutation) ResetRuns() {
+	m.runs = nil
+	m.clearedruns = false
+	m.removedruns = nil
 }
 
-// AddHistoryIDs adds the "history" edge to the TaskHistory entity by ids.
-func (m *TaskMutation) AddHistoryIDs(ids ...int) {
-	if m.history == nil {
-		m.history = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.history[ids[i]] = struct{}{}
-	}
+// Where appends a list predicates to the AutomationRuleMutation builder.
+func (m *AutomationRuleMutation) Where(ps ...predicate.AutomationRule) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearHistory clears the "history" edge to the TaskHistory entity.
-func (m *TaskMutation) ClearHistory() {
-	m.clearedhistory = true
+// WhereP appends storage-level predicates to the AutomationRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AutomationRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AutomationRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// HistoryCleared reports if the "history" edge to the TaskHistory entity was cleared.
-func (m *TaskMutation) HistoryCleared() bool {
-	return m.clearedhistory
+// Op returns the operation name.
+func (m *AutomationRuleMutation) Op() Op {
+	return m.op
 }
 
-// RemoveHistoryIDs removes the "history" edge to the TaskHistory entity by IDs.
-func (m *TaskMutation) RemoveHistoryIDs(ids ...int) {
-	if m.removedhistory == nil {
-		m.removedhistory = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.history, ids[i])
-		m.removedhistory[ids[i]] = struct{}{}
-	}
+// SetOp allows setting the mutation operation.
+func (m *AutomationRuleMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemovedHistory returns the removed IDs of the "history" edge to the TaskHistory entity.
-func (m *TaskMutation) RemovedHistoryIDs() (ids []int) {
-	for id := range m.removedhistory {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// HistoryIDs returns the "history" edge IDs in the mutation.
-func (m *TaskMutation) HistoryIDs() (ids []int) {
-	for id := range m.history {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetHistory resets all changes to the "history" edge.
-func (m *TaskMutation) ResetHistory() {
-	m.history = nil
-	m.clearedhistory = false
-	m.removedhistory = nil
-}
-
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *TaskMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
-	return m.typ
+// Type returns the node type of this mutation (AutomationRule).
+func (m *AutomationRuleMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
+func (m *AutomationRuleMutation) Fields() []string {
 	fields := make([]string, 0, 7)
-	if m.title != nil {
-		fields = append(fields, task.FieldTitle)
+	if m.name != nil {
+		fields = append(fields, automationrule.FieldName)
 	}
-	if m.description != nil {
-		fields = append(fields, task.FieldDescription)
+	if m.trigger != nil {
+		fields = append(fields, automationrule.FieldTrigger)
 	}
-	if m.column != nil {
-		fields = append(fields, task.FieldColumn)
+	if m.trigger_arg != nil {
+		fields = append(fields, automationrule.FieldTriggerArg)
 	}
-	if m.assignee != nil {
-		fields = append(fields, task.FieldAssignee)
+	if m.conditions != nil {
+		fields = append(fields, automationrule.FieldConditions)
 	}
-	if m.position != nil {
-		fields = append(fields, task.FieldPosition)
+	if m.actions != nil {
+		fields = append(fields, automationrule.FieldActions)
 	}
-	if m.created_at != nil {
-		fields = append(fields, task.FieldCreatedAt)
+	if m.enabled != nil {
+		fields = append(fields, automationrule.FieldEnabled)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, task.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, automationrule.FieldCreatedAt)
 	}
 	return fields
 }
@@ -610,22 +588,22 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *AutomationRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldTitle:
-		return m.Title()
-	case task.FieldDescription:
-		return m.Description()
-	case task.FieldColumn:
-		return m.Column()
-	case task.FieldAssignee:
-		return m.Assignee()
-	case task.FieldPosition:
-		return m.Position()
-	case task.FieldCreatedAt:
+	case automationrule.FieldName:
+		return m.Name()
+	case automationrule.FieldTrigger:
+		return m.Trigger()
+	case automationrule.FieldTriggerArg:
+		return m.TriggerArg()
+	case automationrule.FieldConditions:
+		return m.Conditions()
+	case automationrule.FieldActions:
+		return m.Actions()
+	case automationrule.FieldEnabled:
+		return m.Enabled()
+	case automationrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case task.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -633,203 +611,179 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AutomationRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case task.FieldTitle:
-		return m.OldTitle(ctx)
-	case task.FieldDescription:
-		return m.OldDescription(ctx)
-	case task.FieldColumn:
-		return m.OldColumn(ctx)
-	case task.FieldAssignee:
-		return m.OldAssignee(ctx)
-	case task.FieldPosition:
-		return m.OldPosition(ctx)
-	case task.FieldCreatedAt:
+	case automationrule.FieldName:
+		return m.OldName(ctx)
+	case automationrule.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case automationrule.FieldTriggerArg:
+		return m.OldTriggerArg(ctx)
+	case automationrule.FieldConditions:
+		return m.OldConditions(ctx)
+	case automationrule.FieldActions:
+		return m.OldActions(ctx)
+	case automationrule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case automationrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case task.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown AutomationRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *AutomationRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldTitle:
+	case automationrule.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case task.FieldDescription:
-		v, ok := value.(string)
+	case automationrule.FieldTrigger:
+		v, ok := value.(automationrule.Trigger)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTrigger(v)
 		return nil
-	case task.FieldColumn:
+	case automationrule.FieldTriggerArg:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColumn(v)
+		m.SetTriggerArg(v)
 		return nil
-	case task.FieldAssignee:
-		v, ok := value.(string)
+	case automationrule.FieldConditions:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssignee(v)
+		m.SetConditions(v)
 		return nil
-	case task.FieldPosition:
-		v, ok := value.(int)
+	case automationrule.FieldActions:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosition(v)
+		m.SetActions(v)
 		return nil
-	case task.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case automationrule.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetEnabled(v)
 		return nil
-	case task.FieldUpdatedAt:
+	case automationrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown AutomationRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
-	var fields []string
-	if m.addposition != nil {
-		fields = append(fields, task.FieldPosition)
-	}
-	return fields
+func (m *AutomationRuleMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case task.FieldPosition:
-		return m.AddedPosition()
-	}
+func (m *AutomationRuleMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *AutomationRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldPosition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPosition(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown AutomationRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *AutomationRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldDescription) {
-		fields = append(fields, task.FieldDescription)
+	if m.FieldCleared(automationrule.FieldConditions) {
+		fields = append(fields, automationrule.FieldConditions)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *AutomationRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *AutomationRuleMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldDescription:
-		m.ClearDescription()
+	case automationrule.FieldConditions:
+		m.ClearConditions()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown AutomationRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *AutomationRuleMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldTitle:
-		m.ResetTitle()
+	case automationrule.FieldName:
+		m.ResetName()
 		return nil
-	case task.FieldDescription:
-		m.ResetDescription()
+	case automationrule.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case task.FieldColumn:
-		m.ResetColumn()
+	case automationrule.FieldTriggerArg:
+		m.ResetTriggerArg()
 		return nil
-	case task.FieldAssignee:
-		m.ResetAssignee()
+	case automationrule.FieldConditions:
+		m.ResetConditions()
 		return nil
-	case task.FieldPosition:
-		m.ResetPosition()
+	case automationrule.FieldActions:
+		m.ResetActions()
 		return nil
-	case task.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case automationrule.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case task.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case automationrule.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown AutomationRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.tags != nil {
-		edges = append(edges, task.EdgeTags)
-	}
-	if m.history != nil {
-		edges = append(edges, task.EdgeHistory)
+func (m *AutomationRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.runs != nil {
+		edges = append(edges, automationrule.EdgeRuns)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *AutomationRuleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
-			ids = append(ids, id)
-		}
-		return ids
-	case task.EdgeHistory:
-		ids := make([]ent.Value, 0, len(m.history))
-		for id := range m.history {
+	case automationrule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.runs))
+		for id := range m.runs {
 			ids = append(ids, id)
 		}
 		return ids
@@ -838,30 +792,21 @@ func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtags != nil {
-		edges = append(edges, task.EdgeTags)
-	}
-	if m.removedhistory != nil {
-		edges = append(edges, task.EdgeHistory)
+func (m *AutomationRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedruns != nil {
+		edges = append(edges, automationrule.EdgeRuns)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *AutomationRuleMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
-			ids = append(ids, id)
-		}
-		return ids
-	case task.EdgeHistory:
-		ids := make([]ent.Value, 0, len(m.removedhistory))
-		for id := range m.removedhistory {
+	case automationrule.EdgeRuns:
+		ids := make([]ent.Value, 0, len(m.removedruns))
+		for id := range m.removedruns {
 			ids = append(ids, id)
 		}
 		return ids
@@ -870,80 +815,74 @@ func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtags {
-		edges = append(edges, task.EdgeTags)
-	}
-	if m.clearedhistory {
-		edges = append(edges, task.EdgeHistory)
+func (m *AutomationRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedruns {
+		edges = append(edges, automationrule.EdgeRuns)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *AutomationRuleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeTags:
-		return m.clearedtags
-	case task.EdgeHistory:
-		return m.clearedhistory
+	case automationrule.EdgeRuns:
+		return m.clearedruns
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *AutomationRuleMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown AutomationRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *AutomationRuleMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeTags:
-		m.ResetTags()
-		return nil
-	case task.EdgeHistory:
-		m.ResetHistory()
+	case automationrule.EdgeRuns:
+		m.ResetRuns()
 		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown AutomationRule edge %s", name)
 }
 
-// TaskHistoryMutation represents an operation that mutates the TaskHistory nodes in the graph.
-type TaskHistoryMutation struct {
+// AutomationRunMutation represents an operation that mutates the AutomationRun nodes in the graph.
+type AutomationRunMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	action        *string
-	details       *string
-	actor         *string
+	task_id       *int
+	addtask_id    *int
+	trigger       *string
+	status        *automationrun.Status
+	message       *string
 	created_at    *time.Time
 	clearedFields map[string]struct{}
-	task          *int
-	clearedtask   bool
+	rule          *int
+	clearedrule   bool
 	done          bool
-	oldValue      func(context.Context) (*TaskHistory, error)
-	predicates    []predicate.TaskHistory
+	oldValue      func(context.Context) (*AutomationRun, error)
+	predicates    []predicate.AutomationRun
 }
 
-var _ ent.Mutation = (*TaskHistoryMutation)(nil)
+var _ ent.Mutation = (*AutomationRunMutation)(nil)
 
-// taskhistoryOption allows management of the mutation configuration using functional options.
-type taskhistoryOption func(*TaskHistoryMutation)
+// automationrunOption allows management of the mutation configuration using functional options.
+type automationrunOption func(*AutomationRunMutation)
 
-// newTaskHistoryMutation creates new mutation for the TaskHistory entity.
-func newTaskHistoryMutation(c config, op Op, opts ...taskhistoryOption) *TaskHistoryMutation {
-	m := &TaskHistoryMutation{
+// newAutomationRunMutation creates new mutation for the AutomationRun entity.
+func newAutomationRunMutation(c config, op Op, opts ...automationrunOption) *AutomationRunMutation {
+	m := &AutomationRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTaskHistory,
+		typ:           TypeAutomationRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -952,20 +891,20 @@ func newTaskHistoryMutation(c config, op Op, opts ...taskhistoryOption) *TaskHis
 	return m
 }
 
-// withTaskHistoryID sets the ID field of the mutation.
-func withTaskHistoryID(id int) taskhistoryOption {
-	return func(m *TaskHistoryMutation) {
+// withAutomationRunID sets the ID field of the mutation.
+func withAutomationRunID(id int) automationrunOption {
+	return func(m *AutomationRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TaskHistory
+			value *AutomationRun
 		)
-		m.oldValue = func(ctx context.Context) (*TaskHistory, error) {
+		m.oldValue = func(ctx context.Context) (*AutomationRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TaskHistory.Get(ctx, id)
+					value, err = m.Client().AutomationRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -974,10 +913,10 @@ func withTaskHistoryID(id int) taskhistoryOption {
 	}
 }
 
-// withTaskHistory sets the old TaskHistory of the mutation.
-func withTaskHistory(node *TaskHistory) taskhistoryOption {
-	return func(m *TaskHistoryMutation) {
-		m.oldValue = func(context.Context) (*TaskHistory, error) {
+// withAutomationRun sets the old AutomationRun of the mutation.
+func withAutomationRun(node *AutomationRun) automationrunOption {
+	return func(m *AutomationRunMutation) {
+		m.oldValue = func(context.Context) (*AutomationRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -986,7 +925,7 @@ func withTaskHistory(node *TaskHistory) taskhistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskHistoryMutation) Client() *Client {
+func (m AutomationRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -994,7 +933,7 @@ func (m TaskHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskHistoryMutation) Tx() (*Tx, error) {
+func (m AutomationRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1005,7 +944,7 @@ func (m TaskHistoryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskHistoryMutation) ID() (id int, exists bool) {
+func (m *AutomationRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1016,7 +955,7 @@ func (m *TaskHistoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AutomationRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1025,140 +964,183 @@ func (m *TaskHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TaskHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AutomationRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAction sets the "action" field.
-func (m *TaskHistoryMutation) SetAction(s string) {
-	m.action = &s
+// SetTaskID sets the "task_id" field.
+func (m *AutomationRunMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *TaskHistoryMutation) Action() (r string, exists bool) {
-	v := m.action
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *AutomationRunMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the TaskHistory entity.
-// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the AutomationRun entity.
+// If the AutomationRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskHistoryMutation) OldAction(ctx context.Context) (v string, err error) {
+func (m *AutomationRunMutation) OldTaskID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *TaskHistoryMutation) ResetAction() {
-	m.action = nil
+// AddTaskID adds i to the "task_id" field.
+func (m *AutomationRunMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
 }
 
-// SetDetails sets the "details" field.
-func (m *TaskHistoryMutation) SetDetails(s string) {
-	m.details = &s
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *AutomationRunMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Details returns the value of the "details" field in the mutation.
-func (m *TaskHistoryMutation) Details() (r string, exists bool) {
-	v := m.details
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *AutomationRunMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *AutomationRunMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *AutomationRunMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDetails returns the old "details" field's value of the TaskHistory entity.
-// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the AutomationRun entity.
+// If the AutomationRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskHistoryMutation) OldDetails(ctx context.Context) (v string, err error) {
+func (m *AutomationRunMutation) OldTrigger(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDetails requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.Details, nil
+	return oldValue.Trigger, nil
 }
 
-// ClearDetails clears the value of the "details" field.
-func (m *TaskHistoryMutation) ClearDetails() {
-	m.details = nil
-	m.clearedFields[taskhistory.FieldDetails] = struct{}{}
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *AutomationRunMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// DetailsCleared returns if the "details" field was cleared in this mutation.
-func (m *TaskHistoryMutation) DetailsCleared() bool {
-	_, ok := m.clearedFields[taskhistory.FieldDetails]
-	return ok
+// SetStatus sets the "status" field.
+func (m *AutomationRunMutation) SetStatus(a automationrun.Status) {
+	m.status = &a
 }
 
-// ResetDetails resets all changes to the "details" field.
-func (m *TaskHistoryMutation) ResetDetails() {
-	m.details = nil
-	delete(m.clearedFields, taskhistory.FieldDetails)
+// Status returns the value of the "status" field in the mutation.
+func (m *AutomationRunMutation) Status() (r automationrun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetActor sets the "actor" field.
-func (m *TaskHistoryMutation) SetActor(s string) {
-	m.actor = &s
+// OldStatus returns the old "status" field's value of the AutomationRun entity.
+// If the AutomationRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AutomationRunMutation) OldStatus(ctx context.Context) (v automationrun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// Actor returns the value of the "actor" field in the mutation.
-func (m *TaskHistoryMutation) Actor() (r string, exists bool) {
-	v := m.actor
+// ResetStatus resets all changes to the "status" field.
+func (m *AutomationRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *AutomationRunMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *AutomationRunMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActor returns the old "actor" field's value of the TaskHistory entity.
-// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the AutomationRun entity.
+// If the AutomationRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+func (m *AutomationRunMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActor requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Actor, nil
+	return oldValue.Message, nil
 }
 
-// ResetActor resets all changes to the "actor" field.
-func (m *TaskHistoryMutation) ResetActor() {
-	m.actor = nil
+// ResetMessage resets all changes to the "message" field.
+func (m *AutomationRunMutation) ResetMessage() {
+	m.message = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TaskHistoryMutation) SetCreatedAt(t time.Time) {
+func (m *AutomationRunMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AutomationRunMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1166,10 +1148,10 @@ func (m *TaskHistoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TaskHistory entity.
-// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AutomationRun entity.
+// If the AutomationRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AutomationRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1184,58 +1166,58 @@ func (m *TaskHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskHistoryMutation) ResetCreatedAt() {
+func (m *AutomationRunMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetTaskID sets the "task" edge to the Task entity by id.
-func (m *TaskHistoryMutation) SetTaskID(id int) {
-	m.task = &id
+// SetRuleID sets the "rule" edge to the AutomationRule entity by id.
+func (m *AutomationRunMutation) SetRuleID(id int) {
+	m.rule = &id
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *TaskHistoryMutation) ClearTask() {
-	m.clearedtask = true
+// ClearRule clears the "rule" edge to the AutomationRule entity.
+func (m *AutomationRunMutation) ClearRule() {
+	m.clearedrule = true
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *TaskHistoryMutation) TaskCleared() bool {
-	return m.clearedtask
+// RuleCleared reports if the "rule" edge to the AutomationRule entity was cleared.
+func (m *AutomationRunMutation) RuleCleared() bool {
+	return m.clearedrule
 }
 
-// TaskID returns the "task" edge ID in the mutation.
-func (m *TaskHistoryMutation) TaskID() (id int, exists bool) {
-	if m.task != nil {
-		return *m.task, true
+// RuleID returns the "rule" edge ID in the mutation.
+func (m *AutomationRunMutation) RuleID() (id int, exists bool) {
+	if m.rule != nil {
+		return *m.rule, true
 	}
 	return
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
+// RuleIDs returns the "rule" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *TaskHistoryMutation) TaskIDs() (ids []int) {
-	if id := m.task; id != nil {
+// RuleID instead. It exists only for internal usage by the builders.
+func (m *AutomationRunMutation) RuleIDs() (ids []int) {
+	if id := m.rule; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *TaskHistoryMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ResetRule resets all changes to the "rule" edge.
+func (m *AutomationRunMutation) ResetRule() {
+	m.rule = nil
+	m.clearedrule = false
 }
 
-// Where appends a list predicates to the TaskHistoryMutation builder.
-func (m *TaskHistoryMutation) Where(ps ...predicate.TaskHistory) {
+// Where appends a list predicates to the AutomationRunMutation builder.
+func (m *AutomationRunMutation) Where(ps ...predicate.AutomationRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskHistoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AutomationRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TaskHistory, len(ps))
+func (m *AutomationRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AutomationRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1243,36 +1225,39 @@ func (m *TaskHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskHistoryMutation) Op() Op {
+func (m *AutomationRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskHistoryMutation) SetOp(op Op) {
+func (m *AutomationRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TaskHistory).
-func (m *TaskHistoryMutation) Type() string {
+// Type returns the node type of this mutation (AutomationRun).
+func (m *AutomationRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.action != nil {
-		fields = append(fields, taskhistory.FieldAction)
+func (m *AutomationRunMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.task_id != nil {
+		fields = append(fields, automationrun.FieldTaskID)
 	}
-	if m.details != nil {
-		fields = append(fields, taskhistory.FieldDetails)
+	if m.trigger != nil {
+		fields = append(fields, automationrun.FieldTrigger)
 	}
-	if m.actor != nil {
-		fields = append(fields, taskhistory.FieldActor)
+	if m.status != nil {
+		fields = append(fields, automationrun.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, automationrun.FieldMessage)
 	}
 	if m.created_at != nil {
-		fields = append(fields, taskhistory.FieldCreatedAt)
+		fields = append(fields, automationrun.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1280,15 +1265,17 @@ func (m *TaskHistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *AutomationRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case taskhistory.FieldAction:
-		return m.Action()
-	case taskhistory.FieldDetails:
-		return m.Details()
-	case taskhistory.FieldActor:
-		return m.Actor()
-	case taskhistory.FieldCreatedAt:
+	case automationrun.FieldTaskID:
+		return m.TaskID()
+	case automationrun.FieldTrigger:
+		return m.Trigger()
+	case automationrun.FieldStatus:
+		return m.Status()
+	case automationrun.FieldMessage:
+		return m.Message()
+	case automationrun.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1297,47 +1284,56 @@ func (m *TaskHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AutomationRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case taskhistory.FieldAction:
-		return m.OldAction(ctx)
-	case taskhistory.FieldDetails:
-		return m.OldDetails(ctx)
-	case taskhistory.FieldActor:
-		return m.OldActor(ctx)
-	case taskhistory.FieldCreatedAt:
+	case automationrun.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case automationrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case automationrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case automationrun.FieldMessage:
+		return m.OldMessage(ctx)
+	case automationrun.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TaskHistory field %s", name)
+	return nil, fmt.Errorf("unknown AutomationRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *AutomationRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case taskhistory.FieldAction:
-		v, ok := value.(string)
+	case automationrun.FieldTaskID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAction(v)
+		m.SetTaskID(v)
 		return nil
-	case taskhistory.FieldDetails:
+	case automationrun.FieldTrigger:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDetails(v)
+		m.SetTrigger(v)
 		return nil
-	case taskhistory.FieldActor:
+	case automationrun.FieldStatus:
+		v, ok := value.(automationrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case automationrun.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActor(v)
+		m.SetMessage(v)
 		return nil
-	case taskhistory.FieldCreatedAt:
+	case automationrun.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1345,94 +1341,103 @@ func (m *TaskHistoryMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TaskHistory field %s", name)
+	return fmt.Errorf("unknown AutomationRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskHistoryMutation) AddedFields() []string {
-	return nil
+func (m *AutomationRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, automationrun.FieldTaskID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskHistoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AutomationRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case automationrun.FieldTaskID:
+		return m.AddedTaskID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *AutomationRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case automationrun.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown TaskHistory numeric field %s", name)
+	return fmt.Errorf("unknown AutomationRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskHistoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(taskhistory.FieldDetails) {
-		fields = append(fields, taskhistory.FieldDetails)
-	}
-	return fields
+func (m *AutomationRunMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskHistoryMutation) FieldCleared(name string) bool {
+func (m *AutomationRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskHistoryMutation) ClearField(name string) error {
-	switch name {
-	case taskhistory.FieldDetails:
-		m.ClearDetails()
-		return nil
-	}
-	return fmt.Errorf("unknown TaskHistory nullable field %s", name)
+func (m *AutomationRunMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AutomationRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskHistoryMutation) ResetField(name string) error {
+func (m *AutomationRunMutation) ResetField(name string) error {
 	switch name {
-	case taskhistory.FieldAction:
-		m.ResetAction()
+	case automationrun.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case taskhistory.FieldDetails:
-		m.ResetDetails()
+	case automationrun.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case taskhistory.FieldActor:
-		m.ResetActor()
+	case automationrun.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case taskhistory.FieldCreatedAt:
+	case automationrun.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case automationrun.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TaskHistory field %s", name)
+	return fmt.Errorf("unknown AutomationRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskHistoryMutation) AddedEdges() []string {
+func (m *AutomationRunMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.task != nil {
-		edges = append(edges, taskhistory.EdgeTask)
+	if m.rule != nil {
+		edges = append(edges, automationrun.EdgeRule)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskHistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *AutomationRunMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case taskhistory.EdgeTask:
-		if id := m.task; id != nil {
+	case automationrun.EdgeRule:
+		if id := m.rule; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1440,86 +1445,91 @@ func (m *TaskHistoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskHistoryMutation) RemovedEdges() []string {
+func (m *AutomationRunMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *AutomationRunMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskHistoryMutation) ClearedEdges() []string {
+func (m *AutomationRunMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtask {
-		edges = append(edges, taskhistory.EdgeTask)
+	if m.clearedrule {
+		edges = append(edges, automationrun.EdgeRule)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskHistoryMutation) EdgeCleared(name string) bool {
+func (m *AutomationRunMutation) EdgeCleared(name string) bool {
 	switch name {
-	case taskhistory.EdgeTask:
-		return m.clearedtask
+	case automationrun.EdgeRule:
+		return m.clearedrule
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskHistoryMutation) ClearEdge(name string) error {
+func (m *AutomationRunMutation) ClearEdge(name string) error {
 	switch name {
-	case taskhistory.EdgeTask:
-		m.ClearTask()
+	case automationrun.EdgeRule:
+		m.ClearRule()
 		return nil
 	}
-	return fmt.Errorf("unknown TaskHistory unique edge %s", name)
+	return fmt.Errorf("unknown AutomationRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskHistoryMutation) ResetEdge(name string) error {
+func (m *AutomationRunMutation) ResetEdge(name string) error {
 	switch name {
-	case taskhistory.EdgeTask:
-		m.ResetTask()
+	case automationrun.EdgeRule:
+		m.ResetRule()
 		return nil
 	}
-	return fmt.Errorf("unknown TaskHistory edge %s", name)
+	return fmt.Errorf("unknown AutomationRun edge %s", name)
 }
 
-// TaskTagMutation represents an operation that mutates the TaskTag nodes in the graph.
-type TaskTagMutation struct {
+// FieldDefinitionMutation represents an operation that mutates the FieldDefinition nodes in the graph.
+type FieldDefinitionMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
 	key           *string
-	value         *string
+	label         *string
+	_type         *fielddefinition.Type
+	required      *bool
+	default_value *string
+	options       *[]string
+	appendoptions []string
+	position      *int
+	addposition   *int
 	created_at    *time.Time
 	clearedFields map[string]struct{}
-	task          *int
-	clearedtask   bool
 	done          bool
-	oldValue      func(context.Context) (*TaskTag, error)
-	predicates    []predicate.TaskTag
+	oldValue      func(context.Context) (*FieldDefinition, error)
+	predicates    []predicate.FieldDefinition
 }
 
-var _ ent.Mutation = (*TaskTagMutation)(nil)
+var _ ent.Mutation = (*FieldDefinitionMutation)(nil)
 
-// tasktagOption allows management of the mutation configuration using functional options.
-type tasktagOption func(*TaskTagMutation)
+// fielddefinitionOption allows management of the mutation configuration using functional options.
+type fielddefinitionOption func(*FieldDefinitionMutation)
 
-// newTaskTagMutation creates new mutation for the TaskTag entity.
-func newTaskTagMutation(c config, op Op, opts ...tasktagOption) *TaskTagMutation {
-	m := &TaskTagMutation{
+// newFieldDefinitionMutation creates new mutation for the FieldDefinition entity.
+func newFieldDefinitionMutation(c config, op Op, opts ...fielddefinitionOption) *FieldDefinitionMutation {
+	m := &FieldDefinitionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTaskTag,
+		typ:           TypeFieldDefinition,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1528,20 +1538,20 @@ func newTaskTagMutation(c config, op Op, opts ...tasktagOption) *TaskTagMutation
 	return m
 }
 
-// withTaskTagID sets the ID field of the mutation.
-func withTaskTagID(id int) tasktagOption {
-	return func(m *TaskTagMutation) {
+// withFieldDefinitionID sets the ID field of the mutation.
+func withFieldDefinitionID(id int) fielddefinitionOption {
+	return func(m *FieldDefinitionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TaskTag
+			value *FieldDefinition
 		)
-		m.oldValue = func(ctx context.Context) (*TaskTag, error) {
+		m.oldValue = func(ctx context.Context) (*FieldDefinition, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TaskTag.Get(ctx, id)
+					value, err = m.Client().FieldDefinition.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1550,10 +1560,10 @@ func withTaskTagID(id int) tasktagOption {
 	}
 }
 
-// withTaskTag sets the old TaskTag of the mutation.
-func withTaskTag(node *TaskTag) tasktagOption {
-	return func(m *TaskTagMutation) {
-		m.oldValue = func(context.Context) (*TaskTag, error) {
+// withFieldDefinition sets the old FieldDefinition of the mutation.
+func withFieldDefinition(node *FieldDefinition) fielddefinitionOption {
+	return func(m *FieldDefinitionMutation) {
+		m.oldValue = func(context.Context) (*FieldDefinition, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1562,7 +1572,7 @@ func withTaskTag(node *TaskTag) tasktagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskTagMutation) Client() *Client {
+func (m FieldDefinitionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1570,7 +1580,7 @@ func (m TaskTagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TaskTagMutation) Tx() (*Tx, error) {
+func (m FieldDefinitionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1581,7 +1591,7 @@ func (m TaskTagMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TaskTagMutation) ID() (id int, exists bool) {
+func (m *FieldDefinitionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1592,7 +1602,7 @@ func (m *TaskTagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TaskTagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FieldDefinitionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1601,19 +1611,19 @@ func (m *TaskTagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TaskTag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FieldDefinition.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetKey sets the "key" field.
-func (m *TaskTagMutation) SetKey(s string) {
+func (m *FieldDefinitionMutation) SetKey(s string) {
 	m.key = &s
 }
 
 // Key returns the value of the "key" field in the mutation.
-func (m *TaskTagMutation) Key() (r string, exists bool) {
+func (m *FieldDefinitionMutation) Key() (r string, exists bool) {
 	v := m.key
 	if v == nil {
 		return
@@ -1621,10 +1631,10 @@ func (m *TaskTagMutation) Key() (r string, exists bool) {
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the TaskTag entity.
-// If the TaskTag object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskTagMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *FieldDefinitionMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
@@ -1639,130 +1649,8679 @@ func (m *TaskTagMutation) OldKey(ctx context.Context) (v string, err error) {
 }
 
 // ResetKey resets all changes to the "key" field.
-func (m *TaskTagMutation) ResetKey() {
+func (m *FieldDefinitionMutation) ResetKey() {
 	m.key = nil
 }
 
-// SetValue sets the "value" field.
-func (m *TaskTagMutation) SetValue(s string) {
-	m.value = &s
+// SetLabel sets the "label" field.
+func (m *FieldDefinitionMutation) SetLabel(s string) {
+	m.label = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *TaskTagMutation) Value() (r string, exists bool) {
-	v := m.value
+// Label returns the value of the "label" field in the mutation.
+func (m *FieldDefinitionMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the TaskTag entity.
-// If the TaskTag object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskTagMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *FieldDefinitionMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *FieldDefinitionMutation) ResetLabel() {
+	m.label = nil
+}
+
+// SetType sets the "type" field.
+func (m *FieldDefinitionMutation) SetType(f fielddefinition.Type) {
+	m._type = &f
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *FieldDefinitionMutation) GetType() (r fielddefinition.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldDefinitionMutation) OldType(ctx context.Context) (v fielddefinition.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *FieldDefinitionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetRequired sets the "required" field.
+func (m *FieldDefinitionMutation) SetRequired(b bool) {
+	m.required = &b
+}
+
+// Required returns the value of the "required" field in the mutation.
+func (m *FieldDefinitionMutation) Required() (r bool, exists bool) {
+	v := m.required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequired returns the old "required" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldDefinitionMutation) OldRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	}
+	return oldValue.Required, nil
+}
+
+// ResetRequired resets all changes to the "required" field.
+func (m *FieldDefinitionMutation) ResetRequired() {
+	m.required = nil
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (m *FieldDefinitionMutation) SetDefaultValue(s string) {
+	m.default_value = &s
+}
+
+// DefaultValue returns the value of the "default_value" field in the mutation.
+func (m *FieldDefinitionMutation) DefaultValue() (r string, exists bool) {
+	v := m.default_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultValue returns the old "default_value" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldDefinitionMutation) OldDefaultValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultValue: %w", err)
+	}
+	return oldValue.DefaultValue, nil
+}
+
+// ResetDefaultValue resets all changes to the "default_value" field.
+func (m *FieldDefinitionMutation) ResetDefaultValue() {
+	m.default_value = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *FieldDefinitionMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *FieldDefinitionMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldDefinitionMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *FieldDefinitionMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *FieldDefinitionMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *FieldDefinitionMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[fielddefinition.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *FieldDefinitionMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[fielddefinition.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *FieldDefinitionMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, fielddefinition.FieldOptions)
+}
+
+// SetPosition sets the "position" field.
+func (m *FieldDefinitionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *FieldDefinitionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldDefinitionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *FieldDefinitionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *FieldDefinitionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *FieldDefinitionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FieldDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FieldDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FieldDefinition entity.
+// If the FieldDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FieldDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FieldDefinitionMutation builder.
+func (m *FieldDefinitionMutation) Where(ps ...predicate.FieldDefinition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FieldDefinitionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FieldDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FieldDefinition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FieldDefinitionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FieldDefinitionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FieldDefinition).
+func (m *FieldDefinitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FieldDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.key != nil {
+		fields = append(fields, fielddefinition.FieldKey)
+	}
+	if m.label != nil {
+		fields = append(fields, fielddefinition.FieldLabel)
+	}
+	if m._type != nil {
+		fields = append(fields, fielddefinition.FieldType)
+	}
+	if m.required != nil {
+		fields = append(fields, fielddefinition.FieldRequired)
+	}
+	if m.default_value != nil {
+		fields = append(fields, fielddefinition.FieldDefaultValue)
+	}
+	if m.options != nil {
+		fields = append(fields, fielddefinition.FieldOptions)
+	}
+	if m.position != nil {
+		fields = append(fields, fielddefinition.FieldPosition)
+	}
+	if m.created_at != nil {
+		fields = append(fields, fielddefinition.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FieldDefinitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fielddefinition.FieldKey:
+		return m.Key()
+	case fielddefinition.FieldLabel:
+		return m.Label()
+	case fielddefinition.FieldType:
+		return m.GetType()
+	case fielddefinition.FieldRequired:
+		return m.Required()
+	case fielddefinition.FieldDefaultValue:
+		return m.DefaultValue()
+	case fielddefinition.FieldOptions:
+		return m.Options()
+	case fielddefinition.FieldPosition:
+		return m.Position()
+	case fielddefinition.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FieldDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fielddefinition.FieldKey:
+		return m.OldKey(ctx)
+	case fielddefinition.FieldLabel:
+		return m.OldLabel(ctx)
+	case fielddefinition.FieldType:
+		return m.OldType(ctx)
+	case fielddefinition.FieldRequired:
+		return m.OldRequired(ctx)
+	case fielddefinition.FieldDefaultValue:
+		return m.OldDefaultValue(ctx)
+	case fielddefinition.FieldOptions:
+		return m.OldOptions(ctx)
+	case fielddefinition.FieldPosition:
+		return m.OldPosition(ctx)
+	case fielddefinition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FieldDefinition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FieldDefinitionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fielddefinition.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case fielddefinition.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case fielddefinition.FieldType:
+		v, ok := value.(fielddefinition.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case fielddefinition.FieldRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequired(v)
+		return nil
+	case fielddefinition.FieldDefaultValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultValue(v)
+		return nil
+	case fielddefinition.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case fielddefinition.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case fielddefinition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FieldDefinition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FieldDefinitionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, fielddefinition.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FieldDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fielddefinition.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FieldDefinitionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fielddefinition.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FieldDefinition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FieldDefinitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fielddefinition.FieldOptions) {
+		fields = append(fields, fielddefinition.FieldOptions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FieldDefinitionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FieldDefinitionMutation) ClearField(name string) error {
+	switch name {
+	case fielddefinition.FieldOptions:
+		m.ClearOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown FieldDefinition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FieldDefinitionMutation) ResetField(name string) error {
+	switch name {
+	case fielddefinition.FieldKey:
+		m.ResetKey()
+		return nil
+	case fielddefinition.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case fielddefinition.FieldType:
+		m.ResetType()
+		return nil
+	case fielddefinition.FieldRequired:
+		m.ResetRequired()
+		return nil
+	case fielddefinition.FieldDefaultValue:
+		m.ResetDefaultValue()
+		return nil
+	case fielddefinition.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case fielddefinition.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case fielddefinition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FieldDefinition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FieldDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FieldDefinitionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FieldDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FieldDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FieldDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FieldDefinitionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FieldDefinitionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FieldDefinition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FieldDefinitionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FieldDefinition edge %s", name)
+}
+
+// RecurringTaskMutation represents an operation that mutates the RecurringTask nodes in the graph.
+type RecurringTaskMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	title           *string
+	description     *string
+	column          *string
+	assignee        *string
+	priority        *recurringtask.Priority
+	tags            *[]string
+	appendtags      []string
+	rule            *string
+	skip_if_open    *bool
+	enabled         *bool
+	next_run_at     *time.Time
+	last_run_at     *time.Time
+	last_task_id    *int
+	addlast_task_id *int
+	last_result     *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*RecurringTask, error)
+	predicates      []predicate.RecurringTask
+}
+
+var _ ent.Mutation = (*RecurringTaskMutation)(nil)
+
+// recurringtaskOption allows management of the mutation configuration using functional options.
+type recurringtaskOption func(*RecurringTaskMutation)
+
+// newRecurringTaskMutation creates new mutation for the RecurringTask entity.
+func newRecurringTaskMutation(c config, op Op, opts ...recurringtaskOption) *RecurringTaskMutation {
+	m := &RecurringTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurringTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurringTaskID sets the ID field of the mutation.
+func withRecurringTaskID(id int) recurringtaskOption {
+	return func(m *RecurringTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurringTask
+		)
+		m.oldValue = func(ctx context.Context) (*RecurringTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurringTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurringTask sets the old RecurringTask of the mutation.
+func withRecurringTask(node *RecurringTask) recurringtaskOption {
+	return func(m *RecurringTaskMutation) {
+		m.oldValue = func(context.Context) (*RecurringTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurringTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurringTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurringTaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurringTaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurringTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *RecurringTaskMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *RecurringTaskMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *RecurringTaskMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RecurringTaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RecurringTaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RecurringTaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[recurringtask.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RecurringTaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RecurringTaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, recurringtask.FieldDescription)
+}
+
+// SetColumn sets the "column" field.
+func (m *RecurringTaskMutation) SetColumn(s string) {
+	m.column = &s
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *RecurringTaskMutation) Column() (r string, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
+}
+
+// ResetColumn resets all changes to the "column" field.
+func (m *RecurringTaskMutation) ResetColumn() {
+	m.column = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *RecurringTaskMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *RecurringTaskMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *RecurringTaskMutation) ResetAssignee() {
+	m.assignee = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *RecurringTaskMutation) SetPriority(r recurringtask.Priority) {
+	m.priority = &r
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *RecurringTaskMutation) Priority() (r recurringtask.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldPriority(ctx context.Context) (v recurringtask.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *RecurringTaskMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *RecurringTaskMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *RecurringTaskMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *RecurringTaskMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *RecurringTaskMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *RecurringTaskMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[recurringtask.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *RecurringTaskMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *RecurringTaskMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, recurringtask.FieldTags)
+}
+
+// SetRule sets the "rule" field.
+func (m *RecurringTaskMutation) SetRule(s string) {
+	m.rule = &s
+}
+
+// Rule returns the value of the "rule" field in the mutation.
+func (m *RecurringTaskMutation) Rule() (r string, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRule returns the old "rule" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRule: %w", err)
+	}
+	return oldValue.Rule, nil
+}
+
+// ResetRule resets all changes to the "rule" field.
+func (m *RecurringTaskMutation) ResetRule() {
+	m.rule = nil
+}
+
+// SetSkipIfOpen sets the "skip_if_open" field.
+func (m *RecurringTaskMutation) SetSkipIfOpen(b bool) {
+	m.skip_if_open = &b
+}
+
+// SkipIfOpen returns the value of the "skip_if_open" field in the mutation.
+func (m *RecurringTaskMutation) SkipIfOpen() (r bool, exists bool) {
+	v := m.skip_if_open
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipIfOpen returns the old "skip_if_open" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldSkipIfOpen(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipIfOpen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipIfOpen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipIfOpen: %w", err)
+	}
+	return oldValue.SkipIfOpen, nil
+}
+
+// ResetSkipIfOpen resets all changes to the "skip_if_open" field.
+func (m *RecurringTaskMutation) ResetSkipIfOpen() {
+	m.skip_if_open = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *RecurringTaskMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *RecurringTaskMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *RecurringTaskMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *RecurringTaskMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *RecurringTaskMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *RecurringTaskMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[recurringtask.FieldNextRunAt] = struct{}{}
+}
+
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *RecurringTaskMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldNextRunAt]
+	return ok
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *RecurringTaskMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, recurringtask.FieldNextRunAt)
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *RecurringTaskMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *RecurringTaskMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *RecurringTaskMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[recurringtask.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *RecurringTaskMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *RecurringTaskMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, recurringtask.FieldLastRunAt)
+}
+
+// SetLastTaskID sets the "last_task_id" field.
+func (m *RecurringTaskMutation) SetLastTaskID(i int) {
+	m.last_task_id = &i
+	m.addlast_task_id = nil
+}
+
+// LastTaskID returns the value of the "last_task_id" field in the mutation.
+func (m *RecurringTaskMutation) LastTaskID() (r int, exists bool) {
+	v := m.last_task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastTaskID returns the old "last_task_id" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldLastTaskID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTaskID: %w", err)
+	}
+	return oldValue.LastTaskID, nil
+}
+
+// AddLastTaskID adds i to the "last_task_id" field.
+func (m *RecurringTaskMutation) AddLastTaskID(i int) {
+	if m.addlast_task_id != nil {
+		*m.addlast_task_id += i
+	} else {
+		m.addlast_task_id = &i
+	}
+}
+
+// AddedLastTaskID returns the value that was added to the "last_task_id" field in this mutation.
+func (m *RecurringTaskMutation) AddedLastTaskID() (r int, exists bool) {
+	v := m.addlast_task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastTaskID clears the value of the "last_task_id" field.
+func (m *RecurringTaskMutation) ClearLastTaskID() {
+	m.last_task_id = nil
+	m.addlast_task_id = nil
+	m.clearedFields[recurringtask.FieldLastTaskID] = struct{}{}
+}
+
+// LastTaskIDCleared returns if the "last_task_id" field was cleared in this mutation.
+func (m *RecurringTaskMutation) LastTaskIDCleared() bool {
+	_, ok := m.clearedFields[recurringtask.FieldLastTaskID]
+	return ok
+}
+
+// ResetLastTaskID resets all changes to the "last_task_id" field.
+func (m *RecurringTaskMutation) ResetLastTaskID() {
+	m.last_task_id = nil
+	m.addlast_task_id = nil
+	delete(m.clearedFields, recurringtask.FieldLastTaskID)
+}
+
+// SetLastResult sets the "last_result" field.
+func (m *RecurringTaskMutation) SetLastResult(s string) {
+	m.last_result = &s
+}
+
+// LastResult returns the value of the "last_result" field in the mutation.
+func (m *RecurringTaskMutation) LastResult() (r string, exists bool) {
+	v := m.last_result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastResult returns the old "last_result" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldLastResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastResult: %w", err)
+	}
+	return oldValue.LastResult, nil
+}
+
+// ResetLastResult resets all changes to the "last_result" field.
+func (m *RecurringTaskMutation) ResetLastResult() {
+	m.last_result = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurringTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurringTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurringTask entity.
+// If the RecurringTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurringTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RecurringTaskMutation builder.
+func (m *RecurringTaskMutation) Where(ps ...predicate.RecurringTask) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurringTaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurringTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurringTask, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurringTaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurringTaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurringTask).
+func (m *RecurringTaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurringTaskMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.title != nil {
+		fields = append(fields, recurringtask.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, recurringtask.FieldDescription)
+	}
+	if m.column != nil {
+		fields = append(fields, recurringtask.FieldColumn)
+	}
+	if m.assignee != nil {
+		fields = append(fields, recurringtask.FieldAssignee)
+	}
+	if m.priority != nil {
+		fields = append(fields, recurringtask.FieldPriority)
+	}
+	if m.tags != nil {
+		fields = append(fields, recurringtask.FieldTags)
+	}
+	if m.rule != nil {
+		fields = append(fields, recurringtask.FieldRule)
+	}
+	if m.skip_if_open != nil {
+		fields = append(fields, recurringtask.FieldSkipIfOpen)
+	}
+	if m.enabled != nil {
+		fields = append(fields, recurringtask.FieldEnabled)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, recurringtask.FieldNextRunAt)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, recurringtask.FieldLastRunAt)
+	}
+	if m.last_task_id != nil {
+		fields = append(fields, recurringtask.FieldLastTaskID)
+	}
+	if m.last_result != nil {
+		fields = append(fields, recurringtask.FieldLastResult)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurringtask.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurringTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurringtask.FieldTitle:
+		return m.Title()
+	case recurringtask.FieldDescription:
+		return m.Description()
+	case recurringtask.FieldColumn:
+		return m.Column()
+	case recurringtask.FieldAssignee:
+		return m.Assignee()
+	case recurringtask.FieldPriority:
+		return m.Priority()
+	case recurringtask.FieldTags:
+		return m.Tags()
+	case recurringtask.FieldRule:
+		return m.Rule()
+	case recurringtask.FieldSkipIfOpen:
+		return m.SkipIfOpen()
+	case recurringtask.FieldEnabled:
+		return m.Enabled()
+	case recurringtask.FieldNextRunAt:
+		return m.NextRunAt()
+	case recurringtask.FieldLastRunAt:
+		return m.LastRunAt()
+	case recurringtask.FieldLastTaskID:
+		return m.LastTaskID()
+	case recurringtask.FieldLastResult:
+		return m.LastResult()
+	case recurringtask.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurringTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurringtask.FieldTitle:
+		return m.OldTitle(ctx)
+	case recurringtask.FieldDescription:
+		return m.OldDescription(ctx)
+	case recurringtask.FieldColumn:
+		return m.OldColumn(ctx)
+	case recurringtask.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case recurringtask.FieldPriority:
+		return m.OldPriority(ctx)
+	case recurringtask.FieldTags:
+		return m.OldTags(ctx)
+	case recurringtask.FieldRule:
+		return m.OldRule(ctx)
+	case recurringtask.FieldSkipIfOpen:
+		return m.OldSkipIfOpen(ctx)
+	case recurringtask.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case recurringtask.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case recurringtask.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case recurringtask.FieldLastTaskID:
+		return m.OldLastTaskID(ctx)
+	case recurringtask.FieldLastResult:
+		return m.OldLastResult(ctx)
+	case recurringtask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurringTask field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringTaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurringtask.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case recurringtask.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case recurringtask.FieldColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn(v)
+		return nil
+	case recurringtask.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case recurringtask.FieldPriority:
+		v, ok := value.(recurringtask.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case recurringtask.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case recurringtask.FieldRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRule(v)
+		return nil
+	case recurringtask.FieldSkipIfOpen:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipIfOpen(v)
+		return nil
+	case recurringtask.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case recurringtask.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case recurringtask.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case recurringtask.FieldLastTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTaskID(v)
+		return nil
+	case recurringtask.FieldLastResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastResult(v)
+		return nil
+	case recurringtask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurringTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_task_id != nil {
+		fields = append(fields, recurringtask.FieldLastTaskID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurringTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recurringtask.FieldLastTaskID:
+		return m.AddedLastTaskID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recurringtask.FieldLastTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurringTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurringtask.FieldDescription) {
+		fields = append(fields, recurringtask.FieldDescription)
+	}
+	if m.FieldCleared(recurringtask.FieldTags) {
+		fields = append(fields, recurringtask.FieldTags)
+	}
+	if m.FieldCleared(recurringtask.FieldNextRunAt) {
+		fields = append(fields, recurringtask.FieldNextRunAt)
+	}
+	if m.FieldCleared(recurringtask.FieldLastRunAt) {
+		fields = append(fields, recurringtask.FieldLastRunAt)
+	}
+	if m.FieldCleared(recurringtask.FieldLastTaskID) {
+		fields = append(fields, recurringtask.FieldLastTaskID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurringTaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurringTaskMutation) ClearField(name string) error {
+	switch name {
+	case recurringtask.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case recurringtask.FieldTags:
+		m.ClearTags()
+		return nil
+	case recurringtask.FieldNextRunAt:
+		m.ClearNextRunAt()
+		return nil
+	case recurringtask.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	case recurringtask.FieldLastTaskID:
+		m.ClearLastTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurringTaskMutation) ResetField(name string) error {
+	switch name {
+	case recurringtask.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case recurringtask.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case recurringtask.FieldColumn:
+		m.ResetColumn()
+		return nil
+	case recurringtask.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case recurringtask.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case recurringtask.FieldTags:
+		m.ResetTags()
+		return nil
+	case recurringtask.FieldRule:
+		m.ResetRule()
+		return nil
+	case recurringtask.FieldSkipIfOpen:
+		m.ResetSkipIfOpen()
+		return nil
+	case recurringtask.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case recurringtask.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case recurringtask.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case recurringtask.FieldLastTaskID:
+		m.ResetLastTaskID()
+		return nil
+	case recurringtask.FieldLastResult:
+		m.ResetLastResult()
+		return nil
+	case recurringtask.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringTask field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurringTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurringTaskMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurringTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurringTaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurringTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurringTaskMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurringTaskMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RecurringTask unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurringTaskMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RecurringTask edge %s", name)
+}
+
+// StaleThresholdMutation represents an operation that mutates the StaleThreshold nodes in the graph.
+type StaleThresholdMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	column        *string
+	days          *int
+	adddays       *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*StaleThreshold, error)
+	predicates    []predicate.StaleThreshold
+}
+
+var _ ent.Mutation = (*StaleThresholdMutation)(nil)
+
+// stalethresholdOption allows management of the mutation configuration using functional options.
+type stalethresholdOption func(*StaleThresholdMutation)
+
+// newStaleThresholdMutation creates new mutation for the StaleThreshold entity.
+func newStaleThresholdMutation(c config, op Op, opts ...stalethresholdOption) *StaleThresholdMutation {
+	m := &StaleThresholdMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStaleThreshold,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStaleThresholdID sets the ID field of the mutation.
+func withStaleThresholdID(id int) stalethresholdOption {
+	return func(m *StaleThresholdMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StaleThreshold
+		)
+		m.oldValue = func(ctx context.Context) (*StaleThreshold, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StaleThreshold.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStaleThreshold sets the old StaleThreshold of the mutation.
+func withStaleThreshold(node *StaleThreshold) stalethresholdOption {
+	return func(m *StaleThresholdMutation) {
+		m.oldValue = func(context.Context) (*StaleThreshold, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StaleThresholdMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StaleThresholdMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StaleThresholdMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StaleThresholdMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StaleThreshold.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetColumn sets the "column" field.
+func (m *StaleThresholdMutation) SetColumn(s string) {
+	m.column = &s
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *StaleThresholdMutation) Column() (r string, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the StaleThreshold entity.
+// If the StaleThreshold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaleThresholdMutation) OldColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
+}
+
+// ResetColumn resets all changes to the "column" field.
+func (m *StaleThresholdMutation) ResetColumn() {
+	m.column = nil
+}
+
+// SetDays sets the "days" field.
+func (m *StaleThresholdMutation) SetDays(i int) {
+	m.days = &i
+	m.adddays = nil
+}
+
+// Days returns the value of the "days" field in the mutation.
+func (m *StaleThresholdMutation) Days() (r int, exists bool) {
+	v := m.days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDays returns the old "days" field's value of the StaleThreshold entity.
+// If the StaleThreshold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaleThresholdMutation) OldDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDays: %w", err)
+	}
+	return oldValue.Days, nil
+}
+
+// AddDays adds i to the "days" field.
+func (m *StaleThresholdMutation) AddDays(i int) {
+	if m.adddays != nil {
+		*m.adddays += i
+	} else {
+		m.adddays = &i
+	}
+}
+
+// AddedDays returns the value that was added to the "days" field in this mutation.
+func (m *StaleThresholdMutation) AddedDays() (r int, exists bool) {
+	v := m.adddays
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDays resets all changes to the "days" field.
+func (m *StaleThresholdMutation) ResetDays() {
+	m.days = nil
+	m.adddays = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StaleThresholdMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StaleThresholdMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StaleThreshold entity.
+// If the StaleThreshold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaleThresholdMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StaleThresholdMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the StaleThresholdMutation builder.
+func (m *StaleThresholdMutation) Where(ps ...predicate.StaleThreshold) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StaleThresholdMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StaleThresholdMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StaleThreshold, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StaleThresholdMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StaleThresholdMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StaleThreshold).
+func (m *StaleThresholdMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StaleThresholdMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.column != nil {
+		fields = append(fields, stalethreshold.FieldColumn)
+	}
+	if m.days != nil {
+		fields = append(fields, stalethreshold.FieldDays)
+	}
+	if m.created_at != nil {
+		fields = append(fields, stalethreshold.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StaleThresholdMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case stalethreshold.FieldColumn:
+		return m.Column()
+	case stalethreshold.FieldDays:
+		return m.Days()
+	case stalethreshold.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StaleThresholdMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case stalethreshold.FieldColumn:
+		return m.OldColumn(ctx)
+	case stalethreshold.FieldDays:
+		return m.OldDays(ctx)
+	case stalethreshold.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StaleThreshold field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StaleThresholdMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case stalethreshold.FieldColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn(v)
+		return nil
+	case stalethreshold.FieldDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDays(v)
+		return nil
+	case stalethreshold.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StaleThreshold field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StaleThresholdMutation) AddedFields() []string {
+	var fields []string
+	if m.adddays != nil {
+		fields = append(fields, stalethreshold.FieldDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StaleThresholdMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case stalethreshold.FieldDays:
+		return m.AddedDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StaleThresholdMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case stalethreshold.FieldDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StaleThreshold numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StaleThresholdMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StaleThresholdMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StaleThresholdMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StaleThreshold nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StaleThresholdMutation) ResetField(name string) error {
+	switch name {
+	case stalethreshold.FieldColumn:
+		m.ResetColumn()
+		return nil
+	case stalethreshold.FieldDays:
+		m.ResetDays()
+		return nil
+	case stalethreshold.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StaleThreshold field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StaleThresholdMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StaleThresholdMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StaleThresholdMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StaleThresholdMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StaleThresholdMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StaleThresholdMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StaleThresholdMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StaleThreshold unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StaleThresholdMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StaleThreshold edge %s", name)
+}
+
+// TagDefinitionMutation represents an operation that mutates the TagDefinition nodes in the graph.
+type TagDefinitionMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	key                  *string
+	display_name         *string
+	emoji                *string
+	color                *string
+	allowed_values       *[]string
+	appendallowed_values []string
+	multi_valued         *bool
+	position             *int
+	addposition          *int
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*TagDefinition, error)
+	predicates           []predicate.TagDefinition
+}
+
+var _ ent.Mutation = (*TagDefinitionMutation)(nil)
+
+// tagdefinitionOption allows management of the mutation configuration using functional options.
+type tagdefinitionOption func(*TagDefinitionMutation)
+
+// newTagDefinitionMutation creates new mutation for the TagDefinition entity.
+func newTagDefinitionMutation(c config, op Op, opts ...tagdefinitionOption) *TagDefinitionMutation {
+	m := &TagDefinitionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTagDefinition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagDefinitionID sets the ID field of the mutation.
+func withTagDefinitionID(id int) tagdefinitionOption {
+	return func(m *TagDefinitionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TagDefinition
+		)
+		m.oldValue = func(ctx context.Context) (*TagDefinition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TagDefinition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTagDefinition sets the old TagDefinition of the mutation.
+func withTagDefinition(node *TagDefinition) tagdefinitionOption {
+	return func(m *TagDefinitionMutation) {
+		m.oldValue = func(context.Context) (*TagDefinition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagDefinitionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagDefinitionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagDefinitionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagDefinitionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TagDefinition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *TagDefinitionMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *TagDefinitionMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *TagDefinitionMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *TagDefinitionMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *TagDefinitionMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *TagDefinitionMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *TagDefinitionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *TagDefinitionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *TagDefinitionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagDefinitionMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagDefinitionMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagDefinitionMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetAllowedValues sets the "allowed_values" field.
+func (m *TagDefinitionMutation) SetAllowedValues(s []string) {
+	m.allowed_values = &s
+	m.appendallowed_values = nil
+}
+
+// AllowedValues returns the value of the "allowed_values" field in the mutation.
+func (m *TagDefinitionMutation) AllowedValues() (r []string, exists bool) {
+	v := m.allowed_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedValues returns the old "allowed_values" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldAllowedValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedValues: %w", err)
+	}
+	return oldValue.AllowedValues, nil
+}
+
+// AppendAllowedValues adds s to the "allowed_values" field.
+func (m *TagDefinitionMutation) AppendAllowedValues(s []string) {
+	m.appendallowed_values = append(m.appendallowed_values, s...)
+}
+
+// AppendedAllowedValues returns the list of values that were appended to the "allowed_values" field in this mutation.
+func (m *TagDefinitionMutation) AppendedAllowedValues() ([]string, bool) {
+	if len(m.appendallowed_values) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_values, true
+}
+
+// ClearAllowedValues clears the value of the "allowed_values" field.
+func (m *TagDefinitionMutation) ClearAllowedValues() {
+	m.allowed_values = nil
+	m.appendallowed_values = nil
+	m.clearedFields[tagdefinition.FieldAllowedValues] = struct{}{}
+}
+
+// AllowedValuesCleared returns if the "allowed_values" field was cleared in this mutation.
+func (m *TagDefinitionMutation) AllowedValuesCleared() bool {
+	_, ok := m.clearedFields[tagdefinition.FieldAllowedValues]
+	return ok
+}
+
+// ResetAllowedValues resets all changes to the "allowed_values" field.
+func (m *TagDefinitionMutation) ResetAllowedValues() {
+	m.allowed_values = nil
+	m.appendallowed_values = nil
+	delete(m.clearedFields, tagdefinition.FieldAllowedValues)
+}
+
+// SetMultiValued sets the "multi_valued" field.
+func (m *TagDefinitionMutation) SetMultiValued(b bool) {
+	m.multi_valued = &b
+}
+
+// MultiValued returns the value of the "multi_valued" field in the mutation.
+func (m *TagDefinitionMutation) MultiValued() (r bool, exists bool) {
+	v := m.multi_valued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultiValued returns the old "multi_valued" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldMultiValued(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultiValued is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultiValued requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultiValued: %w", err)
+	}
+	return oldValue.MultiValued, nil
+}
+
+// ResetMultiValued resets all changes to the "multi_valued" field.
+func (m *TagDefinitionMutation) ResetMultiValued() {
+	m.multi_valued = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *TagDefinitionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *TagDefinitionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *TagDefinitionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *TagDefinitionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *TagDefinitionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TagDefinition entity.
+// If the TagDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TagDefinitionMutation builder.
+func (m *TagDefinitionMutation) Where(ps ...predicate.TagDefinition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagDefinitionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagDefinition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagDefinitionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagDefinitionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TagDefinition).
+func (m *TagDefinitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.key != nil {
+		fields = append(fields, tagdefinition.FieldKey)
+	}
+	if m.display_name != nil {
+		fields = append(fields, tagdefinition.FieldDisplayName)
+	}
+	if m.emoji != nil {
+		fields = append(fields, tagdefinition.FieldEmoji)
+	}
+	if m.color != nil {
+		fields = append(fields, tagdefinition.FieldColor)
+	}
+	if m.allowed_values != nil {
+		fields = append(fields, tagdefinition.FieldAllowedValues)
+	}
+	if m.multi_valued != nil {
+		fields = append(fields, tagdefinition.FieldMultiValued)
+	}
+	if m.position != nil {
+		fields = append(fields, tagdefinition.FieldPosition)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tagdefinition.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagDefinitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tagdefinition.FieldKey:
+		return m.Key()
+	case tagdefinition.FieldDisplayName:
+		return m.DisplayName()
+	case tagdefinition.FieldEmoji:
+		return m.Emoji()
+	case tagdefinition.FieldColor:
+		return m.Color()
+	case tagdefinition.FieldAllowedValues:
+		return m.AllowedValues()
+	case tagdefinition.FieldMultiValued:
+		return m.MultiValued()
+	case tagdefinition.FieldPosition:
+		return m.Position()
+	case tagdefinition.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tagdefinition.FieldKey:
+		return m.OldKey(ctx)
+	case tagdefinition.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case tagdefinition.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case tagdefinition.FieldColor:
+		return m.OldColor(ctx)
+	case tagdefinition.FieldAllowedValues:
+		return m.OldAllowedValues(ctx)
+	case tagdefinition.FieldMultiValued:
+		return m.OldMultiValued(ctx)
+	case tagdefinition.FieldPosition:
+		return m.OldPosition(ctx)
+	case tagdefinition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TagDefinition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagDefinitionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tagdefinition.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case tagdefinition.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case tagdefinition.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case tagdefinition.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tagdefinition.FieldAllowedValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedValues(v)
+		return nil
+	case tagdefinition.FieldMultiValued:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultiValued(v)
+		return nil
+	case tagdefinition.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case tagdefinition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TagDefinition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagDefinitionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, tagdefinition.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tagdefinition.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagDefinitionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tagdefinition.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TagDefinition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagDefinitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tagdefinition.FieldAllowedValues) {
+		fields = append(fields, tagdefinition.FieldAllowedValues)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagDefinitionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagDefinitionMutation) ClearField(name string) error {
+	switch name {
+	case tagdefinition.FieldAllowedValues:
+		m.ClearAllowedValues()
+		return nil
+	}
+	return fmt.Errorf("unknown TagDefinition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagDefinitionMutation) ResetField(name string) error {
+	switch name {
+	case tagdefinition.FieldKey:
+		m.ResetKey()
+		return nil
+	case tagdefinition.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case tagdefinition.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case tagdefinition.FieldColor:
+		m.ResetColor()
+		return nil
+	case tagdefinition.FieldAllowedValues:
+		m.ResetAllowedValues()
+		return nil
+	case tagdefinition.FieldMultiValued:
+		m.ResetMultiValued()
+		return nil
+	case tagdefinition.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case tagdefinition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TagDefinition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagDefinitionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagDefinitionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagDefinitionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TagDefinition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagDefinitionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TagDefinition edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	title               *string
+	description         *string
+	column              *string
+	assignee            *string
+	position            *int
+	addposition         *int
+	priority            *task.Priority
+	due_at              *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted_at          *time.Time
+	archived_at         *time.Time
+	column_entered_at   *time.Time
+	clearedFields       map[string]struct{}
+	tags                map[int]struct{}
+	removedtags         map[int]struct{}
+	clearedtags         bool
+	history             map[int]struct{}
+	removedhistory      map[int]struct{}
+	clearedhistory      bool
+	revisions           map[int]struct{}
+	removedrevisions    map[int]struct{}
+	clearedrevisions    bool
+	field_values        map[int]struct{}
+	removedfield_values map[int]struct{}
+	clearedfield_values bool
+	done                bool
+	oldValue            func(context.Context) (*Task, error)
+	predicates          []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id int) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *TaskMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[task.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[task.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, task.FieldDescription)
+}
+
+// SetColumn sets the "column" field.
+func (m *TaskMutation) SetColumn(s string) {
+	m.column = &s
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *TaskMutation) Column() (r string, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
+}
+
+// ResetColumn resets all changes to the "column" field.
+func (m *TaskMutation) ResetColumn() {
+	m.column = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *TaskMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *TaskMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *TaskMutation) ResetAssignee() {
+	m.assignee = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *TaskMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *TaskMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *TaskMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *TaskMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *TaskMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *TaskMutation) SetPriority(t task.Priority) {
+	m.priority = &t
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TaskMutation) Priority() (r task.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPriority(ctx context.Context) (v task.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *TaskMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *TaskMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *TaskMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *TaskMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[task.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *TaskMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *TaskMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, task.FieldDueAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TaskMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TaskMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TaskMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[task.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TaskMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TaskMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, task.FieldDeletedAt)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *TaskMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *TaskMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *TaskMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[task.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *TaskMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *TaskMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, task.FieldArchivedAt)
+}
+
+// SetColumnEnteredAt sets the "column_entered_at" field.
+func (m *TaskMutation) SetColumnEnteredAt(t time.Time) {
+	m.column_entered_at = &t
+}
+
+// ColumnEnteredAt returns the value of the "column_entered_at" field in the mutation.
+func (m *TaskMutation) ColumnEnteredAt() (r time.Time, exists bool) {
+	v := m.column_entered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumnEnteredAt returns the old "column_entered_at" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldColumnEnteredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumnEnteredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumnEnteredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumnEnteredAt: %w", err)
+	}
+	return oldValue.ColumnEnteredAt, nil
+}
+
+// ClearColumnEnteredAt clears the value of the "column_entered_at" field.
+func (m *TaskMutation) ClearColumnEnteredAt() {
+	m.column_entered_at = nil
+	m.clearedFields[task.FieldColumnEnteredAt] = struct{}{}
+}
+
+// ColumnEnteredAtCleared returns if the "column_entered_at" field was cleared in this mutation.
+func (m *TaskMutation) ColumnEnteredAtCleared() bool {
+	_, ok := m.clearedFields[task.FieldColumnEnteredAt]
+	return ok
+}
+
+// ResetColumnEnteredAt resets all changes to the "column_entered_at" field.
+func (m *TaskMutation) ResetColumnEnteredAt() {
+	m.column_entered_at = nil
+	delete(m.clearedFields, task.FieldColumnEnteredAt)
+}
+
+// AddTagIDs adds the "tags" edge to the TaskTag entity by ids.
+func (m *TaskMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the TaskTag entity.
+func (m *TaskMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the TaskTag entity was cleared.
+func (m *TaskMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the TaskTag entity by IDs.
+func (m *TaskMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the TaskTag entity.
+func (m *TaskMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *TaskMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *TaskMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddHistoryIDs adds the "history" edge to the TaskHistory entity by ids.
+func (m *TaskMutation) AddHistoryIDs(ids ...int) {
+	if m.history == nil {
+		m.history = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistory clears the "history" edge to the TaskHistory entity.
+func (m *TaskMutation) ClearHistory() {
+	m.clearedhistory = true
+}
+
+// HistoryCleared reports if the "history" edge to the TaskHistory entity was cleared.
+func (m *TaskMutation) HistoryCleared() bool {
+	return m.clearedhistory
+}
+
+// RemoveHistoryIDs removes the "history" edge to the TaskHistory entity by IDs.
+func (m *TaskMutation) RemoveHistoryIDs(ids ...int) {
+	if m.removedhistory == nil {
+		m.removedhistory = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.history, ids[i])
+		m.removedhistory[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistory returns the removed IDs of the "history" edge to the TaskHistory entity.
+func (m *TaskMutation) RemovedHistoryIDs() (ids []int) {
+	for id := range m.removedhistory {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoryIDs returns the "history" edge IDs in the mutation.
+func (m *TaskMutation) HistoryIDs() (ids []int) {
+	for id := range m.history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistory resets all changes to the "history" edge.
+func (m *TaskMutation) ResetHistory() {
+	m.history = nil
+	m.clearedhistory = false
+	m.removedhistory = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the TaskRevision entity by ids.
+func (m *TaskMutation) AddRevisionIDs(ids ...int) {
+	if m.revisions == nil {
+		m.revisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the TaskRevision entity.
+func (m *TaskMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the TaskRevision entity was cleared.
+func (m *TaskMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the TaskRevision entity by IDs.
+func (m *TaskMutation) RemoveRevisionIDs(ids ...int) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the TaskRevision entity.
+func (m *TaskMutation) RemovedRevisionsIDs() (ids []int) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *TaskMutation) RevisionsIDs() (ids []int) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *TaskMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddFieldValueIDs adds the "field_values" edge to the TaskFieldValue entity by ids.
+func (m *TaskMutation) AddFieldValueIDs(ids ...int) {
+	if m.field_values == nil {
+		m.field_values = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.field_values[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFieldValues clears the "field_values" edge to the TaskFieldValue entity.
+func (m *TaskMutation) ClearFieldValues() {
+	m.clearedfield_values = true
+}
+
+// FieldValuesCleared reports if the "field_values" edge to the TaskFieldValue entity was cleared.
+func (m *TaskMutation) FieldValuesCleared() bool {
+	return m.clearedfield_values
+}
+
+// RemoveFieldValueIDs removes the "field_values" edge to the TaskFieldValue entity by IDs.
+func (m *TaskMutation) RemoveFieldValueIDs(ids ...int) {
+	if m.removedfield_values == nil {
+		m.removedfield_values = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.field_values, ids[i])
+		m.removedfield_values[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFieldValues returns the removed IDs of the "field_values" edge to the TaskFieldValue entity.
+func (m *TaskMutation) RemovedFieldValuesIDs() (ids []int) {
+	for id := range m.removedfield_values {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FieldValuesIDs returns the "field_values" edge IDs in the mutation.
+func (m *TaskMutation) FieldValuesIDs() (ids []int) {
+	for id := range m.field_values {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFieldValues resets all changes to the "field_values" edge.
+func (m *TaskMutation) ResetFieldValues() {
+	m.field_values = nil
+	m.clearedfield_values = false
+	m.removedfield_values = nil
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.title != nil {
+		fields = append(fields, task.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.column != nil {
+		fields = append(fields, task.FieldColumn)
+	}
+	if m.assignee != nil {
+		fields = append(fields, task.FieldAssignee)
+	}
+	if m.position != nil {
+		fields = append(fields, task.FieldPosition)
+	}
+	if m.priority != nil {
+		fields = append(fields, task.FieldPriority)
+	}
+	if m.due_at != nil {
+		fields = append(fields, task.FieldDueAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, task.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, task.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, task.FieldDeletedAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, task.FieldArchivedAt)
+	}
+	if m.column_entered_at != nil {
+		fields = append(fields, task.FieldColumnEnteredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldTitle:
+		return m.Title()
+	case task.FieldDescription:
+		return m.Description()
+	case task.FieldColumn:
+		return m.Column()
+	case task.FieldAssignee:
+		return m.Assignee()
+	case task.FieldPosition:
+		return m.Position()
+	case task.FieldPriority:
+		return m.Priority()
+	case task.FieldDueAt:
+		return m.DueAt()
+	case task.FieldCreatedAt:
+		return m.CreatedAt()
+	case task.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case task.FieldDeletedAt:
+		return m.DeletedAt()
+	case task.FieldArchivedAt:
+		return m.ArchivedAt()
+	case task.FieldColumnEnteredAt:
+		return m.ColumnEnteredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case task.FieldTitle:
+		return m.OldTitle(ctx)
+	case task.FieldDescription:
+		return m.OldDescription(ctx)
+	case task.FieldColumn:
+		return m.OldColumn(ctx)
+	case task.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case task.FieldPosition:
+		return m.OldPosition(ctx)
+	case task.FieldPriority:
+		return m.OldPriority(ctx)
+	case task.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case task.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case task.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case task.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case task.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case task.FieldColumnEnteredAt:
+		return m.OldColumnEnteredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Task field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case task.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case task.FieldColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn(v)
+		return nil
+	case task.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case task.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case task.FieldPriority:
+		v, ok := value.(task.Priority)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case task.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case task.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case task.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case task.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case task.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case task.FieldColumnEnteredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumnEnteredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, task.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case task.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case task.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Task numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(task.FieldDescription) {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.FieldCleared(task.FieldDueAt) {
+		fields = append(fields, task.FieldDueAt)
+	}
+	if m.FieldCleared(task.FieldDeletedAt) {
+		fields = append(fields, task.FieldDeletedAt)
+	}
+	if m.FieldCleared(task.FieldArchivedAt) {
+		fields = append(fields, task.FieldArchivedAt)
+	}
+	if m.FieldCleared(task.FieldColumnEnteredAt) {
+		fields = append(fields, task.FieldColumnEnteredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskMutation) ClearField(name string) error {
+	switch name {
+	case task.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case task.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case task.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case task.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case task.FieldColumnEnteredAt:
+		m.ClearColumnEnteredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskMutation) ResetField(name string) error {
+	switch name {
+	case task.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case task.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case task.FieldColumn:
+		m.ResetColumn()
+		return nil
+	case task.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case task.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case task.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case task.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case task.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case task.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case task.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case task.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case task.FieldColumnEnteredAt:
+		m.ResetColumnEnteredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Task field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tags != nil {
+		edges = append(edges, task.EdgeTags)
+	}
+	if m.history != nil {
+		edges = append(edges, task.EdgeHistory)
+	}
+	if m.revisions != nil {
+		edges = append(edges, task.EdgeRevisions)
+	}
+	if m.field_values != nil {
+		edges = append(edges, task.EdgeFieldValues)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeHistory:
+		ids := make([]ent.Value, 0, len(m.history))
+		for id := range m.history {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeFieldValues:
+		ids := make([]ent.Value, 0, len(m.field_values))
+		for id := range m.field_values {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedtags != nil {
+		edges = append(edges, task.EdgeTags)
+	}
+	if m.removedhistory != nil {
+		edges = append(edges, task.EdgeHistory)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, task.EdgeRevisions)
+	}
+	if m.removedfield_values != nil {
+		edges = append(edges, task.EdgeFieldValues)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case task.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeHistory:
+		ids := make([]ent.Value, 0, len(m.removedhistory))
+		for id := range m.removedhistory {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case task.EdgeFieldValues:
+		ids := make([]ent.Value, 0, len(m.removedfield_values))
+		for id := range m.removedfield_values {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtags {
+		edges = append(edges, task.EdgeTags)
+	}
+	if m.clearedhistory {
+		edges = append(edges, task.EdgeHistory)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, task.EdgeRevisions)
+	}
+	if m.clearedfield_values {
+		edges = append(edges, task.EdgeFieldValues)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case task.EdgeTags:
+		return m.clearedtags
+	case task.EdgeHistory:
+		return m.clearedhistory
+	case task.EdgeRevisions:
+		return m.clearedrevisions
+	case task.EdgeFieldValues:
+		return m.clearedfield_values
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Task unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskMutation) ResetEdge(name string) error {
+	switch name {
+	case task.EdgeTags:
+		m.ResetTags()
+		return nil
+	case task.EdgeHistory:
+		m.ResetHistory()
+		return nil
+	case task.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case task.EdgeFieldValues:
+		m.ResetFieldValues()
+		return nil
+	}
+	return fmt.Errorf("unknown Task edge %s", name)
+}
+
+// TaskFieldValueMutation represents an operation that mutates the TaskFieldValue nodes in the graph.
+type TaskFieldValueMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	clearedFields map[string]struct{}
+	task          *int
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*TaskFieldValue, error)
+	predicates    []predicate.TaskFieldValue
+}
+
+var _ ent.Mutation = (*TaskFieldValueMutation)(nil)
+
+// taskfieldvalueOption allows management of the mutation configuration using functional options.
+type taskfieldvalueOption func(*TaskFieldValueMutation)
+
+// newTaskFieldValueMutation creates new mutation for the TaskFieldValue entity.
+func newTaskFieldValueMutation(c config, op Op, opts ...taskfieldvalueOption) *TaskFieldValueMutation {
+	m := &TaskFieldValueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskFieldValue,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskFieldValueID sets the ID field of the mutation.
+func withTaskFieldValueID(id int) taskfieldvalueOption {
+	return func(m *TaskFieldValueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskFieldValue
+		)
+		m.oldValue = func(ctx context.Context) (*TaskFieldValue, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskFieldValue.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskFieldValue sets the old TaskFieldValue of the mutation.
+func withTaskFieldValue(node *TaskFieldValue) taskfieldvalueOption {
+	return func(m *TaskFieldValueMutation) {
+		m.oldValue = func(context.Context) (*TaskFieldValue, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskFieldValueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskFieldValueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskFieldValueMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskFieldValueMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskFieldValue.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *TaskFieldValueMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *TaskFieldValueMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the TaskFieldValue entity.
+// If the TaskFieldValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskFieldValueMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *TaskFieldValueMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *TaskFieldValueMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *TaskFieldValueMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the TaskFieldValue entity.
+// If the TaskFieldValue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskFieldValueMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *TaskFieldValueMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetTaskID sets the "task" edge to the Task entity by id.
+func (m *TaskFieldValueMutation) SetTaskID(id int) {
+	m.task = &id
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskFieldValueMutation) ClearTask() {
+	m.clearedtask = true
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskFieldValueMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskID returns the "task" edge ID in the mutation.
+func (m *TaskFieldValueMutation) TaskID() (id int, exists bool) {
+	if m.task != nil {
+		return *m.task, true
+	}
+	return
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskFieldValueMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskFieldValueMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the TaskFieldValueMutation builder.
+func (m *TaskFieldValueMutation) Where(ps ...predicate.TaskFieldValue) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskFieldValueMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskFieldValueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskFieldValue, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskFieldValueMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskFieldValueMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskFieldValue).
+func (m *TaskFieldValueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskFieldValueMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key != nil {
+		fields = append(fields, taskfieldvalue.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, taskfieldvalue.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskFieldValueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskfieldvalue.FieldKey:
+		return m.Key()
+	case taskfieldvalue.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskFieldValueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskfieldvalue.FieldKey:
+		return m.OldKey(ctx)
+	case taskfieldvalue.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskFieldValue field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskFieldValueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskfieldvalue.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case taskfieldvalue.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskFieldValue field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskFieldValueMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskFieldValueMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskFieldValueMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TaskFieldValue numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskFieldValueMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskFieldValueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskFieldValueMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TaskFieldValue nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskFieldValueMutation) ResetField(name string) error {
+	switch name {
+	case taskfieldvalue.FieldKey:
+		m.ResetKey()
+		return nil
+	case taskfieldvalue.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskFieldValue field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskFieldValueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, taskfieldvalue.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskFieldValueMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case taskfieldvalue.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskFieldValueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskFieldValueMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskFieldValueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, taskfieldvalue.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskFieldValueMutation) EdgeCleared(name string) bool {
+	switch name {
+	case taskfieldvalue.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskFieldValueMutation) ClearEdge(name string) error {
+	switch name {
+	case taskfieldvalue.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskFieldValue unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskFieldValueMutation) ResetEdge(name string) error {
+	switch name {
+	case taskfieldvalue.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskFieldValue edge %s", name)
+}
+
+// TaskHistoryMutation represents an operation that mutates the TaskHistory nodes in the graph.
+type TaskHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	action        *string
+	details       *string
+	actor         *string
+	changes       *[]history.Change
+	appendchanges []history.Change
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	task          *int
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*TaskHistory, error)
+	predicates    []predicate.TaskHistory
+}
+
+var _ ent.Mutation = (*TaskHistoryMutation)(nil)
+
+// taskhistoryOption allows management of the mutation configuration using functional options.
+type taskhistoryOption func(*TaskHistoryMutation)
+
+// newTaskHistoryMutation creates new mutation for the TaskHistory entity.
+func newTaskHistoryMutation(c config, op Op, opts ...taskhistoryOption) *TaskHistoryMutation {
+	m := &TaskHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskHistoryID sets the ID field of the mutation.
+func withTaskHistoryID(id int) taskhistoryOption {
+	return func(m *TaskHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskHistory
+		)
+		m.oldValue = func(ctx context.Context) (*TaskHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskHistory sets the old TaskHistory of the mutation.
+func withTaskHistory(node *TaskHistory) taskhistoryOption {
+	return func(m *TaskHistoryMutation) {
+		m.oldValue = func(context.Context) (*TaskHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAction sets the "action" field.
+func (m *TaskHistoryMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *TaskHistoryMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *TaskHistoryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetDetails sets the "details" field.
+func (m *TaskHistoryMutation) SetDetails(s string) {
+	m.details = &s
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *TaskHistoryMutation) Details() (r string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldDetails(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *TaskHistoryMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[taskhistory.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *TaskHistoryMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[taskhistory.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *TaskHistoryMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, taskhistory.FieldDetails)
+}
+
+// SetActor sets the "actor" field.
+func (m *TaskHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *TaskHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *TaskHistoryMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *TaskHistoryMutation) SetChanges(h []history.Change) {
+	m.changes = &h
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *TaskHistoryMutation) Changes() (r []history.Change, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldChanges(ctx context.Context) (v []history.Change, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds h to the "changes" field.
+func (m *TaskHistoryMutation) AppendChanges(h []history.Change) {
+	m.appendchanges = append(m.appendchanges, h...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *TaskHistoryMutation) AppendedChanges() ([]history.Change, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *TaskHistoryMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[taskhistory.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *TaskHistoryMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[taskhistory.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *TaskHistoryMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, taskhistory.FieldChanges)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskHistory entity.
+// If the TaskHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTaskID sets the "task" edge to the Task entity by id.
+func (m *TaskHistoryMutation) SetTaskID(id int) {
+	m.task = &id
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskHistoryMutation) ClearTask() {
+	m.clearedtask = true
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskHistoryMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskID returns the "task" edge ID in the mutation.
+func (m *TaskHistoryMutation) TaskID() (id int, exists bool) {
+	if m.task != nil {
+		return *m.task, true
+	}
+	return
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskHistoryMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskHistoryMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the TaskHistoryMutation builder.
+func (m *TaskHistoryMutation) Where(ps ...predicate.TaskHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskHistory).
+func (m *TaskHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.action != nil {
+		fields = append(fields, taskhistory.FieldAction)
+	}
+	if m.details != nil {
+		fields = append(fields, taskhistory.FieldDetails)
+	}
+	if m.actor != nil {
+		fields = append(fields, taskhistory.FieldActor)
+	}
+	if m.changes != nil {
+		fields = append(fields, taskhistory.FieldChanges)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taskhistory.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskhistory.FieldAction:
+		return m.Action()
+	case taskhistory.FieldDetails:
+		return m.Details()
+	case taskhistory.FieldActor:
+		return m.Actor()
+	case taskhistory.FieldChanges:
+		return m.Changes()
+	case taskhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskhistory.FieldAction:
+		return m.OldAction(ctx)
+	case taskhistory.FieldDetails:
+		return m.OldDetails(ctx)
+	case taskhistory.FieldActor:
+		return m.OldActor(ctx)
+	case taskhistory.FieldChanges:
+		return m.OldChanges(ctx)
+	case taskhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskhistory.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case taskhistory.FieldDetails:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case taskhistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case taskhistory.FieldChanges:
+		v, ok := value.([]history.Change)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case taskhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskHistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TaskHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(taskhistory.FieldDetails) {
+		fields = append(fields, taskhistory.FieldDetails)
+	}
+	if m.FieldCleared(taskhistory.FieldChanges) {
+		fields = append(fields, taskhistory.FieldChanges)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskHistoryMutation) ClearField(name string) error {
+	switch name {
+	case taskhistory.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case taskhistory.FieldChanges:
+		m.ClearChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskHistoryMutation) ResetField(name string) error {
+	switch name {
+	case taskhistory.FieldAction:
+		m.ResetAction()
+		return nil
+	case taskhistory.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case taskhistory.FieldActor:
+		m.ResetActor()
+		return nil
+	case taskhistory.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case taskhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, taskhistory.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case taskhistory.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, taskhistory.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case taskhistory.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case taskhistory.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case taskhistory.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskHistory edge %s", name)
+}
+
+// TaskRevisionMutation represents an operation that mutates the TaskRevision nodes in the graph.
+type TaskRevisionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	revision      *int
+	addrevision   *int
+	title         *string
+	description   *string
+	column        *string
+	assignee      *string
+	priority      *string
+	due           *string
+	tags          *[]string
+	appendtags    []string
+	fields        *map[string]string
+	actor         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	task          *int
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*TaskRevision, error)
+	predicates    []predicate.TaskRevision
+}
+
+var _ ent.Mutation = (*TaskRevisionMutation)(nil)
+
+// taskrevisionOption allows management of the mutation configuration using functional options.
+type taskrevisionOption func(*TaskRevisionMutation)
+
+// newTaskRevisionMutation creates new mutation for the TaskRevision entity.
+func newTaskRevisionMutation(c config, op Op, opts ...taskrevisionOption) *TaskRevisionMutation {
+	m := &TaskRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskRevisionID sets the ID field of the mutation.
+func withTaskRevisionID(id int) taskrevisionOption {
+	return func(m *TaskRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskRevision
+		)
+		m.oldValue = func(ctx context.Context) (*TaskRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskRevision sets the old TaskRevision of the mutation.
+func withTaskRevision(node *TaskRevision) taskrevisionOption {
+	return func(m *TaskRevisionMutation) {
+		m.oldValue = func(context.Context) (*TaskRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskRevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskRevisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRevision sets the "revision" field.
+func (m *TaskRevisionMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *TaskRevisionMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *TaskRevisionMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *TaskRevisionMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *TaskRevisionMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TaskRevisionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskRevisionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskRevisionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskRevisionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskRevisionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskRevisionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[taskrevision.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskRevisionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[taskrevision.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskRevisionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, taskrevision.FieldDescription)
+}
+
+// SetColumn sets the "column" field.
+func (m *TaskRevisionMutation) SetColumn(s string) {
+	m.column = &s
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *TaskRevisionMutation) Column() (r string, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
+}
+
+// ResetColumn resets all changes to the "column" field.
+func (m *TaskRevisionMutation) ResetColumn() {
+	m.column = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *TaskRevisionMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *TaskRevisionMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *TaskRevisionMutation) ResetAssignee() {
+	m.assignee = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *TaskRevisionMutation) SetPriority(s string) {
+	m.priority = &s
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TaskRevisionMutation) Priority() (r string, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldPriority(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *TaskRevisionMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// SetDue sets the "due" field.
+func (m *TaskRevisionMutation) SetDue(s string) {
+	m.due = &s
+}
+
+// Due returns the value of the "due" field in the mutation.
+func (m *TaskRevisionMutation) Due() (r string, exists bool) {
+	v := m.due
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDue returns the old "due" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldDue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDue: %w", err)
+	}
+	return oldValue.Due, nil
+}
+
+// ResetDue resets all changes to the "due" field.
+func (m *TaskRevisionMutation) ResetDue() {
+	m.due = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *TaskRevisionMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *TaskRevisionMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *TaskRevisionMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *TaskRevisionMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *TaskRevisionMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[taskrevision.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *TaskRevisionMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[taskrevision.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *TaskRevisionMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, taskrevision.FieldTags)
+}
+
+// SetFields sets the "fields" field.
+func (m *TaskRevisionMutation) SetFields(value map[string]string) {
+	m.fields = &value
+}
+
+// GetFields returns the value of the "fields" field in the mutation.
+func (m *TaskRevisionMutation) GetFields() (r map[string]string, exists bool) {
+	v := m.fields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFields returns the old "fields" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldFields(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFields: %w", err)
+	}
+	return oldValue.Fields, nil
+}
+
+// ClearFields clears the value of the "fields" field.
+func (m *TaskRevisionMutation) ClearFields() {
+	m.fields = nil
+	m.clearedFields[taskrevision.FieldFields] = struct{}{}
+}
+
+// FieldsCleared returns if the "fields" field was cleared in this mutation.
+func (m *TaskRevisionMutation) FieldsCleared() bool {
+	_, ok := m.clearedFields[taskrevision.FieldFields]
+	return ok
+}
+
+// ResetFields resets all changes to the "fields" field.
+func (m *TaskRevisionMutation) ResetFields() {
+	m.fields = nil
+	delete(m.clearedFields, taskrevision.FieldFields)
+}
+
+// SetActor sets the "actor" field.
+func (m *TaskRevisionMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *TaskRevisionMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *TaskRevisionMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskRevision entity.
+// If the TaskRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTaskID sets the "task" edge to the Task entity by id.
+func (m *TaskRevisionMutation) SetTaskID(id int) {
+	m.task = &id
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskRevisionMutation) ClearTask() {
+	m.clearedtask = true
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskRevisionMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskID returns the "task" edge ID in the mutation.
+func (m *TaskRevisionMutation) TaskID() (id int, exists bool) {
+	if m.task != nil {
+		return *m.task, true
+	}
+	return
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskRevisionMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskRevisionMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the TaskRevisionMutation builder.
+func (m *TaskRevisionMutation) Where(ps ...predicate.TaskRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskRevision).
+func (m *TaskRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.revision != nil {
+		fields = append(fields, taskrevision.FieldRevision)
+	}
+	if m.title != nil {
+		fields = append(fields, taskrevision.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, taskrevision.FieldDescription)
+	}
+	if m.column != nil {
+		fields = append(fields, taskrevision.FieldColumn)
+	}
+	if m.assignee != nil {
+		fields = append(fields, taskrevision.FieldAssignee)
+	}
+	if m.priority != nil {
+		fields = append(fields, taskrevision.FieldPriority)
+	}
+	if m.due != nil {
+		fields = append(fields, taskrevision.FieldDue)
+	}
+	if m.tags != nil {
+		fields = append(fields, taskrevision.FieldTags)
+	}
+	if m.fields != nil {
+		fields = append(fields, taskrevision.FieldFields)
+	}
+	if m.actor != nil {
+		fields = append(fields, taskrevision.FieldActor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taskrevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskrevision.FieldRevision:
+		return m.Revision()
+	case taskrevision.FieldTitle:
+		return m.Title()
+	case taskrevision.FieldDescription:
+		return m.Description()
+	case taskrevision.FieldColumn:
+		return m.Column()
+	case taskrevision.FieldAssignee:
+		return m.Assignee()
+	case taskrevision.FieldPriority:
+		return m.Priority()
+	case taskrevision.FieldDue:
+		return m.Due()
+	case taskrevision.FieldTags:
+		return m.Tags()
+	case taskrevision.FieldFields:
+		return m.GetFields()
+	case taskrevision.FieldActor:
+		return m.Actor()
+	case taskrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskrevision.FieldRevision:
+		return m.OldRevision(ctx)
+	case taskrevision.FieldTitle:
+		return m.OldTitle(ctx)
+	case taskrevision.FieldDescription:
+		return m.OldDescription(ctx)
+	case taskrevision.FieldColumn:
+		return m.OldColumn(ctx)
+	case taskrevision.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case taskrevision.FieldPriority:
+		return m.OldPriority(ctx)
+	case taskrevision.FieldDue:
+		return m.OldDue(ctx)
+	case taskrevision.FieldTags:
+		return m.OldTags(ctx)
+	case taskrevision.FieldFields:
+		return m.OldFields(ctx)
+	case taskrevision.FieldActor:
+		return m.OldActor(ctx)
+	case taskrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskrevision.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	case taskrevision.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case taskrevision.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case taskrevision.FieldColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn(v)
+		return nil
+	case taskrevision.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case taskrevision.FieldPriority:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case taskrevision.FieldDue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDue(v)
+		return nil
+	case taskrevision.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case taskrevision.FieldFields:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFields(v)
+		return nil
+	case taskrevision.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case taskrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, taskrevision.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taskrevision.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case taskrevision.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(taskrevision.FieldDescription) {
+		fields = append(fields, taskrevision.FieldDescription)
+	}
+	if m.FieldCleared(taskrevision.FieldTags) {
+		fields = append(fields, taskrevision.FieldTags)
+	}
+	if m.FieldCleared(taskrevision.FieldFields) {
+		fields = append(fields, taskrevision.FieldFields)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskRevisionMutation) ClearField(name string) error {
+	switch name {
+	case taskrevision.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case taskrevision.FieldTags:
+		m.ClearTags()
+		return nil
+	case taskrevision.FieldFields:
+		m.ClearFields()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskRevisionMutation) ResetField(name string) error {
+	switch name {
+	case taskrevision.FieldRevision:
+		m.ResetRevision()
+		return nil
+	case taskrevision.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case taskrevision.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case taskrevision.FieldColumn:
+		m.ResetColumn()
+		return nil
+	case taskrevision.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case taskrevision.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case taskrevision.FieldDue:
+		m.ResetDue()
+		return nil
+	case taskrevision.FieldTags:
+		m.ResetTags()
+		return nil
+	case taskrevision.FieldFields:
+		m.ResetFields()
+		return nil
+	case taskrevision.FieldActor:
+		m.ResetActor()
+		return nil
+	case taskrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, taskrevision.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case taskrevision.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, taskrevision.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case taskrevision.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case taskrevision.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case taskrevision.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskRevision edge %s", name)
+}
+
+// TaskTagMutation represents an operation that mutates the TaskTag nodes in the graph.
+type TaskTagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	task          *int
+	clearedtask   bool
+	done          bool
+	oldValue      func(context.Context) (*TaskTag, error)
+	predicates    []predicate.TaskTag
+}
+
+var _ ent.Mutation = (*TaskTagMutation)(nil)
+
+// tasktagOption allows management of the mutation configuration using functional options.
+type tasktagOption func(*TaskTagMutation)
+
+// newTaskTagMutation creates new mutation for the TaskTag entity.
+func newTaskTagMutation(c config, op Op, opts ...tasktagOption) *TaskTagMutation {
+	m := &TaskTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskTagID sets the ID field of the mutation.
+func withTaskTagID(id int) tasktagOption {
+	return func(m *TaskTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskTag
+		)
+		m.oldValue = func(ctx context.Context) (*TaskTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskTag sets the old TaskTag of the mutation.
+func withTaskTag(node *TaskTag) tasktagOption {
+	return func(m *TaskTagMutation) {
+		m.oldValue = func(context.Context) (*TaskTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskTagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskTagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *TaskTagMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *TaskTagMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the TaskTag entity.
+// If the TaskTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTagMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *TaskTagMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *TaskTagMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *TaskTagMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the TaskTag entity.
+// If the TaskTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTagMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
 		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *TaskTagMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskTag entity.
+// If the TaskTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTaskID sets the "task" edge to the Task entity by id.
+func (m *TaskTagMutation) SetTaskID(id int) {
+	m.task = &id
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *TaskTagMutation) ClearTask() {
+	m.clearedtask = true
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *TaskTagMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskID returns the "task" edge ID in the mutation.
+func (m *TaskTagMutation) TaskID() (id int, exists bool) {
+	if m.task != nil {
+		return *m.task, true
+	}
+	return
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *TaskTagMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *TaskTagMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the TaskTagMutation builder.
+func (m *TaskTagMutation) Where(ps ...predicate.TaskTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskTag).
+func (m *TaskTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskTagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.key != nil {
+		fields = append(fields, tasktag.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, tasktag.FieldValue)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tasktag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tasktag.FieldKey:
+		return m.Key()
+	case tasktag.FieldValue:
+		return m.Value()
+	case tasktag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tasktag.FieldKey:
+		return m.OldKey(ctx)
+	case tasktag.FieldValue:
+		return m.OldValue(ctx)
+	case tasktag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tasktag.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case tasktag.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case tasktag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TaskTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TaskTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskTagMutation) ResetField(name string) error {
+	switch name {
+	case tasktag.FieldKey:
+		m.ResetKey()
+		return nil
+	case tasktag.FieldValue:
+		m.ResetValue()
+		return nil
+	case tasktag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, tasktag.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tasktag.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, tasktag.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tasktag.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskTagMutation) ClearEdge(name string) error {
+	switch name {
+	case tasktag.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskTagMutation) ResetEdge(name string) error {
+	switch name {
+	case tasktag.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTag edge %s", name)
+}
+
+// TaskTemplateMutation represents an operation that mutates the TaskTemplate nodes in the graph.
+type TaskTemplateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	title         *string
+	description   *string
+	tags          *[]string
+	appendtags    []string
+	column        *string
+	assignee      *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TaskTemplate, error)
+	predicates    []predicate.TaskTemplate
+}
+
+var _ ent.Mutation = (*TaskTemplateMutation)(nil)
+
+// tasktemplateOption allows management of the mutation configuration using functional options.
+type tasktemplateOption func(*TaskTemplateMutation)
+
+// newTaskTemplateMutation creates new mutation for the TaskTemplate entity.
+func newTaskTemplateMutation(c config, op Op, opts ...tasktemplateOption) *TaskTemplateMutation {
+	m := &TaskTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskTemplateID sets the ID field of the mutation.
+func withTaskTemplateID(id int) tasktemplateOption {
+	return func(m *TaskTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*TaskTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskTemplate sets the old TaskTemplate of the mutation.
+func withTaskTemplate(node *TaskTemplate) tasktemplateOption {
+	return func(m *TaskTemplateMutation) {
+		m.oldValue = func(context.Context) (*TaskTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskTemplateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskTemplateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TaskTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TaskTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TaskTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TaskTemplateMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaskTemplateMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaskTemplateMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[tasktemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[tasktemplate.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, tasktemplate.FieldDescription)
+}
+
+// SetTags sets the "tags" field.
+func (m *TaskTemplateMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *TaskTemplateMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *TaskTemplateMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *TaskTemplateMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *TaskTemplateMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[tasktemplate.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *TaskTemplateMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[tasktemplate.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *TaskTemplateMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, tasktemplate.FieldTags)
+}
+
+// SetColumn sets the "column" field.
+func (m *TaskTemplateMutation) SetColumn(s string) {
+	m.column = &s
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *TaskTemplateMutation) Column() (r string, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
+}
+
+// ResetColumn resets all changes to the "column" field.
+func (m *TaskTemplateMutation) ResetColumn() {
+	m.column = nil
+}
+
+// SetAssignee sets the "assignee" field.
+func (m *TaskTemplateMutation) SetAssignee(s string) {
+	m.assignee = &s
+}
+
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *TaskTemplateMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignee returns the old "assignee" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldAssignee(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	}
+	return oldValue.Assignee, nil
+}
+
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *TaskTemplateMutation) ResetAssignee() {
+	m.assignee = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskTemplate entity.
+// If the TaskTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TaskTemplateMutation builder.
+func (m *TaskTemplateMutation) Where(ps ...predicate.TaskTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskTemplateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskTemplate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskTemplateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskTemplateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskTemplate).
+func (m *TaskTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, tasktemplate.FieldName)
+	}
+	if m.title != nil {
+		fields = append(fields, tasktemplate.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, tasktemplate.FieldDescription)
+	}
+	if m.tags != nil {
+		fields = append(fields, tasktemplate.FieldTags)
+	}
+	if m.column != nil {
+		fields = append(fields, tasktemplate.FieldColumn)
+	}
+	if m.assignee != nil {
+		fields = append(fields, tasktemplate.FieldAssignee)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tasktemplate.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tasktemplate.FieldName:
+		return m.Name()
+	case tasktemplate.FieldTitle:
+		return m.Title()
+	case tasktemplate.FieldDescription:
+		return m.Description()
+	case tasktemplate.FieldTags:
+		return m.Tags()
+	case tasktemplate.FieldColumn:
+		return m.Column()
+	case tasktemplate.FieldAssignee:
+		return m.Assignee()
+	case tasktemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tasktemplate.FieldName:
+		return m.OldName(ctx)
+	case tasktemplate.FieldTitle:
+		return m.OldTitle(ctx)
+	case tasktemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case tasktemplate.FieldTags:
+		return m.OldTags(ctx)
+	case tasktemplate.FieldColumn:
+		return m.OldColumn(ctx)
+	case tasktemplate.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case tasktemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tasktemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tasktemplate.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case tasktemplate.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case tasktemplate.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case tasktemplate.FieldColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColumn(v)
+		return nil
+	case tasktemplate.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case tasktemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskTemplateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TaskTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tasktemplate.FieldDescription) {
+		fields = append(fields, tasktemplate.FieldDescription)
+	}
+	if m.FieldCleared(tasktemplate.FieldTags) {
+		fields = append(fields, tasktemplate.FieldTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskTemplateMutation) ClearField(name string) error {
+	switch name {
+	case tasktemplate.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case tasktemplate.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskTemplateMutation) ResetField(name string) error {
+	switch name {
+	case tasktemplate.FieldName:
+		m.ResetName()
+		return nil
+	case tasktemplate.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case tasktemplate.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case tasktemplate.FieldTags:
+		m.ResetTags()
+		return nil
+	case tasktemplate.FieldColumn:
+		m.ResetColumn()
+		return nil
+	case tasktemplate.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case tasktemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskTemplateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskTemplateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskTemplateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskTemplateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskTemplateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskTemplate edge %s", name)
+}
+
+// TransitionMutation represents an operation that mutates the Transition nodes in the graph.
+type TransitionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	from_column   *string
+	to_column     *string
+	guards        *[]string
+	appendguards  []string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Transition, error)
+	predicates    []predicate.Transition
+}
+
+var _ ent.Mutation = (*TransitionMutation)(nil)
+
+// transitionOption allows management of the mutation configuration using functional options.
+type transitionOption func(*TransitionMutation)
+
+// newTransitionMutation creates new mutation for the Transition entity.
+func newTransitionMutation(c config, op Op, opts ...transitionOption) *TransitionMutation {
+	m := &TransitionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransitionID sets the ID field of the mutation.
+func withTransitionID(id int) transitionOption {
+	return func(m *TransitionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transition
+		)
+		m.oldValue = func(ctx context.Context) (*Transition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransition sets the old Transition of the mutation.
+func withTransition(node *Transition) transitionOption {
+	return func(m *TransitionMutation) {
+		m.oldValue = func(context.Context) (*Transition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransitionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransitionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransitionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransitionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFromColumn sets the "from_column" field.
+func (m *TransitionMutation) SetFromColumn(s string) {
+	m.from_column = &s
+}
+
+// FromColumn returns the value of the "from_column" field in the mutation.
+func (m *TransitionMutation) FromColumn() (r string, exists bool) {
+	v := m.from_column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromColumn returns the old "from_column" field's value of the Transition entity.
+// If the Transition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransitionMutation) OldFromColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromColumn: %w", err)
+	}
+	return oldValue.FromColumn, nil
+}
+
+// ResetFromColumn resets all changes to the "from_column" field.
+func (m *TransitionMutation) ResetFromColumn() {
+	m.from_column = nil
+}
+
+// SetToColumn sets the "to_column" field.
+func (m *TransitionMutation) SetToColumn(s string) {
+	m.to_column = &s
+}
+
+// ToColumn returns the value of the "to_column" field in the mutation.
+func (m *TransitionMutation) ToColumn() (r string, exists bool) {
+	v := m.to_column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToColumn returns the old "to_column" field's value of the Transition entity.
+// If the Transition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransitionMutation) OldToColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToColumn: %w", err)
+	}
+	return oldValue.ToColumn, nil
+}
+
+// ResetToColumn resets all changes to the "to_column" field.
+func (m *TransitionMutation) ResetToColumn() {
+	m.to_column = nil
+}
+
+// SetGuards sets the "guards" field.
+func (m *TransitionMutation) SetGuards(s []string) {
+	m.guards = &s
+	m.appendguards = nil
+}
+
+// Guards returns the value of the "guards" field in the mutation.
+func (m *TransitionMutation) Guards() (r []string, exists bool) {
+	v := m.guards
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuards returns the old "guards" field's value of the Transition entity.
+// If the Transition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransitionMutation) OldGuards(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuards is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuards requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuards: %w", err)
+	}
+	return oldValue.Guards, nil
+}
+
+// AppendGuards adds s to the "guards" field.
+func (m *TransitionMutation) AppendGuards(s []string) {
+	m.appendguards = append(m.appendguards, s...)
+}
+
+// AppendedGuards returns the list of values that were appended to the "guards" field in this mutation.
+func (m *TransitionMutation) AppendedGuards() ([]string, bool) {
+	if len(m.appendguards) == 0 {
+		return nil, false
+	}
+	return m.appendguards, true
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (m *TransitionMutation) ClearGuards() {
+	m.guards = nil
+	m.appendguards = nil
+	m.clearedFields[transition.FieldGuards] = struct{}{}
+}
+
+// GuardsCleared returns if the "guards" field was cleared in this mutation.
+func (m *TransitionMutation) GuardsCleared() bool {
+	_, ok := m.clearedFields[transition.FieldGuards]
+	return ok
+}
+
+// ResetGuards resets all changes to the "guards" field.
+func (m *TransitionMutation) ResetGuards() {
+	m.guards = nil
+	m.appendguards = nil
+	delete(m.clearedFields, transition.FieldGuards)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TransitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TransitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Transition entity.
+// If the Transition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TransitionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TransitionMutation builder.
+func (m *TransitionMutation) Where(ps ...predicate.Transition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransitionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransitionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransitionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transition).
+func (m *TransitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransitionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.from_column != nil {
+		fields = append(fields, transition.FieldFromColumn)
+	}
+	if m.to_column != nil {
+		fields = append(fields, transition.FieldToColumn)
+	}
+	if m.guards != nil {
+		fields = append(fields, transition.FieldGuards)
+	}
+	if m.created_at != nil {
+		fields = append(fields, transition.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transition.FieldFromColumn:
+		return m.FromColumn()
+	case transition.FieldToColumn:
+		return m.ToColumn()
+	case transition.FieldGuards:
+		return m.Guards()
+	case transition.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transition.FieldFromColumn:
+		return m.OldFromColumn(ctx)
+	case transition.FieldToColumn:
+		return m.OldToColumn(ctx)
+	case transition.FieldGuards:
+		return m.OldGuards(ctx)
+	case transition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransitionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transition.FieldFromColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromColumn(v)
+		return nil
+	case transition.FieldToColumn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToColumn(v)
+		return nil
+	case transition.FieldGuards:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuards(v)
+		return nil
+	case transition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransitionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransitionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransitionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Transition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transition.FieldGuards) {
+		fields = append(fields, transition.FieldGuards)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransitionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransitionMutation) ClearField(name string) error {
+	switch name {
+	case transition.FieldGuards:
+		m.ClearGuards()
+		return nil
+	}
+	return fmt.Errorf("unknown Transition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransitionMutation) ResetField(name string) error {
+	switch name {
+	case transition.FieldFromColumn:
+		m.ResetFromColumn()
+		return nil
+	case transition.FieldToColumn:
+		m.ResetToColumn()
+		return nil
+	case transition.FieldGuards:
+		m.ResetGuards()
+		return nil
+	case transition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Transition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransitionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransitionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransitionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransitionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Transition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransitionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Transition edge %s", name)
+}
+
+// WipLimitMutation represents an operation that mutates the WipLimit nodes in the graph.
+type WipLimitMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	column        *string
+	assignee      *string
+	max_tasks     *int
+	addmax_tasks  *int
+	mode          *wiplimit.Mode
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WipLimit, error)
+	predicates    []predicate.WipLimit
+}
+
+var _ ent.Mutation = (*WipLimitMutation)(nil)
+
+// wiplimitOption allows management of the mutation configuration using functional options.
+type wiplimitOption func(*WipLimitMutation)
+
+// newWipLimitMutation creates new mutation for the WipLimit entity.
+func newWipLimitMutation(c config, op Op, opts ...wiplimitOption) *WipLimitMutation {
+	m := &WipLimitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWipLimit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWipLimitID sets the ID field of the mutation.
+func withWipLimitID(id int) wiplimitOption {
+	return func(m *WipLimitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WipLimit
+		)
+		m.oldValue = func(ctx context.Context) (*WipLimit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WipLimit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWipLimit sets the old WipLimit of the mutation.
+func withWipLimit(node *WipLimit) wiplimitOption {
+	return func(m *WipLimitMutation) {
+		m.oldValue = func(context.Context) (*WipLimit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WipLimitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WipLimitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WipLimitMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WipLimitMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WipLimit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetColumn sets the "column" field.
+func (m *WipLimitMutation) SetColumn(s string) {
+	m.column = &s
+}
+
+// Column returns the value of the "column" field in the mutation.
+func (m *WipLimitMutation) Column() (r string, exists bool) {
+	v := m.column
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColumn returns the old "column" field's value of the WipLimit entity.
+// If the WipLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WipLimitMutation) OldColumn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColumn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColumn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColumn: %w", err)
+	}
+	return oldValue.Column, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *TaskTagMutation) ResetValue() {
-	m.value = nil
+// ResetColumn resets all changes to the "column" field.
+func (m *WipLimitMutation) ResetColumn() {
+	m.column = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TaskTagMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAssignee sets the "assignee" field.
+func (m *WipLimitMutation) SetAssignee(s string) {
+	m.assignee = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskTagMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *WipLimitMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TaskTag entity.
-// If the TaskTag object wasn't provided to the builder, the object is fetched from the database.
+// OldAssignee returns the old "assignee" field's value of the WipLimit entity.
+// If the WipLimit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WipLimitMutation) OldAssignee(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Assignee, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskTagMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *WipLimitMutation) ResetAssignee() {
+	m.assignee = nil
 }
 
-// SetTaskID sets the "task" edge to the Task entity by id.
-func (m *TaskTagMutation) SetTaskID(id int) {
-	m.task = &id
+// SetMaxTasks sets the "max_tasks" field.
+func (m *WipLimitMutation) SetMaxTasks(i int) {
+	m.max_tasks = &i
+	m.addmax_tasks = nil
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *TaskTagMutation) ClearTask() {
-	m.clearedtask = true
+// MaxTasks returns the value of the "max_tasks" field in the mutation.
+func (m *WipLimitMutation) MaxTasks() (r int, exists bool) {
+	v := m.max_tasks
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *TaskTagMutation) TaskCleared() bool {
-	return m.clearedtask
+// OldMaxTasks returns the old "max_tasks" field's value of the WipLimit entity.
+// If the WipLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WipLimitMutation) OldMaxTasks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxTasks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxTasks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxTasks: %w", err)
+	}
+	return oldValue.MaxTasks, nil
 }
 
-// TaskID returns the "task" edge ID in the mutation.
-func (m *TaskTagMutation) TaskID() (id int, exists bool) {
-	if m.task != nil {
-		return *m.task, true
+// AddMaxTasks adds i to the "max_tasks" field.
+func (m *WipLimitMutation) AddMaxTasks(i int) {
+	if m.addmax_tasks != nil {
+		*m.addmax_tasks += i
+	} else {
+		m.addmax_tasks = &i
 	}
-	return
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *TaskTagMutation) TaskIDs() (ids []int) {
-	if id := m.task; id != nil {
-		ids = append(ids, *id)
+// AddedMaxTasks returns the value that was added to the "max_tasks" field in this mutation.
+func (m *WipLimitMutation) AddedMaxTasks() (r int, exists bool) {
+	v := m.addmax_tasks
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *TaskTagMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ResetMaxTasks resets all changes to the "max_tasks" field.
+func (m *WipLimitMutation) ResetMaxTasks() {
+	m.max_tasks = nil
+	m.addmax_tasks = nil
 }
 
-// Where appends a list predicates to the TaskTagMutation builder.
-func (m *TaskTagMutation) Where(ps ...predicate.TaskTag) {
+// SetMode sets the "mode" field.
+func (m *WipLimitMutation) SetMode(w wiplimit.Mode) {
+	m.mode = &w
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *WipLimitMutation) Mode() (r wiplimit.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the WipLimit entity.
+// If the WipLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WipLimitMutation) OldMode(ctx context.Context) (v wiplimit.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *WipLimitMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WipLimitMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WipLimitMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WipLimit entity.
+// If the WipLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WipLimitMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WipLimitMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the WipLimitMutation builder.
+func (m *WipLimitMutation) Where(ps ...predicate.WipLimit) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskTagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WipLimitMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TaskTag, len(ps))
+func (m *WipLimitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WipLimit, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1770,33 +10329,39 @@ func (m *TaskTagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskTagMutation) Op() Op {
+func (m *WipLimitMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskTagMutation) SetOp(op Op) {
+func (m *WipLimitMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TaskTag).
-func (m *TaskTagMutation) Type() string {
+// Type returns the node type of this mutation (WipLimit).
+func (m *WipLimitMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskTagMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.key != nil {
-		fields = append(fields, tasktag.FieldKey)
+func (m *WipLimitMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.column != nil {
+		fields = append(fields, wiplimit.FieldColumn)
 	}
-	if m.value != nil {
-		fields = append(fields, tasktag.FieldValue)
+	if m.assignee != nil {
+		fields = append(fields, wiplimit.FieldAssignee)
+	}
+	if m.max_tasks != nil {
+		fields = append(fields, wiplimit.FieldMaxTasks)
+	}
+	if m.mode != nil {
+		fields = append(fields, wiplimit.FieldMode)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tasktag.FieldCreatedAt)
+		fields = append(fields, wiplimit.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1804,13 +10369,17 @@ func (m *TaskTagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskTagMutation) Field(name string) (ent.Value, bool) {
+func (m *WipLimitMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tasktag.FieldKey:
-		return m.Key()
-	case tasktag.FieldValue:
-		return m.Value()
-	case tasktag.FieldCreatedAt:
+	case wiplimit.FieldColumn:
+		return m.Column()
+	case wiplimit.FieldAssignee:
+		return m.Assignee()
+	case wiplimit.FieldMaxTasks:
+		return m.MaxTasks()
+	case wiplimit.FieldMode:
+		return m.Mode()
+	case wiplimit.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1819,38 +10388,56 @@ func (m *TaskTagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TaskTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WipLimitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tasktag.FieldKey:
-		return m.OldKey(ctx)
-	case tasktag.FieldValue:
-		return m.OldValue(ctx)
-	case tasktag.FieldCreatedAt:
+	case wiplimit.FieldColumn:
+		return m.OldColumn(ctx)
+	case wiplimit.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case wiplimit.FieldMaxTasks:
+		return m.OldMaxTasks(ctx)
+	case wiplimit.FieldMode:
+		return m.OldMode(ctx)
+	case wiplimit.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TaskTag field %s", name)
+	return nil, fmt.Errorf("unknown WipLimit field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskTagMutation) SetField(name string, value ent.Value) error {
+func (m *WipLimitMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tasktag.FieldKey:
+	case wiplimit.FieldColumn:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetColumn(v)
 		return nil
-	case tasktag.FieldValue:
+	case wiplimit.FieldAssignee:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetAssignee(v)
 		return nil
-	case tasktag.FieldCreatedAt:
+	case wiplimit.FieldMaxTasks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxTasks(v)
+		return nil
+	case wiplimit.FieldMode:
+		v, ok := value.(wiplimit.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case wiplimit.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1858,137 +10445,132 @@ func (m *TaskTagMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TaskTag field %s", name)
+	return fmt.Errorf("unknown WipLimit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskTagMutation) AddedFields() []string {
-	return nil
+func (m *WipLimitMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_tasks != nil {
+		fields = append(fields, wiplimit.FieldMaxTasks)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskTagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WipLimitMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wiplimit.FieldMaxTasks:
+		return m.AddedMaxTasks()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskTagMutation) AddField(name string, value ent.Value) error {
+func (m *WipLimitMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case wiplimit.FieldMaxTasks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxTasks(v)
+		return nil
 	}
-	return fmt.Errorf("unknown TaskTag numeric field %s", name)
+	return fmt.Errorf("unknown WipLimit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskTagMutation) ClearedFields() []string {
+func (m *WipLimitMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskTagMutation) FieldCleared(name string) bool {
+func (m *WipLimitMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskTagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TaskTag nullable field %s", name)
+func (m *WipLimitMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WipLimit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskTagMutation) ResetField(name string) error {
+func (m *WipLimitMutation) ResetField(name string) error {
 	switch name {
-	case tasktag.FieldKey:
-		m.ResetKey()
+	case wiplimit.FieldColumn:
+		m.ResetColumn()
 		return nil
-	case tasktag.FieldValue:
-		m.ResetValue()
+	case wiplimit.FieldAssignee:
+		m.ResetAssignee()
 		return nil
-	case tasktag.FieldCreatedAt:
+	case wiplimit.FieldMaxTasks:
+		m.ResetMaxTasks()
+		return nil
+	case wiplimit.FieldMode:
+		m.ResetMode()
+		return nil
+	case wiplimit.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TaskTag field %s", name)
+	return fmt.Errorf("unknown WipLimit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskTagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.task != nil {
-		edges = append(edges, tasktag.EdgeTask)
-	}
+func (m *WipLimitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskTagMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tasktag.EdgeTask:
-		if id := m.task; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WipLimitMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskTagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WipLimitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskTagMutation) RemovedIDs(name string) []ent.Value {
+func (m *WipLimitMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskTagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtask {
-		edges = append(edges, tasktag.EdgeTask)
-	}
+func (m *WipLimitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskTagMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tasktag.EdgeTask:
-		return m.clearedtask
-	}
+func (m *WipLimitMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskTagMutation) ClearEdge(name string) error {
-	switch name {
-	case tasktag.EdgeTask:
-		m.ClearTask()
-		return nil
-	}
-	return fmt.Errorf("unknown TaskTag unique edge %s", name)
+func (m *WipLimitMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WipLimit unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskTagMutation) ResetEdge(name string) error {
-	switch name {
-	case tasktag.EdgeTask:
-		m.ResetTask()
-		return nil
-	}
-	return fmt.Errorf("unknown TaskTag edge %s", name)
+func (m *WipLimitMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WipLimit edge %s", name)
 }
@@ -6,11 +6,44 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AutomationRule is the predicate function for automationrule builders.
+type AutomationRule func(*sql.Selector)
+
+// AutomationRun is the predicate function for automationrun builders.
+type AutomationRun func(*sql.Selector)
+
+// FieldDefinition is the predicate function for fielddefinition builders.
+type FieldDefinition func(*sql.Selector)
+
+// RecurringTask is the predicate function for recurringtask builders.
+type RecurringTask func(*sql.Selector)
+
+// StaleThreshold is the predicate function for stalethreshold builders.
+type StaleThreshold func(*sql.Selector)
+
+// TagDefinition is the predicate function for tagdefinition builders.
+type TagDefinition func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskFieldValue is the predicate function for taskfieldvalue builders.
+type TaskFieldValue func(*sql.Selector)
+
 // TaskHistory is the predicate function for taskhistory builders.
 type TaskHistory func(*sql.Selector)
 
+// TaskRevision is the predicate function for taskrevision builders.
+type TaskRevision func(*sql.Selector)
+
 // TaskTag is the predicate function for tasktag builders.
 type TaskTag func(*sql.Selector)
+
+// TaskTemplate is the predicate function for tasktemplate builders.
+type TaskTemplate func(*sql.Selector)
+
+// Transition is the predicate function for transition builders.
+type Transition func(*sql.Selector)
+
+// WipLimit is the predicate function for wiplimit builders.
+type WipLimit func(*sql.Selector)
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recurringtask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldDescription, v))
+}
+
+// Column applies equality check predicate on the "column" field. It's identical to ColumnEQ.
+func Column(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldColumn, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldAssignee, v))
+}
+
+// Rule applies equality check predicate on the "rule" field. It's identical to RuleEQ.
+func Rule(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldRule, v))
+}
+
+// SkipIfOpen applies equality check predicate on the "skip_if_open" field. It's identical to SkipIfOpenEQ.
+func SkipIfOpen(v bool) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldSkipIfOpen, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastTaskID applies equality check predicate on the "last_task_id" field. It's identical to LastTaskIDEQ.
+func LastTaskID(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastTaskID, v))
+}
+
+// LastResult applies equality check predicate on the "last_result" field. It's identical to LastResultEQ.
+func LastResult(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastResult, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ColumnEQ applies the EQ predicate on the "column" field.
+func ColumnEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldColumn, v))
+}
+
+// ColumnNEQ applies the NEQ predicate on the "column" field.
+func ColumnNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldColumn, v))
+}
+
+// ColumnIn applies the In predicate on the "column" field.
+func ColumnIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldColumn, vs...))
+}
+
+// ColumnNotIn applies the NotIn predicate on the "column" field.
+func ColumnNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldColumn, vs...))
+}
+
+// ColumnGT applies the GT predicate on the "column" field.
+func ColumnGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldColumn, v))
+}
+
+// ColumnGTE applies the GTE predicate on the "column" field.
+func ColumnGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldColumn, v))
+}
+
+// ColumnLT applies the LT predicate on the "column" field.
+func ColumnLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldColumn, v))
+}
+
+// ColumnLTE applies the LTE predicate on the "column" field.
+func ColumnLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldColumn, v))
+}
+
+// ColumnContains applies the Contains predicate on the "column" field.
+func ColumnContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldColumn, v))
+}
+
+// ColumnHasPrefix applies the HasPrefix predicate on the "column" field.
+func ColumnHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldColumn, v))
+}
+
+// ColumnHasSuffix applies the HasSuffix predicate on the "column" field.
+func ColumnHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldColumn, v))
+}
+
+// ColumnEqualFold applies the EqualFold predicate on the "column" field.
+func ColumnEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldColumn, v))
+}
+
+// ColumnContainsFold applies the ContainsFold predicate on the "column" field.
+func ColumnContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldColumn, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldTags))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleGT applies the GT predicate on the "rule" field.
+func RuleGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldRule, v))
+}
+
+// RuleGTE applies the GTE predicate on the "rule" field.
+func RuleGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldRule, v))
+}
+
+// RuleLT applies the LT predicate on the "rule" field.
+func RuleLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldRule, v))
+}
+
+// RuleLTE applies the LTE predicate on the "rule" field.
+func RuleLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldRule, v))
+}
+
+// RuleContains applies the Contains predicate on the "rule" field.
+func RuleContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldRule, v))
+}
+
+// RuleHasPrefix applies the HasPrefix predicate on the "rule" field.
+func RuleHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldRule, v))
+}
+
+// RuleHasSuffix applies the HasSuffix predicate on the "rule" field.
+func RuleHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldRule, v))
+}
+
+// RuleEqualFold applies the EqualFold predicate on the "rule" field.
+func RuleEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldRule, v))
+}
+
+// RuleContainsFold applies the ContainsFold predicate on the "rule" field.
+func RuleContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldRule, v))
+}
+
+// SkipIfOpenEQ applies the EQ predicate on the "skip_if_open" field.
+func SkipIfOpenEQ(v bool) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldSkipIfOpen, v))
+}
+
+// SkipIfOpenNEQ applies the NEQ predicate on the "skip_if_open" field.
+func SkipIfOpenNEQ(v bool) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldSkipIfOpen, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldNextRunAt))
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldNextRunAt))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastTaskIDEQ applies the EQ predicate on the "last_task_id" field.
+func LastTaskIDEQ(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastTaskID, v))
+}
+
+// LastTaskIDNEQ applies the NEQ predicate on the "last_task_id" field.
+func LastTaskIDNEQ(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldLastTaskID, v))
+}
+
+// LastTaskIDIn applies the In predicate on the "last_task_id" field.
+func LastTaskIDIn(vs ...int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldLastTaskID, vs...))
+}
+
+// LastTaskIDNotIn applies the NotIn predicate on the "last_task_id" field.
+func LastTaskIDNotIn(vs ...int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldLastTaskID, vs...))
+}
+
+// LastTaskIDGT applies the GT predicate on the "last_task_id" field.
+func LastTaskIDGT(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldLastTaskID, v))
+}
+
+// LastTaskIDGTE applies the GTE predicate on the "last_task_id" field.
+func LastTaskIDGTE(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldLastTaskID, v))
+}
+
+// LastTaskIDLT applies the LT predicate on the "last_task_id" field.
+func LastTaskIDLT(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldLastTaskID, v))
+}
+
+// LastTaskIDLTE applies the LTE predicate on the "last_task_id" field.
+func LastTaskIDLTE(v int) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldLastTaskID, v))
+}
+
+// LastTaskIDIsNil applies the IsNil predicate on the "last_task_id" field.
+func LastTaskIDIsNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIsNull(FieldLastTaskID))
+}
+
+// LastTaskIDNotNil applies the NotNil predicate on the "last_task_id" field.
+func LastTaskIDNotNil() predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotNull(FieldLastTaskID))
+}
+
+// LastResultEQ applies the EQ predicate on the "last_result" field.
+func LastResultEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldLastResult, v))
+}
+
+// LastResultNEQ applies the NEQ predicate on the "last_result" field.
+func LastResultNEQ(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldLastResult, v))
+}
+
+// LastResultIn applies the In predicate on the "last_result" field.
+func LastResultIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldLastResult, vs...))
+}
+
+// LastResultNotIn applies the NotIn predicate on the "last_result" field.
+func LastResultNotIn(vs ...string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldLastResult, vs...))
+}
+
+// LastResultGT applies the GT predicate on the "last_result" field.
+func LastResultGT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldLastResult, v))
+}
+
+// LastResultGTE applies the GTE predicate on the "last_result" field.
+func LastResultGTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldLastResult, v))
+}
+
+// LastResultLT applies the LT predicate on the "last_result" field.
+func LastResultLT(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldLastResult, v))
+}
+
+// LastResultLTE applies the LTE predicate on the "last_result" field.
+func LastResultLTE(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldLastResult, v))
+}
+
+// LastResultContains applies the Contains predicate on the "last_result" field.
+func LastResultContains(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContains(FieldLastResult, v))
+}
+
+// LastResultHasPrefix applies the HasPrefix predicate on the "last_result" field.
+func LastResultHasPrefix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasPrefix(FieldLastResult, v))
+}
+
+// LastResultHasSuffix applies the HasSuffix predicate on the "last_result" field.
+func LastResultHasSuffix(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldHasSuffix(FieldLastResult, v))
+}
+
+// LastResultEqualFold applies the EqualFold predicate on the "last_result" field.
+func LastResultEqualFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEqualFold(FieldLastResult, v))
+}
+
+// LastResultContainsFold applies the ContainsFold predicate on the "last_result" field.
+func LastResultContainsFold(v string) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldContainsFold(FieldLastResult, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecurringTask) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecurringTask) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecurringTask) predicate.RecurringTask {
+	return predicate.RecurringTask(sql.NotPredicates(p))
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
+)
+
+// RecurringTaskCreate is the builder for creating a RecurringTask entity.
+type RecurringTaskCreate struct {
+	config
+	mutation *RecurringTaskMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (_c *RecurringTaskCreate) SetTitle(v string) *RecurringTaskCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RecurringTaskCreate) SetDescription(v string) *RecurringTaskCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableDescription(v *string) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetColumn sets the "column" field.
+func (_c *RecurringTaskCreate) SetColumn(v string) *RecurringTaskCreate {
+	_c.mutation.SetColumn(v)
+	return _c
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableColumn(v *string) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetColumn(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *RecurringTaskCreate) SetAssignee(v string) *RecurringTaskCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableAssignee(v *string) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *RecurringTaskCreate) SetPriority(v recurringtask.Priority) *RecurringTaskCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillablePriority(v *recurringtask.Priority) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *RecurringTaskCreate) SetTags(v []string) *RecurringTaskCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetRule sets the "rule" field.
+func (_c *RecurringTaskCreate) SetRule(v string) *RecurringTaskCreate {
+	_c.mutation.SetRule(v)
+	return _c
+}
+
+// SetSkipIfOpen sets the "skip_if_open" field.
+func (_c *RecurringTaskCreate) SetSkipIfOpen(v bool) *RecurringTaskCreate {
+	_c.mutation.SetSkipIfOpen(v)
+	return _c
+}
+
+// SetNillableSkipIfOpen sets the "skip_if_open" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableSkipIfOpen(v *bool) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetSkipIfOpen(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RecurringTaskCreate) SetEnabled(v bool) *RecurringTaskCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableEnabled(v *bool) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *RecurringTaskCreate) SetNextRunAt(v time.Time) *RecurringTaskCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableNextRunAt(v *time.Time) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetNextRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *RecurringTaskCreate) SetLastRunAt(v time.Time) *RecurringTaskCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableLastRunAt(v *time.Time) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastTaskID sets the "last_task_id" field.
+func (_c *RecurringTaskCreate) SetLastTaskID(v int) *RecurringTaskCreate {
+	_c.mutation.SetLastTaskID(v)
+	return _c
+}
+
+// SetNillableLastTaskID sets the "last_task_id" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableLastTaskID(v *int) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetLastTaskID(*v)
+	}
+	return _c
+}
+
+// SetLastResult sets the "last_result" field.
+func (_c *RecurringTaskCreate) SetLastResult(v string) *RecurringTaskCreate {
+	_c.mutation.SetLastResult(v)
+	return _c
+}
+
+// SetNillableLastResult sets the "last_result" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableLastResult(v *string) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetLastResult(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RecurringTaskCreate) SetCreatedAt(v time.Time) *RecurringTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RecurringTaskCreate) SetNillableCreatedAt(v *time.Time) *RecurringTaskCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RecurringTaskMutation object of the builder.
+func (_c *RecurringTaskCreate) Mutation() *RecurringTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the RecurringTask in the database.
+func (_c *RecurringTaskCreate) Save(ctx context.Context) (*RecurringTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecurringTaskCreate) SaveX(ctx context.Context) *RecurringTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurringTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurringTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecurringTaskCreate) defaults() {
+	if _, ok := _c.mutation.Column(); !ok {
+		v := recurringtask.DefaultColumn
+		_c.mutation.SetColumn(v)
+	}
+	if _, ok := _c.mutation.Assignee(); !ok {
+		v := recurringtask.DefaultAssignee
+		_c.mutation.SetAssignee(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := recurringtask.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.SkipIfOpen(); !ok {
+		v := recurringtask.DefaultSkipIfOpen
+		_c.mutation.SetSkipIfOpen(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := recurringtask.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.LastResult(); !ok {
+		v := recurringtask.DefaultLastResult
+		_c.mutation.SetLastResult(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := recurringtask.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecurringTaskCreate) check() error {
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "RecurringTask.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := recurringtask.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Column(); !ok {
+		return &ValidationError{Name: "column", err: errors.New(`ent: missing required field "RecurringTask.column"`)}
+	}
+	if _, ok := _c.mutation.Assignee(); !ok {
+		return &ValidationError{Name: "assignee", err: errors.New(`ent: missing required field "RecurringTask.assignee"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RecurringTask.priority"`)}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := recurringtask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.priority": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "RecurringTask.rule"`)}
+	}
+	if v, ok := _c.mutation.Rule(); ok {
+		if err := recurringtask.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.rule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SkipIfOpen(); !ok {
+		return &ValidationError{Name: "skip_if_open", err: errors.New(`ent: missing required field "RecurringTask.skip_if_open"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RecurringTask.enabled"`)}
+	}
+	if _, ok := _c.mutation.LastResult(); !ok {
+		return &ValidationError{Name: "last_result", err: errors.New(`ent: missing required field "RecurringTask.last_result"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RecurringTask.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RecurringTaskCreate) sqlSave(ctx context.Context) (*RecurringTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecurringTaskCreate) createSpec() (*RecurringTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecurringTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recurringtask.Table, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(recurringtask.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(recurringtask.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Column(); ok {
+		_spec.SetField(recurringtask.FieldColumn, field.TypeString, value)
+		_node.Column = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(recurringtask.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(recurringtask.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(recurringtask.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Rule(); ok {
+		_spec.SetField(recurringtask.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := _c.mutation.SkipIfOpen(); ok {
+		_spec.SetField(recurringtask.FieldSkipIfOpen, field.TypeBool, value)
+		_node.SkipIfOpen = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(recurringtask.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(recurringtask.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(recurringtask.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastTaskID(); ok {
+		_spec.SetField(recurringtask.FieldLastTaskID, field.TypeInt, value)
+		_node.LastTaskID = &value
+	}
+	if value, ok := _c.mutation.LastResult(); ok {
+		_spec.SetField(recurringtask.FieldLastResult, field.TypeString, value)
+		_node.LastResult = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(recurringtask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// RecurringTaskCreateBulk is the builder for creating many RecurringTask entities in bulk.
+type RecurringTaskCreateBulk struct {
+	config
+	err      error
+	builders []*RecurringTaskCreate
+}
+
+// Save creates the RecurringTask entities in the database.
+func (_c *RecurringTaskCreateBulk) Save(ctx context.Context) ([]*RecurringTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecurringTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecurringTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecurringTaskCreateBulk) SaveX(ctx context.Context) []*RecurringTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecurringTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecurringTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
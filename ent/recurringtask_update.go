@@ -0,0 +1,781 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
+)
+
+// RecurringTaskUpdate is the builder for updating RecurringTask entities.
+type RecurringTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecurringTaskMutation
+}
+
+// Where appends a list predicates to the RecurringTaskUpdate builder.
+func (_u *RecurringTaskUpdate) Where(ps ...predicate.RecurringTask) *RecurringTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *RecurringTaskUpdate) SetTitle(v string) *RecurringTaskUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableTitle(v *string) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RecurringTaskUpdate) SetDescription(v string) *RecurringTaskUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableDescription(v *string) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RecurringTaskUpdate) ClearDescription() *RecurringTaskUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetColumn sets the "column" field.
+func (_u *RecurringTaskUpdate) SetColumn(v string) *RecurringTaskUpdate {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableColumn(v *string) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *RecurringTaskUpdate) SetAssignee(v string) *RecurringTaskUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableAssignee(v *string) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RecurringTaskUpdate) SetPriority(v recurringtask.Priority) *RecurringTaskUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillablePriority(v *recurringtask.Priority) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *RecurringTaskUpdate) SetTags(v []string) *RecurringTaskUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *RecurringTaskUpdate) AppendTags(v []string) *RecurringTaskUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *RecurringTaskUpdate) ClearTags() *RecurringTaskUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *RecurringTaskUpdate) SetRule(v string) *RecurringTaskUpdate {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableRule(v *string) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// SetSkipIfOpen sets the "skip_if_open" field.
+func (_u *RecurringTaskUpdate) SetSkipIfOpen(v bool) *RecurringTaskUpdate {
+	_u.mutation.SetSkipIfOpen(v)
+	return _u
+}
+
+// SetNillableSkipIfOpen sets the "skip_if_open" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableSkipIfOpen(v *bool) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetSkipIfOpen(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RecurringTaskUpdate) SetEnabled(v bool) *RecurringTaskUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableEnabled(v *bool) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *RecurringTaskUpdate) SetNextRunAt(v time.Time) *RecurringTaskUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableNextRunAt(v *time.Time) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *RecurringTaskUpdate) ClearNextRunAt() *RecurringTaskUpdate {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *RecurringTaskUpdate) SetLastRunAt(v time.Time) *RecurringTaskUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableLastRunAt(v *time.Time) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *RecurringTaskUpdate) ClearLastRunAt() *RecurringTaskUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastTaskID sets the "last_task_id" field.
+func (_u *RecurringTaskUpdate) SetLastTaskID(v int) *RecurringTaskUpdate {
+	_u.mutation.ResetLastTaskID()
+	_u.mutation.SetLastTaskID(v)
+	return _u
+}
+
+// SetNillableLastTaskID sets the "last_task_id" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableLastTaskID(v *int) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetLastTaskID(*v)
+	}
+	return _u
+}
+
+// AddLastTaskID adds value to the "last_task_id" field.
+func (_u *RecurringTaskUpdate) AddLastTaskID(v int) *RecurringTaskUpdate {
+	_u.mutation.AddLastTaskID(v)
+	return _u
+}
+
+// ClearLastTaskID clears the value of the "last_task_id" field.
+func (_u *RecurringTaskUpdate) ClearLastTaskID() *RecurringTaskUpdate {
+	_u.mutation.ClearLastTaskID()
+	return _u
+}
+
+// SetLastResult sets the "last_result" field.
+func (_u *RecurringTaskUpdate) SetLastResult(v string) *RecurringTaskUpdate {
+	_u.mutation.SetLastResult(v)
+	return _u
+}
+
+// SetNillableLastResult sets the "last_result" field if the given value is not nil.
+func (_u *RecurringTaskUpdate) SetNillableLastResult(v *string) *RecurringTaskUpdate {
+	if v != nil {
+		_u.SetLastResult(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RecurringTaskMutation object of the builder.
+func (_u *RecurringTaskUpdate) Mutation() *RecurringTaskMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecurringTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurringTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecurringTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurringTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringTaskUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := recurringtask.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := recurringtask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rule(); ok {
+		if err := recurringtask.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.rule": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RecurringTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringtask.Table, recurringtask.Columns, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(recurringtask.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(recurringtask.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(recurringtask.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(recurringtask.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(recurringtask.FieldAssignee, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(recurringtask.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(recurringtask.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringtask.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(recurringtask.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(recurringtask.FieldRule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkipIfOpen(); ok {
+		_spec.SetField(recurringtask.FieldSkipIfOpen, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(recurringtask.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(recurringtask.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(recurringtask.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(recurringtask.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(recurringtask.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTaskID(); ok {
+		_spec.SetField(recurringtask.FieldLastTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastTaskID(); ok {
+		_spec.AddField(recurringtask.FieldLastTaskID, field.TypeInt, value)
+	}
+	if _u.mutation.LastTaskIDCleared() {
+		_spec.ClearField(recurringtask.FieldLastTaskID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastResult(); ok {
+		_spec.SetField(recurringtask.FieldLastResult, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecurringTaskUpdateOne is the builder for updating a single RecurringTask entity.
+type RecurringTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecurringTaskMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *RecurringTaskUpdateOne) SetTitle(v string) *RecurringTaskUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableTitle(v *string) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RecurringTaskUpdateOne) SetDescription(v string) *RecurringTaskUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableDescription(v *string) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RecurringTaskUpdateOne) ClearDescription() *RecurringTaskUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetColumn sets the "column" field.
+func (_u *RecurringTaskUpdateOne) SetColumn(v string) *RecurringTaskUpdateOne {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableColumn(v *string) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *RecurringTaskUpdateOne) SetAssignee(v string) *RecurringTaskUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableAssignee(v *string) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RecurringTaskUpdateOne) SetPriority(v recurringtask.Priority) *RecurringTaskUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillablePriority(v *recurringtask.Priority) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *RecurringTaskUpdateOne) SetTags(v []string) *RecurringTaskUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *RecurringTaskUpdateOne) AppendTags(v []string) *RecurringTaskUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *RecurringTaskUpdateOne) ClearTags() *RecurringTaskUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *RecurringTaskUpdateOne) SetRule(v string) *RecurringTaskUpdateOne {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableRule(v *string) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// SetSkipIfOpen sets the "skip_if_open" field.
+func (_u *RecurringTaskUpdateOne) SetSkipIfOpen(v bool) *RecurringTaskUpdateOne {
+	_u.mutation.SetSkipIfOpen(v)
+	return _u
+}
+
+// SetNillableSkipIfOpen sets the "skip_if_open" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableSkipIfOpen(v *bool) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetSkipIfOpen(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RecurringTaskUpdateOne) SetEnabled(v bool) *RecurringTaskUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableEnabled(v *bool) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *RecurringTaskUpdateOne) SetNextRunAt(v time.Time) *RecurringTaskUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableNextRunAt(v *time.Time) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *RecurringTaskUpdateOne) ClearNextRunAt() *RecurringTaskUpdateOne {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *RecurringTaskUpdateOne) SetLastRunAt(v time.Time) *RecurringTaskUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableLastRunAt(v *time.Time) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *RecurringTaskUpdateOne) ClearLastRunAt() *RecurringTaskUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastTaskID sets the "last_task_id" field.
+func (_u *RecurringTaskUpdateOne) SetLastTaskID(v int) *RecurringTaskUpdateOne {
+	_u.mutation.ResetLastTaskID()
+	_u.mutation.SetLastTaskID(v)
+	return _u
+}
+
+// SetNillableLastTaskID sets the "last_task_id" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableLastTaskID(v *int) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetLastTaskID(*v)
+	}
+	return _u
+}
+
+// AddLastTaskID adds value to the "last_task_id" field.
+func (_u *RecurringTaskUpdateOne) AddLastTaskID(v int) *RecurringTaskUpdateOne {
+	_u.mutation.AddLastTaskID(v)
+	return _u
+}
+
+// ClearLastTaskID clears the value of the "last_task_id" field.
+func (_u *RecurringTaskUpdateOne) ClearLastTaskID() *RecurringTaskUpdateOne {
+	_u.mutation.ClearLastTaskID()
+	return _u
+}
+
+// SetLastResult sets the "last_result" field.
+func (_u *RecurringTaskUpdateOne) SetLastResult(v string) *RecurringTaskUpdateOne {
+	_u.mutation.SetLastResult(v)
+	return _u
+}
+
+// SetNillableLastResult sets the "last_result" field if the given value is not nil.
+func (_u *RecurringTaskUpdateOne) SetNillableLastResult(v *string) *RecurringTaskUpdateOne {
+	if v != nil {
+		_u.SetLastResult(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RecurringTaskMutation object of the builder.
+func (_u *RecurringTaskUpdateOne) Mutation() *RecurringTaskMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RecurringTaskUpdate builder.
+func (_u *RecurringTaskUpdateOne) Where(ps ...predicate.RecurringTask) *RecurringTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecurringTaskUpdateOne) Select(field string, fields ...string) *RecurringTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecurringTask entity.
+func (_u *RecurringTaskUpdateOne) Save(ctx context.Context) (*RecurringTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecurringTaskUpdateOne) SaveX(ctx context.Context) *RecurringTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecurringTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecurringTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecurringTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := recurringtask.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := recurringtask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rule(); ok {
+		if err := recurringtask.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "RecurringTask.rule": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RecurringTaskUpdateOne) sqlSave(ctx context.Context) (_node *RecurringTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recurringtask.Table, recurringtask.Columns, sqlgraph.NewFieldSpec(recurringtask.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecurringTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recurringtask.FieldID)
+		for _, f := range fields {
+			if !recurringtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recurringtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(recurringtask.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(recurringtask.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(recurringtask.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(recurringtask.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(recurringtask.FieldAssignee, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(recurringtask.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(recurringtask.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recurringtask.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(recurringtask.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(recurringtask.FieldRule, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkipIfOpen(); ok {
+		_spec.SetField(recurringtask.FieldSkipIfOpen, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(recurringtask.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(recurringtask.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(recurringtask.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(recurringtask.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(recurringtask.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTaskID(); ok {
+		_spec.SetField(recurringtask.FieldLastTaskID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastTaskID(); ok {
+		_spec.AddField(recurringtask.FieldLastTaskID, field.TypeInt, value)
+	}
+	if _u.mutation.LastTaskIDCleared() {
+		_spec.ClearField(recurringtask.FieldLastTaskID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastResult(); ok {
+		_spec.SetField(recurringtask.FieldLastResult, field.TypeString, value)
+	}
+	_node = &RecurringTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recurringtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,16 +5,163 @@ package ent
 import (
 	"time"
 
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/fielddefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
 	"github.com/j0hnsmith/botTaskTracker/ent/schema"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	automationruleFields := schema.AutomationRule{}.Fields()
+	_ = automationruleFields
+	// automationruleDescName is the schema descriptor for name field.
+	automationruleDescName := automationruleFields[0].Descriptor()
+	// automationrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	automationrule.NameValidator = automationruleDescName.Validators[0].(func(string) error)
+	// automationruleDescTriggerArg is the schema descriptor for trigger_arg field.
+	automationruleDescTriggerArg := automationruleFields[2].Descriptor()
+	// automationrule.DefaultTriggerArg holds the default value on creation for the trigger_arg field.
+	automationrule.DefaultTriggerArg = automationruleDescTriggerArg.Default.(string)
+	// automationruleDescEnabled is the schema descriptor for enabled field.
+	automationruleDescEnabled := automationruleFields[5].Descriptor()
+	// automationrule.DefaultEnabled holds the default value on creation for the enabled field.
+	automationrule.DefaultEnabled = automationruleDescEnabled.Default.(bool)
+	// automationruleDescCreatedAt is the schema descriptor for created_at field.
+	automationruleDescCreatedAt := automationruleFields[6].Descriptor()
+	// automationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	automationrule.DefaultCreatedAt = automationruleDescCreatedAt.Default.(func() time.Time)
+	automationrunFields := schema.AutomationRun{}.Fields()
+	_ = automationrunFields
+	// automationrunDescTrigger is the schema descriptor for trigger field.
+	automationrunDescTrigger := automationrunFields[1].Descriptor()
+	// automationrun.TriggerValidator is a validator for the "trigger" field. It is called by the builders before save.
+	automationrun.TriggerValidator = automationrunDescTrigger.Validators[0].(func(string) error)
+	// automationrunDescMessage is the schema descriptor for message field.
+	automationrunDescMessage := automationrunFields[3].Descriptor()
+	// automationrun.DefaultMessage holds the default value on creation for the message field.
+	automationrun.DefaultMessage = automationrunDescMessage.Default.(string)
+	// automationrunDescCreatedAt is the schema descriptor for created_at field.
+	automationrunDescCreatedAt := automationrunFields[4].Descriptor()
+	// automationrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	automationrun.DefaultCreatedAt = automationrunDescCreatedAt.Default.(func() time.Time)
+	fielddefinitionFields := schema.FieldDefinition{}.Fields()
+	_ = fielddefinitionFields
+	// fielddefinitionDescKey is the schema descriptor for key field.
+	fielddefinitionDescKey := fielddefinitionFields[0].Descriptor()
+	// fielddefinition.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	fielddefinition.KeyValidator = fielddefinitionDescKey.Validators[0].(func(string) error)
+	// fielddefinitionDescLabel is the schema descriptor for label field.
+	fielddefinitionDescLabel := fielddefinitionFields[1].Descriptor()
+	// fielddefinition.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	fielddefinition.LabelValidator = fielddefinitionDescLabel.Validators[0].(func(string) error)
+	// fielddefinitionDescRequired is the schema descriptor for required field.
+	fielddefinitionDescRequired := fielddefinitionFields[3].Descriptor()
+	// fielddefinition.DefaultRequired holds the default value on creation for the required field.
+	fielddefinition.DefaultRequired = fielddefinitionDescRequired.Default.(bool)
+	// fielddefinitionDescDefaultValue is the schema descriptor for default_value field.
+	fielddefinitionDescDefaultValue := fielddefinitionFields[4].Descriptor()
+	// fielddefinition.DefaultDefaultValue holds the default value on creation for the default_value field.
+	fielddefinition.DefaultDefaultValue = fielddefinitionDescDefaultValue.Default.(string)
+	// fielddefinitionDescPosition is the schema descriptor for position field.
+	fielddefinitionDescPosition := fielddefinitionFields[6].Descriptor()
+	// fielddefinition.DefaultPosition holds the default value on creation for the position field.
+	fielddefinition.DefaultPosition = fielddefinitionDescPosition.Default.(int)
+	// fielddefinitionDescCreatedAt is the schema descriptor for created_at field.
+	fielddefinitionDescCreatedAt := fielddefinitionFields[7].Descriptor()
+	// fielddefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	fielddefinition.DefaultCreatedAt = fielddefinitionDescCreatedAt.Default.(func() time.Time)
+	recurringtaskFields := schema.RecurringTask{}.Fields()
+	_ = recurringtaskFields
+	// recurringtaskDescTitle is the schema descriptor for title field.
+	recurringtaskDescTitle := recurringtaskFields[0].Descriptor()
+	// recurringtask.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	recurringtask.TitleValidator = recurringtaskDescTitle.Validators[0].(func(string) error)
+	// recurringtaskDescColumn is the schema descriptor for column field.
+	recurringtaskDescColumn := recurringtaskFields[2].Descriptor()
+	// recurringtask.DefaultColumn holds the default value on creation for the column field.
+	recurringtask.DefaultColumn = recurringtaskDescColumn.Default.(string)
+	// recurringtaskDescAssignee is the schema descriptor for assignee field.
+	recurringtaskDescAssignee := recurringtaskFields[3].Descriptor()
+	// recurringtask.DefaultAssignee holds the default value on creation for the assignee field.
+	recurringtask.DefaultAssignee = recurringtaskDescAssignee.Default.(string)
+	// recurringtaskDescRule is the schema descriptor for rule field.
+	recurringtaskDescRule := recurringtaskFields[6].Descriptor()
+	// recurringtask.RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	recurringtask.RuleValidator = recurringtaskDescRule.Validators[0].(func(string) error)
+	// recurringtaskDescSkipIfOpen is the schema descriptor for skip_if_open field.
+	recurringtaskDescSkipIfOpen := recurringtaskFields[7].Descriptor()
+	// recurringtask.DefaultSkipIfOpen holds the default value on creation for the skip_if_open field.
+	recurringtask.DefaultSkipIfOpen = recurringtaskDescSkipIfOpen.Default.(bool)
+	// recurringtaskDescEnabled is the schema descriptor for enabled field.
+	recurringtaskDescEnabled := recurringtaskFields[8].Descriptor()
+	// recurringtask.DefaultEnabled holds the default value on creation for the enabled field.
+	recurringtask.DefaultEnabled = recurringtaskDescEnabled.Default.(bool)
+	// recurringtaskDescLastResult is the schema descriptor for last_result field.
+	recurringtaskDescLastResult := recurringtaskFields[12].Descriptor()
+	// recurringtask.DefaultLastResult holds the default value on creation for the last_result field.
+	recurringtask.DefaultLastResult = recurringtaskDescLastResult.Default.(string)
+	// recurringtaskDescCreatedAt is the schema descriptor for created_at field.
+	recurringtaskDescCreatedAt := recurringtaskFields[13].Descriptor()
+	// recurringtask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurringtask.DefaultCreatedAt = recurringtaskDescCreatedAt.Default.(func() time.Time)
+	stalethresholdFields := schema.StaleThreshold{}.Fields()
+	_ = stalethresholdFields
+	// stalethresholdDescColumn is the schema descriptor for column field.
+	stalethresholdDescColumn := stalethresholdFields[0].Descriptor()
+	// stalethreshold.ColumnValidator is a validator for the "column" field. It is called by the builders before save.
+	stalethreshold.ColumnValidator = stalethresholdDescColumn.Validators[0].(func(string) error)
+	// stalethresholdDescDays is the schema descriptor for days field.
+	stalethresholdDescDays := stalethresholdFields[1].Descriptor()
+	// stalethreshold.DaysValidator is a validator for the "days" field. It is called by the builders before save.
+	stalethreshold.DaysValidator = stalethresholdDescDays.Validators[0].(func(int) error)
+	// stalethresholdDescCreatedAt is the schema descriptor for created_at field.
+	stalethresholdDescCreatedAt := stalethresholdFields[2].Descriptor()
+	// stalethreshold.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stalethreshold.DefaultCreatedAt = stalethresholdDescCreatedAt.Default.(func() time.Time)
+	tagdefinitionFields := schema.TagDefinition{}.Fields()
+	_ = tagdefinitionFields
+	// tagdefinitionDescKey is the schema descriptor for key field.
+	tagdefinitionDescKey := tagdefinitionFields[0].Descriptor()
+	// tagdefinition.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	tagdefinition.KeyValidator = tagdefinitionDescKey.Validators[0].(func(string) error)
+	// tagdefinitionDescDisplayName is the schema descriptor for display_name field.
+	tagdefinitionDescDisplayName := tagdefinitionFields[1].Descriptor()
+	// tagdefinition.DefaultDisplayName holds the default value on creation for the display_name field.
+	tagdefinition.DefaultDisplayName = tagdefinitionDescDisplayName.Default.(string)
+	// tagdefinitionDescEmoji is the schema descriptor for emoji field.
+	tagdefinitionDescEmoji := tagdefinitionFields[2].Descriptor()
+	// tagdefinition.DefaultEmoji holds the default value on creation for the emoji field.
+	tagdefinition.DefaultEmoji = tagdefinitionDescEmoji.Default.(string)
+	// tagdefinitionDescColor is the schema descriptor for color field.
+	tagdefinitionDescColor := tagdefinitionFields[3].Descriptor()
+	// tagdefinition.DefaultColor holds the default value on creation for the color field.
+	tagdefinition.DefaultColor = tagdefinitionDescColor.Default.(string)
+	// tagdefinitionDescMultiValued is the schema descriptor for multi_valued field.
+	tagdefinitionDescMultiValued := tagdefinitionFields[5].Descriptor()
+	// tagdefinition.DefaultMultiValued holds the default value on creation for the multi_valued field.
+	tagdefinition.DefaultMultiValued = tagdefinitionDescMultiValued.Default.(bool)
+	// tagdefinitionDescPosition is the schema descriptor for position field.
+	tagdefinitionDescPosition := tagdefinitionFields[6].Descriptor()
+	// tagdefinition.DefaultPosition holds the default value on creation for the position field.
+	tagdefinition.DefaultPosition = tagdefinitionDescPosition.Default.(int)
+	// tagdefinitionDescCreatedAt is the schema descriptor for created_at field.
+	tagdefinitionDescCreatedAt := tagdefinitionFields[7].Descriptor()
+	// tagdefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagdefinition.DefaultCreatedAt = tagdefinitionDescCreatedAt.Default.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescTitle is the schema descriptor for title field.
@@ -34,15 +181,29 @@ func init() {
 	// task.DefaultPosition holds the default value on creation for the position field.
 	task.DefaultPosition = taskDescPosition.Default.(int)
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[5].Descriptor()
+	taskDescCreatedAt := taskFields[7].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
 	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[6].Descriptor()
+	taskDescUpdatedAt := taskFields[8].Descriptor()
 	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taskDescColumnEnteredAt is the schema descriptor for column_entered_at field.
+	taskDescColumnEnteredAt := taskFields[11].Descriptor()
+	// task.DefaultColumnEnteredAt holds the default value on creation for the column_entered_at field.
+	task.DefaultColumnEnteredAt = taskDescColumnEnteredAt.Default.(func() time.Time)
+	taskfieldvalueFields := schema.TaskFieldValue{}.Fields()
+	_ = taskfieldvalueFields
+	// taskfieldvalueDescKey is the schema descriptor for key field.
+	taskfieldvalueDescKey := taskfieldvalueFields[0].Descriptor()
+	// taskfieldvalue.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	taskfieldvalue.KeyValidator = taskfieldvalueDescKey.Validators[0].(func(string) error)
+	// taskfieldvalueDescValue is the schema descriptor for value field.
+	taskfieldvalueDescValue := taskfieldvalueFields[1].Descriptor()
+	// taskfieldvalue.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	taskfieldvalue.ValueValidator = taskfieldvalueDescValue.Validators[0].(func(string) error)
 	taskhistoryFields := schema.TaskHistory{}.Fields()
 	_ = taskhistoryFields
 	// taskhistoryDescAction is the schema descriptor for action field.
@@ -54,9 +215,31 @@ func init() {
 	// taskhistory.DefaultActor holds the default value on creation for the actor field.
 	taskhistory.DefaultActor = taskhistoryDescActor.Default.(string)
 	// taskhistoryDescCreatedAt is the schema descriptor for created_at field.
-	taskhistoryDescCreatedAt := taskhistoryFields[3].Descriptor()
+	taskhistoryDescCreatedAt := taskhistoryFields[4].Descriptor()
 	// taskhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	taskhistory.DefaultCreatedAt = taskhistoryDescCreatedAt.Default.(func() time.Time)
+	taskrevisionFields := schema.TaskRevision{}.Fields()
+	_ = taskrevisionFields
+	// taskrevisionDescAssignee is the schema descriptor for assignee field.
+	taskrevisionDescAssignee := taskrevisionFields[4].Descriptor()
+	// taskrevision.DefaultAssignee holds the default value on creation for the assignee field.
+	taskrevision.DefaultAssignee = taskrevisionDescAssignee.Default.(string)
+	// taskrevisionDescPriority is the schema descriptor for priority field.
+	taskrevisionDescPriority := taskrevisionFields[5].Descriptor()
+	// taskrevision.DefaultPriority holds the default value on creation for the priority field.
+	taskrevision.DefaultPriority = taskrevisionDescPriority.Default.(string)
+	// taskrevisionDescDue is the schema descriptor for due field.
+	taskrevisionDescDue := taskrevisionFields[6].Descriptor()
+	// taskrevision.DefaultDue holds the default value on creation for the due field.
+	taskrevision.DefaultDue = taskrevisionDescDue.Default.(string)
+	// taskrevisionDescActor is the schema descriptor for actor field.
+	taskrevisionDescActor := taskrevisionFields[9].Descriptor()
+	// taskrevision.DefaultActor holds the default value on creation for the actor field.
+	taskrevision.DefaultActor = taskrevisionDescActor.Default.(string)
+	// taskrevisionDescCreatedAt is the schema descriptor for created_at field.
+	taskrevisionDescCreatedAt := taskrevisionFields[10].Descriptor()
+	// taskrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskrevision.DefaultCreatedAt = taskrevisionDescCreatedAt.Default.(func() time.Time)
 	tasktagFields := schema.TaskTag{}.Fields()
 	_ = tasktagFields
 	// tasktagDescKey is the schema descriptor for key field.
@@ -71,4 +254,58 @@ func init() {
 	tasktagDescCreatedAt := tasktagFields[2].Descriptor()
 	// tasktag.DefaultCreatedAt holds the default value on creation for the created_at field.
 	tasktag.DefaultCreatedAt = tasktagDescCreatedAt.Default.(func() time.Time)
+	tasktemplateFields := schema.TaskTemplate{}.Fields()
+	_ = tasktemplateFields
+	// tasktemplateDescName is the schema descriptor for name field.
+	tasktemplateDescName := tasktemplateFields[0].Descriptor()
+	// tasktemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tasktemplate.NameValidator = tasktemplateDescName.Validators[0].(func(string) error)
+	// tasktemplateDescTitle is the schema descriptor for title field.
+	tasktemplateDescTitle := tasktemplateFields[1].Descriptor()
+	// tasktemplate.DefaultTitle holds the default value on creation for the title field.
+	tasktemplate.DefaultTitle = tasktemplateDescTitle.Default.(string)
+	// tasktemplateDescColumn is the schema descriptor for column field.
+	tasktemplateDescColumn := tasktemplateFields[4].Descriptor()
+	// tasktemplate.DefaultColumn holds the default value on creation for the column field.
+	tasktemplate.DefaultColumn = tasktemplateDescColumn.Default.(string)
+	// tasktemplateDescAssignee is the schema descriptor for assignee field.
+	tasktemplateDescAssignee := tasktemplateFields[5].Descriptor()
+	// tasktemplate.DefaultAssignee holds the default value on creation for the assignee field.
+	tasktemplate.DefaultAssignee = tasktemplateDescAssignee.Default.(string)
+	// tasktemplateDescCreatedAt is the schema descriptor for created_at field.
+	tasktemplateDescCreatedAt := tasktemplateFields[6].Descriptor()
+	// tasktemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tasktemplate.DefaultCreatedAt = tasktemplateDescCreatedAt.Default.(func() time.Time)
+	transitionFields := schema.Transition{}.Fields()
+	_ = transitionFields
+	// transitionDescFromColumn is the schema descriptor for from_column field.
+	transitionDescFromColumn := transitionFields[0].Descriptor()
+	// transition.FromColumnValidator is a validator for the "from_column" field. It is called by the builders before save.
+	transition.FromColumnValidator = transitionDescFromColumn.Validators[0].(func(string) error)
+	// transitionDescToColumn is the schema descriptor for to_column field.
+	transitionDescToColumn := transitionFields[1].Descriptor()
+	// transition.ToColumnValidator is a validator for the "to_column" field. It is called by the builders before save.
+	transition.ToColumnValidator = transitionDescToColumn.Validators[0].(func(string) error)
+	// transitionDescCreatedAt is the schema descriptor for created_at field.
+	transitionDescCreatedAt := transitionFields[3].Descriptor()
+	// transition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transition.DefaultCreatedAt = transitionDescCreatedAt.Default.(func() time.Time)
+	wiplimitFields := schema.WipLimit{}.Fields()
+	_ = wiplimitFields
+	// wiplimitDescColumn is the schema descriptor for column field.
+	wiplimitDescColumn := wiplimitFields[0].Descriptor()
+	// wiplimit.ColumnValidator is a validator for the "column" field. It is called by the builders before save.
+	wiplimit.ColumnValidator = wiplimitDescColumn.Validators[0].(func(string) error)
+	// wiplimitDescAssignee is the schema descriptor for assignee field.
+	wiplimitDescAssignee := wiplimitFields[1].Descriptor()
+	// wiplimit.DefaultAssignee holds the default value on creation for the assignee field.
+	wiplimit.DefaultAssignee = wiplimitDescAssignee.Default.(string)
+	// wiplimitDescMaxTasks is the schema descriptor for max_tasks field.
+	wiplimitDescMaxTasks := wiplimitFields[2].Descriptor()
+	// wiplimit.MaxTasksValidator is a validator for the "max_tasks" field. It is called by the builders before save.
+	wiplimit.MaxTasksValidator = wiplimitDescMaxTasks.Validators[0].(func(int) error)
+	// wiplimitDescCreatedAt is the schema descriptor for created_at field.
+	wiplimitDescCreatedAt := wiplimitFields[4].Descriptor()
+	// wiplimit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wiplimit.DefaultCreatedAt = wiplimitDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AutomationRule holds the schema definition for the AutomationRule entity: a
+// trigger, conditions and actions run by the server when the trigger fires.
+type AutomationRule struct {
+	ent.Schema
+}
+
+// Fields of the AutomationRule.
+func (AutomationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.Enum("trigger").
+			Values("created", "moved", "tagged", "due_passed", "stuck"),
+		field.String("trigger_arg").
+			Default(""), // column for "moved" and "stuck", tag pattern for "tagged"
+		field.Strings("conditions").
+			Optional(), // e.g. "priority = high", "tag has bug:critical"
+		field.Strings("actions"), // e.g. "assign peter", "move review top", "webhook https://..."
+		field.Bool("enabled").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AutomationRule.
+func (AutomationRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("runs", AutomationRun.Type),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AutomationRun holds the schema definition for the AutomationRun entity: one
+// entry in a rule's execution log.
+type AutomationRun struct {
+	ent.Schema
+}
+
+// Fields of the AutomationRun.
+func (AutomationRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("task_id"), // kept as a plain id so the log survives purging the task
+		field.String("trigger").
+			NotEmpty(),
+		field.Enum("status").
+			Values("success", "failed", "loop_blocked"),
+		field.String("message").
+			Default(""), // actions taken, or why the run failed or was blocked
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AutomationRun.
+func (AutomationRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("rule", AutomationRule.Type).
+			Ref("runs").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the AutomationRun.
+func (AutomationRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "trigger"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// FieldDefinition holds the schema definition for the FieldDefinition entity:
+// a typed custom field defined on the board that every task can carry a value for.
+type FieldDefinition struct {
+	ent.Schema
+}
+
+// Fields of the FieldDefinition.
+func (FieldDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable(), // e.g., "estimate", "pr_url"
+		field.String("label").
+			NotEmpty(),
+		field.Enum("type").
+			Values("text", "number", "enum", "date", "url", "boolean"),
+		field.Bool("required").
+			Default(false),
+		field.String("default_value").
+			Default(""),
+		field.Strings("options").
+			Optional(), // allowed values for enum fields
+		field.Int("position").
+			Default(0), // for ordering in forms
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RecurringTask holds the schema definition for the RecurringTask entity: a
+// task template that the scheduler creates on a cron or RRULE schedule.
+type RecurringTask struct {
+	ent.Schema
+}
+
+// Fields of the RecurringTask.
+func (RecurringTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			NotEmpty(),
+		field.Text("description").
+			Optional(),
+		field.String("column").
+			Default("backlog"),
+		field.String("assignee").
+			Default(""),
+		field.Enum("priority").
+			Values("none", "low", "medium", "high", "urgent").
+			Default("none"),
+		field.Strings("tags").
+			Optional(), // "key:value" pairs
+		field.String("rule").
+			NotEmpty(), // cron ("0 9 * * MON") or RRULE ("RRULE:FREQ=WEEKLY;BYDAY=MO")
+		field.Bool("skip_if_open").
+			Default(true), // skip a run while the previous instance isn't done
+		field.Bool("enabled").
+			Default(true),
+		field.Time("next_run_at").
+			Optional().
+			Nillable(), // nil once the schedule has no more occurrences
+		field.Time("last_run_at").
+			Optional().
+			Nillable(),
+		field.Int("last_task_id").
+			Optional().
+			Nillable(), // the most recent instance
+		field.String("last_result").
+			Default(""), // e.g. "created #12", "skipped: #11 is still open"
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
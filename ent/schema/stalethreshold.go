@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// StaleThreshold holds the schema definition for the StaleThreshold entity:
+// how many days a task may sit in a column before it is flagged as stuck.
+type StaleThreshold struct {
+	ent.Schema
+}
+
+// Fields of the StaleThreshold.
+func (StaleThreshold) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("column").
+			NotEmpty().
+			Unique(), // "backlog", "in_progress", "review", "done"
+		field.Int("days").
+			Positive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TagDefinition holds the schema definition for the TagDefinition entity: a
+// registered tag key with its allowed values and how it is displayed.
+type TagDefinition struct {
+	ent.Schema
+}
+
+// Fields of the TagDefinition.
+func (TagDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable(), // e.g., "type", "project"
+		field.String("display_name").
+			Default(""),
+		field.String("emoji").
+			Default(""),
+		field.String("color").
+			Default("neutral"), // daisyUI badge variant
+		field.Strings("allowed_values").
+			Optional(), // empty means free text
+		field.Bool("multi_valued").
+			Default(true),
+		field.Int("position").
+			Default(0), // earlier keys win the card's category badge
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
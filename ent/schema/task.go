@@ -26,12 +26,28 @@ func (Task) Fields() []ent.Field {
 			Default(""), // empty, "peter", "john"
 		field.Int("position").
 			Default(0), // for ordering within column
+		field.Enum("priority").
+			Values("none", "low", "medium", "high", "urgent").
+			Default("none"),
+		field.Time("due_at").
+			Optional().
+			Nillable(), // midnight (local time) of the due date
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(), // set when the task is in the trash
+		field.Time("archived_at").
+			Optional().
+			Nillable(), // set when the task is archived off the board
+		field.Time("column_entered_at").
+			Optional().
+			Nillable().
+			Default(time.Now), // when the task last changed column, for stale detection
 	}
 }
 
@@ -40,5 +56,7 @@ func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("tags", TaskTag.Type),
 		edge.To("history", TaskHistory.Type),
+		edge.To("revisions", TaskRevision.Type),
+		edge.To("field_values", TaskFieldValue.Type),
 	}
 }
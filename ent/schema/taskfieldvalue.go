@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskFieldValue holds the schema definition for the TaskFieldValue entity: a
+// task's normalized value for a FieldDefinition, matched by key.
+type TaskFieldValue struct {
+	ent.Schema
+}
+
+// Fields of the TaskFieldValue.
+func (TaskFieldValue) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty(),
+		field.String("value").
+			NotEmpty(), // numbers, dates and booleans in canonical form
+	}
+}
+
+// Edges of the TaskFieldValue.
+func (TaskFieldValue) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("field_values").
+			Unique().
+			Required(),
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"github.com/j0hnsmith/botTaskTracker/history"
 )
 
 // TaskHistory holds the schema definition for the TaskHistory entity.
@@ -22,6 +24,8 @@ func (TaskHistory) Fields() []ent.Field {
 			Optional(), // e.g., "moved from backlog to in_progress", "assigned to john"
 		field.String("actor").
 			Default(""), // who made the change: "peter", "john"
+		field.JSON("changes", []history.Change{}).
+			Optional(), // field-level before/after values for "updated" entries
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -33,7 +37,6 @@ func (TaskHistory) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("task", Task.Type).
 			Ref("history").
-			Unique().
-			Required(),
+			Unique(), // cleared when a task is purged from the trash, the entry itself is kept
 	}
 }
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskRevision holds the schema definition for the TaskRevision entity.
+// Every save of a task writes an immutable snapshot of its fields and tags.
+type TaskRevision struct {
+	ent.Schema
+}
+
+// Fields of the TaskRevision.
+func (TaskRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("revision").
+			Immutable(), // 1, 2, 3... per task
+		field.String("title").
+			Immutable(),
+		field.Text("description").
+			Optional().
+			Immutable(),
+		field.String("column").
+			Immutable(),
+		field.String("assignee").
+			Default("").
+			Immutable(),
+		field.String("priority").
+			Default("none").
+			Immutable(),
+		field.String("due").
+			Default("").
+			Immutable(), // "2006-01-02" or empty
+		field.Strings("tags").
+			Optional().
+			Immutable(), // "key:value" pairs
+		field.JSON("fields", map[string]string{}).
+			Optional().
+			Immutable(), // custom field values by key
+		field.String("actor").
+			Default("").
+			Immutable(), // who saved this revision
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TaskRevision.
+func (TaskRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("revisions").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the TaskRevision.
+func (TaskRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("revision").
+			Edges("task").
+			Unique(),
+	}
+}
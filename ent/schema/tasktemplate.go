@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskTemplate holds the schema definition for the TaskTemplate entity: a
+// named starting point for new tasks.
+type TaskTemplate struct {
+	ent.Schema
+}
+
+// Fields of the TaskTemplate.
+func (TaskTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique(),
+		field.String("title").
+			Default(""), // may contain {date}, {week}, {month} or {year}
+		field.Text("description").
+			Optional(), // markdown skeleton
+		field.Strings("tags").
+			Optional(), // "key:value" pairs
+		field.String("column").
+			Default("backlog"),
+		field.String("assignee").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Transition holds the schema definition for the Transition entity: an allowed
+// move between two columns of the board's workflow.
+type Transition struct {
+	ent.Schema
+}
+
+// Fields of the Transition.
+func (Transition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_column").
+			NotEmpty(), // a column, or "*" for any
+		field.String("to_column").
+			NotEmpty(),
+		field.Strings("guards").
+			Optional(), // e.g. "tag:pr", "description", "assignee", "humans"
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Transition.
+func (Transition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("from_column", "to_column").
+			Unique(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WipLimit holds the schema definition for the WipLimit entity: a cap on the
+// number of tasks in a column, optionally per assignee.
+type WipLimit struct {
+	ent.Schema
+}
+
+// Fields of the WipLimit.
+func (WipLimit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("column").
+			NotEmpty(), // "backlog", "in_progress", "review", "done"
+		field.String("assignee").
+			Default(""), // empty for the whole column, "*" for each assignee
+		field.Int("max_tasks").
+			Positive(),
+		field.Enum("mode").
+			Values("warn", "soft", "hard").
+			Default("warn"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WipLimit.
+func (WipLimit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("column", "assignee").
+			Unique(),
+	}
+}
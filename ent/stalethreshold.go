@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+)
+
+// StaleThreshold is the model entity for the StaleThreshold schema.
+type StaleThreshold struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Column holds the value of the "column" field.
+	Column string `json:"column,omitempty"`
+	// Days holds the value of the "days" field.
+	Days int `json:"days,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StaleThreshold) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stalethreshold.FieldID, stalethreshold.FieldDays:
+			values[i] = new(sql.NullInt64)
+		case stalethreshold.FieldColumn:
+			values[i] = new(sql.NullString)
+		case stalethreshold.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StaleThreshold fields.
+func (_m *StaleThreshold) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stalethreshold.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case stalethreshold.FieldColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column", values[i])
+			} else if value.Valid {
+				_m.Column = value.String
+			}
+		case stalethreshold.FieldDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value.Valid {
+				_m.Days = int(value.Int64)
+			}
+		case stalethreshold.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StaleThreshold.
+// This includes values selected through modifiers, order, etc.
+func (_m *StaleThreshold) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StaleThreshold.
+// Note that you need to call StaleThreshold.Unwrap() before calling this method if this StaleThreshold
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StaleThreshold) Update() *StaleThresholdUpdateOne {
+	return NewStaleThresholdClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StaleThreshold entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StaleThreshold) Unwrap() *StaleThreshold {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StaleThreshold is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StaleThreshold) String() string {
+	var builder strings.Builder
+	builder.WriteString("StaleThreshold(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("column=")
+	builder.WriteString(_m.Column)
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Days))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StaleThresholds is a parsable slice of StaleThreshold.
+type StaleThresholds []*StaleThreshold
@@ -0,0 +1,74 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stalethreshold
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the stalethreshold type in the database.
+	Label = "stale_threshold"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColumn holds the string denoting the column field in the database.
+	FieldColumn = "column"
+	// FieldDays holds the string denoting the days field in the database.
+	FieldDays = "days"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the stalethreshold in the database.
+	Table = "stale_thresholds"
+)
+
+// Columns holds all SQL columns for stalethreshold fields.
+var Columns = []string{
+	FieldID,
+	FieldColumn,
+	FieldDays,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ColumnValidator is a validator for the "column" field. It is called by the builders before save.
+	ColumnValidator func(string) error
+	// DaysValidator is a validator for the "days" field. It is called by the builders before save.
+	DaysValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the StaleThreshold queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByColumn orders the results by the column field.
+func ByColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn, opts...).ToFunc()
+}
+
+// ByDays orders the results by the days field.
+func ByDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDays, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
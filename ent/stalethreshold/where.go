@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stalethreshold
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLTE(FieldID, id))
+}
+
+// Column applies equality check predicate on the "column" field. It's identical to ColumnEQ.
+func Column(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldColumn, v))
+}
+
+// Days applies equality check predicate on the "days" field. It's identical to DaysEQ.
+func Days(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldDays, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ColumnEQ applies the EQ predicate on the "column" field.
+func ColumnEQ(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldColumn, v))
+}
+
+// ColumnNEQ applies the NEQ predicate on the "column" field.
+func ColumnNEQ(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNEQ(FieldColumn, v))
+}
+
+// ColumnIn applies the In predicate on the "column" field.
+func ColumnIn(vs ...string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldIn(FieldColumn, vs...))
+}
+
+// ColumnNotIn applies the NotIn predicate on the "column" field.
+func ColumnNotIn(vs ...string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNotIn(FieldColumn, vs...))
+}
+
+// ColumnGT applies the GT predicate on the "column" field.
+func ColumnGT(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGT(FieldColumn, v))
+}
+
+// ColumnGTE applies the GTE predicate on the "column" field.
+func ColumnGTE(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGTE(FieldColumn, v))
+}
+
+// ColumnLT applies the LT predicate on the "column" field.
+func ColumnLT(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLT(FieldColumn, v))
+}
+
+// ColumnLTE applies the LTE predicate on the "column" field.
+func ColumnLTE(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLTE(FieldColumn, v))
+}
+
+// ColumnContains applies the Contains predicate on the "column" field.
+func ColumnContains(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldContains(FieldColumn, v))
+}
+
+// ColumnHasPrefix applies the HasPrefix predicate on the "column" field.
+func ColumnHasPrefix(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldHasPrefix(FieldColumn, v))
+}
+
+// ColumnHasSuffix applies the HasSuffix predicate on the "column" field.
+func ColumnHasSuffix(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldHasSuffix(FieldColumn, v))
+}
+
+// ColumnEqualFold applies the EqualFold predicate on the "column" field.
+func ColumnEqualFold(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEqualFold(FieldColumn, v))
+}
+
+// ColumnContainsFold applies the ContainsFold predicate on the "column" field.
+func ColumnContainsFold(v string) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldContainsFold(FieldColumn, v))
+}
+
+// DaysEQ applies the EQ predicate on the "days" field.
+func DaysEQ(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldDays, v))
+}
+
+// DaysNEQ applies the NEQ predicate on the "days" field.
+func DaysNEQ(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNEQ(FieldDays, v))
+}
+
+// DaysIn applies the In predicate on the "days" field.
+func DaysIn(vs ...int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldIn(FieldDays, vs...))
+}
+
+// DaysNotIn applies the NotIn predicate on the "days" field.
+func DaysNotIn(vs ...int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNotIn(FieldDays, vs...))
+}
+
+// DaysGT applies the GT predicate on the "days" field.
+func DaysGT(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGT(FieldDays, v))
+}
+
+// DaysGTE applies the GTE predicate on the "days" field.
+func DaysGTE(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGTE(FieldDays, v))
+}
+
+// DaysLT applies the LT predicate on the "days" field.
+func DaysLT(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLT(FieldDays, v))
+}
+
+// DaysLTE applies the LTE predicate on the "days" field.
+func DaysLTE(v int) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLTE(FieldDays, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StaleThreshold) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StaleThreshold) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StaleThreshold) predicate.StaleThreshold {
+	return predicate.StaleThreshold(sql.NotPredicates(p))
+}
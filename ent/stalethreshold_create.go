@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+)
+
+// StaleThresholdCreate is the builder for creating a StaleThreshold entity.
+type StaleThresholdCreate struct {
+	config
+	mutation *StaleThresholdMutation
+	hooks    []Hook
+}
+
+// SetColumn sets the "column" field.
+func (_c *StaleThresholdCreate) SetColumn(v string) *StaleThresholdCreate {
+	_c.mutation.SetColumn(v)
+	return _c
+}
+
+// SetDays sets the "days" field.
+func (_c *StaleThresholdCreate) SetDays(v int) *StaleThresholdCreate {
+	_c.mutation.SetDays(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StaleThresholdCreate) SetCreatedAt(v time.Time) *StaleThresholdCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StaleThresholdCreate) SetNillableCreatedAt(v *time.Time) *StaleThresholdCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the StaleThresholdMutation object of the builder.
+func (_c *StaleThresholdCreate) Mutation() *StaleThresholdMutation {
+	return _c.mutation
+}
+
+// Save creates the StaleThreshold in the database.
+func (_c *StaleThresholdCreate) Save(ctx context.Context) (*StaleThreshold, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StaleThresholdCreate) SaveX(ctx context.Context) *StaleThreshold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StaleThresholdCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StaleThresholdCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StaleThresholdCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := stalethreshold.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StaleThresholdCreate) check() error {
+	if _, ok := _c.mutation.Column(); !ok {
+		return &ValidationError{Name: "column", err: errors.New(`ent: missing required field "StaleThreshold.column"`)}
+	}
+	if v, ok := _c.mutation.Column(); ok {
+		if err := stalethreshold.ColumnValidator(v); err != nil {
+			return &ValidationError{Name: "column", err: fmt.Errorf(`ent: validator failed for field "StaleThreshold.column": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "StaleThreshold.days"`)}
+	}
+	if v, ok := _c.mutation.Days(); ok {
+		if err := stalethreshold.DaysValidator(v); err != nil {
+			return &ValidationError{Name: "days", err: fmt.Errorf(`ent: validator failed for field "StaleThreshold.days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StaleThreshold.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *StaleThresholdCreate) sqlSave(ctx context.Context) (*StaleThreshold, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StaleThresholdCreate) createSpec() (*StaleThreshold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StaleThreshold{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stalethreshold.Table, sqlgraph.NewFieldSpec(stalethreshold.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Column(); ok {
+		_spec.SetField(stalethreshold.FieldColumn, field.TypeString, value)
+		_node.Column = value
+	}
+	if value, ok := _c.mutation.Days(); ok {
+		_spec.SetField(stalethreshold.FieldDays, field.TypeInt, value)
+		_node.Days = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(stalethreshold.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// StaleThresholdCreateBulk is the builder for creating many StaleThreshold entities in bulk.
+type StaleThresholdCreateBulk struct {
+	config
+	err      error
+	builders []*StaleThresholdCreate
+}
+
+// Save creates the StaleThreshold entities in the database.
+func (_c *StaleThresholdCreateBulk) Save(ctx context.Context) ([]*StaleThreshold, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StaleThreshold, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StaleThresholdMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StaleThresholdCreateBulk) SaveX(ctx context.Context) []*StaleThreshold {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StaleThresholdCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StaleThresholdCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
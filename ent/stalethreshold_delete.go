@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+)
+
+// StaleThresholdDelete is the builder for deleting a StaleThreshold entity.
+type StaleThresholdDelete struct {
+	config
+	hooks    []Hook
+	mutation *StaleThresholdMutation
+}
+
+// Where appends a list predicates to the StaleThresholdDelete builder.
+func (_d *StaleThresholdDelete) Where(ps ...predicate.StaleThreshold) *StaleThresholdDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *StaleThresholdDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *StaleThresholdDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *StaleThresholdDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(stalethreshold.Table, sqlgraph.NewFieldSpec(stalethreshold.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// StaleThresholdDeleteOne is the builder for deleting a single StaleThreshold entity.
+type StaleThresholdDeleteOne struct {
+	_d *StaleThresholdDelete
+}
+
+// Where appends a list predicates to the StaleThresholdDelete builder.
+func (_d *StaleThresholdDeleteOne) Where(ps ...predicate.StaleThreshold) *StaleThresholdDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *StaleThresholdDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{stalethreshold.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *StaleThresholdDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
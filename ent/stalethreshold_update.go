@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+)
+
+// StaleThresholdUpdate is the builder for updating StaleThreshold entities.
+type StaleThresholdUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StaleThresholdMutation
+}
+
+// Where appends a list predicates to the StaleThresholdUpdate builder.
+func (_u *StaleThresholdUpdate) Where(ps ...predicate.StaleThreshold) *StaleThresholdUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetColumn sets the "column" field.
+func (_u *StaleThresholdUpdate) SetColumn(v string) *StaleThresholdUpdate {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *StaleThresholdUpdate) SetNillableColumn(v *string) *StaleThresholdUpdate {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *StaleThresholdUpdate) SetDays(v int) *StaleThresholdUpdate {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *StaleThresholdUpdate) SetNillableDays(v *int) *StaleThresholdUpdate {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *StaleThresholdUpdate) AddDays(v int) *StaleThresholdUpdate {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// Mutation returns the StaleThresholdMutation object of the builder.
+func (_u *StaleThresholdUpdate) Mutation() *StaleThresholdMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StaleThresholdUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StaleThresholdUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StaleThresholdUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StaleThresholdUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StaleThresholdUpdate) check() error {
+	if v, ok := _u.mutation.Column(); ok {
+		if err := stalethreshold.ColumnValidator(v); err != nil {
+			return &ValidationError{Name: "column", err: fmt.Errorf(`ent: validator failed for field "StaleThreshold.column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Days(); ok {
+		if err := stalethreshold.DaysValidator(v); err != nil {
+			return &ValidationError{Name: "days", err: fmt.Errorf(`ent: validator failed for field "StaleThreshold.days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *StaleThresholdUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stalethreshold.Table, stalethreshold.Columns, sqlgraph.NewFieldSpec(stalethreshold.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(stalethreshold.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(stalethreshold.FieldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(stalethreshold.FieldDays, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stalethreshold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StaleThresholdUpdateOne is the builder for updating a single StaleThreshold entity.
+type StaleThresholdUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StaleThresholdMutation
+}
+
+// SetColumn sets the "column" field.
+func (_u *StaleThresholdUpdateOne) SetColumn(v string) *StaleThresholdUpdateOne {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *StaleThresholdUpdateOne) SetNillableColumn(v *string) *StaleThresholdUpdateOne {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *StaleThresholdUpdateOne) SetDays(v int) *StaleThresholdUpdateOne {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *StaleThresholdUpdateOne) SetNillableDays(v *int) *StaleThresholdUpdateOne {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *StaleThresholdUpdateOne) AddDays(v int) *StaleThresholdUpdateOne {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// Mutation returns the StaleThresholdMutation object of the builder.
+func (_u *StaleThresholdUpdateOne) Mutation() *StaleThresholdMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StaleThresholdUpdate builder.
+func (_u *StaleThresholdUpdateOne) Where(ps ...predicate.StaleThreshold) *StaleThresholdUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StaleThresholdUpdateOne) Select(field string, fields ...string) *StaleThresholdUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StaleThreshold entity.
+func (_u *StaleThresholdUpdateOne) Save(ctx context.Context) (*StaleThreshold, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StaleThresholdUpdateOne) SaveX(ctx context.Context) *StaleThreshold {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StaleThresholdUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StaleThresholdUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StaleThresholdUpdateOne) check() error {
+	if v, ok := _u.mutation.Column(); ok {
+		if err := stalethreshold.ColumnValidator(v); err != nil {
+			return &ValidationError{Name: "column", err: fmt.Errorf(`ent: validator failed for field "StaleThreshold.column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Days(); ok {
+		if err := stalethreshold.DaysValidator(v); err != nil {
+			return &ValidationError{Name: "days", err: fmt.Errorf(`ent: validator failed for field "StaleThreshold.days": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *StaleThresholdUpdateOne) sqlSave(ctx context.Context) (_node *StaleThreshold, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stalethreshold.Table, stalethreshold.Columns, sqlgraph.NewFieldSpec(stalethreshold.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StaleThreshold.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stalethreshold.FieldID)
+		for _, f := range fields {
+			if !stalethreshold.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stalethreshold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(stalethreshold.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(stalethreshold.FieldDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(stalethreshold.FieldDays, field.TypeInt, value)
+	}
+	_node = &StaleThreshold{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stalethreshold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
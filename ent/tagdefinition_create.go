@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+)
+
+// TagDefinitionCreate is the builder for creating a TagDefinition entity.
+type TagDefinitionCreate struct {
+	config
+	mutation *TagDefinitionMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *TagDefinitionCreate) SetKey(v string) *TagDefinitionCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *TagDefinitionCreate) SetDisplayName(v string) *TagDefinitionCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_c *TagDefinitionCreate) SetNillableDisplayName(v *string) *TagDefinitionCreate {
+	if v != nil {
+		_c.SetDisplayName(*v)
+	}
+	return _c
+}
+
+// SetEmoji sets the "emoji" field.
+func (_c *TagDefinitionCreate) SetEmoji(v string) *TagDefinitionCreate {
+	_c.mutation.SetEmoji(v)
+	return _c
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (_c *TagDefinitionCreate) SetNillableEmoji(v *string) *TagDefinitionCreate {
+	if v != nil {
+		_c.SetEmoji(*v)
+	}
+	return _c
+}
+
+// SetColor sets the "color" field.
+func (_c *TagDefinitionCreate) SetColor(v string) *TagDefinitionCreate {
+	_c.mutation.SetColor(v)
+	return _c
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_c *TagDefinitionCreate) SetNillableColor(v *string) *TagDefinitionCreate {
+	if v != nil {
+		_c.SetColor(*v)
+	}
+	return _c
+}
+
+// SetAllowedValues sets the "allowed_values" field.
+func (_c *TagDefinitionCreate) SetAllowedValues(v []string) *TagDefinitionCreate {
+	_c.mutation.SetAllowedValues(v)
+	return _c
+}
+
+// SetMultiValued sets the "multi_valued" field.
+func (_c *TagDefinitionCreate) SetMultiValued(v bool) *TagDefinitionCreate {
+	_c.mutation.SetMultiValued(v)
+	return _c
+}
+
+// SetNillableMultiValued sets the "multi_valued" field if the given value is not nil.
+func (_c *TagDefinitionCreate) SetNillableMultiValued(v *bool) *TagDefinitionCreate {
+	if v != nil {
+		_c.SetMultiValued(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *TagDefinitionCreate) SetPosition(v int) *TagDefinitionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *TagDefinitionCreate) SetNillablePosition(v *int) *TagDefinitionCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TagDefinitionCreate) SetCreatedAt(v time.Time) *TagDefinitionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TagDefinitionCreate) SetNillableCreatedAt(v *time.Time) *TagDefinitionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TagDefinitionMutation object of the builder.
+func (_c *TagDefinitionCreate) Mutation() *TagDefinitionMutation {
+	return _c.mutation
+}
+
+// Save creates the TagDefinition in the database.
+func (_c *TagDefinitionCreate) Save(ctx context.Context) (*TagDefinition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TagDefinitionCreate) SaveX(ctx context.Context) *TagDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagDefinitionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TagDefinitionCreate) defaults() {
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		v := tagdefinition.DefaultDisplayName
+		_c.mutation.SetDisplayName(v)
+	}
+	if _, ok := _c.mutation.Emoji(); !ok {
+		v := tagdefinition.DefaultEmoji
+		_c.mutation.SetEmoji(v)
+	}
+	if _, ok := _c.mutation.Color(); !ok {
+		v := tagdefinition.DefaultColor
+		_c.mutation.SetColor(v)
+	}
+	if _, ok := _c.mutation.MultiValued(); !ok {
+		v := tagdefinition.DefaultMultiValued
+		_c.mutation.SetMultiValued(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := tagdefinition.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tagdefinition.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TagDefinitionCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "TagDefinition.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := tagdefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "TagDefinition.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "TagDefinition.display_name"`)}
+	}
+	if _, ok := _c.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "TagDefinition.emoji"`)}
+	}
+	if _, ok := _c.mutation.Color(); !ok {
+		return &ValidationError{Name: "color", err: errors.New(`ent: missing required field "TagDefinition.color"`)}
+	}
+	if _, ok := _c.mutation.MultiValued(); !ok {
+		return &ValidationError{Name: "multi_valued", err: errors.New(`ent: missing required field "TagDefinition.multi_valued"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "TagDefinition.position"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagDefinition.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TagDefinitionCreate) sqlSave(ctx context.Context) (*TagDefinition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TagDefinitionCreate) createSpec() (*TagDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagDefinition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tagdefinition.Table, sqlgraph.NewFieldSpec(tagdefinition.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(tagdefinition.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(tagdefinition.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.Emoji(); ok {
+		_spec.SetField(tagdefinition.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if value, ok := _c.mutation.Color(); ok {
+		_spec.SetField(tagdefinition.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := _c.mutation.AllowedValues(); ok {
+		_spec.SetField(tagdefinition.FieldAllowedValues, field.TypeJSON, value)
+		_node.AllowedValues = value
+	}
+	if value, ok := _c.mutation.MultiValued(); ok {
+		_spec.SetField(tagdefinition.FieldMultiValued, field.TypeBool, value)
+		_node.MultiValued = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(tagdefinition.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tagdefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TagDefinitionCreateBulk is the builder for creating many TagDefinition entities in bulk.
+type TagDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*TagDefinitionCreate
+}
+
+// Save creates the TagDefinition entities in the database.
+func (_c *TagDefinitionCreateBulk) Save(ctx context.Context) ([]*TagDefinition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TagDefinition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TagDefinitionCreateBulk) SaveX(ctx context.Context) []*TagDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+)
+
+// TagDefinitionUpdate is the builder for updating TagDefinition entities.
+type TagDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagDefinitionMutation
+}
+
+// Where appends a list predicates to the TagDefinitionUpdate builder.
+func (_u *TagDefinitionUpdate) Where(ps ...predicate.TagDefinition) *TagDefinitionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *TagDefinitionUpdate) SetDisplayName(v string) *TagDefinitionUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *TagDefinitionUpdate) SetNillableDisplayName(v *string) *TagDefinitionUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetEmoji sets the "emoji" field.
+func (_u *TagDefinitionUpdate) SetEmoji(v string) *TagDefinitionUpdate {
+	_u.mutation.SetEmoji(v)
+	return _u
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (_u *TagDefinitionUpdate) SetNillableEmoji(v *string) *TagDefinitionUpdate {
+	if v != nil {
+		_u.SetEmoji(*v)
+	}
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *TagDefinitionUpdate) SetColor(v string) *TagDefinitionUpdate {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *TagDefinitionUpdate) SetNillableColor(v *string) *TagDefinitionUpdate {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// SetAllowedValues sets the "allowed_values" field.
+func (_u *TagDefinitionUpdate) SetAllowedValues(v []string) *TagDefinitionUpdate {
+	_u.mutation.SetAllowedValues(v)
+	return _u
+}
+
+// AppendAllowedValues appends value to the "allowed_values" field.
+func (_u *TagDefinitionUpdate) AppendAllowedValues(v []string) *TagDefinitionUpdate {
+	_u.mutation.AppendAllowedValues(v)
+	return _u
+}
+
+// ClearAllowedValues clears the value of the "allowed_values" field.
+func (_u *TagDefinitionUpdate) ClearAllowedValues() *TagDefinitionUpdate {
+	_u.mutation.ClearAllowedValues()
+	return _u
+}
+
+// SetMultiValued sets the "multi_valued" field.
+func (_u *TagDefinitionUpdate) SetMultiValued(v bool) *TagDefinitionUpdate {
+	_u.mutation.SetMultiValued(v)
+	return _u
+}
+
+// SetNillableMultiValued sets the "multi_valued" field if the given value is not nil.
+func (_u *TagDefinitionUpdate) SetNillableMultiValued(v *bool) *TagDefinitionUpdate {
+	if v != nil {
+		_u.SetMultiValued(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TagDefinitionUpdate) SetPosition(v int) *TagDefinitionUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TagDefinitionUpdate) SetNillablePosition(v *int) *TagDefinitionUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *TagDefinitionUpdate) AddPosition(v int) *TagDefinitionUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// Mutation returns the TagDefinitionMutation object of the builder.
+func (_u *TagDefinitionUpdate) Mutation() *TagDefinitionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TagDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TagDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TagDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tagdefinition.Table, tagdefinition.Columns, sqlgraph.NewFieldSpec(tagdefinition.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(tagdefinition.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Emoji(); ok {
+		_spec.SetField(tagdefinition.FieldEmoji, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(tagdefinition.FieldColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllowedValues(); ok {
+		_spec.SetField(tagdefinition.FieldAllowedValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tagdefinition.FieldAllowedValues, value)
+		})
+	}
+	if _u.mutation.AllowedValuesCleared() {
+		_spec.ClearField(tagdefinition.FieldAllowedValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MultiValued(); ok {
+		_spec.SetField(tagdefinition.FieldMultiValued, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(tagdefinition.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(tagdefinition.FieldPosition, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagdefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TagDefinitionUpdateOne is the builder for updating a single TagDefinition entity.
+type TagDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagDefinitionMutation
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *TagDefinitionUpdateOne) SetDisplayName(v string) *TagDefinitionUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *TagDefinitionUpdateOne) SetNillableDisplayName(v *string) *TagDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetEmoji sets the "emoji" field.
+func (_u *TagDefinitionUpdateOne) SetEmoji(v string) *TagDefinitionUpdateOne {
+	_u.mutation.SetEmoji(v)
+	return _u
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (_u *TagDefinitionUpdateOne) SetNillableEmoji(v *string) *TagDefinitionUpdateOne {
+	if v != nil {
+		_u.SetEmoji(*v)
+	}
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *TagDefinitionUpdateOne) SetColor(v string) *TagDefinitionUpdateOne {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *TagDefinitionUpdateOne) SetNillableColor(v *string) *TagDefinitionUpdateOne {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// SetAllowedValues sets the "allowed_values" field.
+func (_u *TagDefinitionUpdateOne) SetAllowedValues(v []string) *TagDefinitionUpdateOne {
+	_u.mutation.SetAllowedValues(v)
+	return _u
+}
+
+// AppendAllowedValues appends value to the "allowed_values" field.
+func (_u *TagDefinitionUpdateOne) AppendAllowedValues(v []string) *TagDefinitionUpdateOne {
+	_u.mutation.AppendAllowedValues(v)
+	return _u
+}
+
+// ClearAllowedValues clears the value of the "allowed_values" field.
+func (_u *TagDefinitionUpdateOne) ClearAllowedValues() *TagDefinitionUpdateOne {
+	_u.mutation.ClearAllowedValues()
+	return _u
+}
+
+// SetMultiValued sets the "multi_valued" field.
+func (_u *TagDefinitionUpdateOne) SetMultiValued(v bool) *TagDefinitionUpdateOne {
+	_u.mutation.SetMultiValued(v)
+	return _u
+}
+
+// SetNillableMultiValued sets the "multi_valued" field if the given value is not nil.
+func (_u *TagDefinitionUpdateOne) SetNillableMultiValued(v *bool) *TagDefinitionUpdateOne {
+	if v != nil {
+		_u.SetMultiValued(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TagDefinitionUpdateOne) SetPosition(v int) *TagDefinitionUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TagDefinitionUpdateOne) SetNillablePosition(v *int) *TagDefinitionUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *TagDefinitionUpdateOne) AddPosition(v int) *TagDefinitionUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// Mutation returns the TagDefinitionMutation object of the builder.
+func (_u *TagDefinitionUpdateOne) Mutation() *TagDefinitionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TagDefinitionUpdate builder.
+func (_u *TagDefinitionUpdateOne) Where(ps ...predicate.TagDefinition) *TagDefinitionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TagDefinitionUpdateOne) Select(field string, fields ...string) *TagDefinitionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TagDefinition entity.
+func (_u *TagDefinitionUpdateOne) Save(ctx context.Context) (*TagDefinition, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagDefinitionUpdateOne) SaveX(ctx context.Context) *TagDefinition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TagDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TagDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *TagDefinition, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tagdefinition.Table, tagdefinition.Columns, sqlgraph.NewFieldSpec(tagdefinition.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagdefinition.FieldID)
+		for _, f := range fields {
+			if !tagdefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagdefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(tagdefinition.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Emoji(); ok {
+		_spec.SetField(tagdefinition.FieldEmoji, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(tagdefinition.FieldColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllowedValues(); ok {
+		_spec.SetField(tagdefinition.FieldAllowedValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tagdefinition.FieldAllowedValues, value)
+		})
+	}
+	if _u.mutation.AllowedValuesCleared() {
+		_spec.ClearField(tagdefinition.FieldAllowedValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MultiValued(); ok {
+		_spec.SetField(tagdefinition.FieldMultiValued, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(tagdefinition.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(tagdefinition.FieldPosition, field.TypeInt, value)
+	}
+	_node = &TagDefinition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagdefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
 )
 
@@ -84,6 +86,34 @@ func (_c *TaskCreate) SetNillablePosition(v *int) *TaskCreate {
 	return _c
 }
 
+// SetPriority sets the "priority" field.
+func (_c *TaskCreate) SetPriority(v task.Priority) *TaskCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TaskCreate) SetNillablePriority(v *task.Priority) *TaskCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *TaskCreate) SetDueAt(v time.Time) *TaskCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableDueAt(v *time.Time) *TaskCreate {
+	if v != nil {
+		_c.SetDueAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TaskCreate) SetCreatedAt(v time.Time) *TaskCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -112,6 +142,48 @@ func (_c *TaskCreate) SetNillableUpdatedAt(v *time.Time) *TaskCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskCreate) SetDeletedAt(v time.Time) *TaskCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableDeletedAt(v *time.Time) *TaskCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *TaskCreate) SetArchivedAt(v time.Time) *TaskCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableArchivedAt(v *time.Time) *TaskCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetColumnEnteredAt sets the "column_entered_at" field.
+func (_c *TaskCreate) SetColumnEnteredAt(v time.Time) *TaskCreate {
+	_c.mutation.SetColumnEnteredAt(v)
+	return _c
+}
+
+// SetNillableColumnEnteredAt sets the "column_entered_at" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableColumnEnteredAt(v *time.Time) *TaskCreate {
+	if v != nil {
+		_c.SetColumnEnteredAt(*v)
+	}
+	return _c
+}
+
 // AddTagIDs adds the "tags" edge to the TaskTag entity by IDs.
 func (_c *TaskCreate) AddTagIDs(ids ...int) *TaskCreate {
 	_c.mutation.AddTagIDs(ids...)
@@ -142,6 +214,36 @@ func (_c *TaskCreate) AddHistory(v ...*TaskHistory) *TaskCreate {
 	return _c.AddHistoryIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the TaskRevision entity by IDs.
+func (_c *TaskCreate) AddRevisionIDs(ids ...int) *TaskCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the TaskRevision entity.
+func (_c *TaskCreate) AddRevisions(v ...*TaskRevision) *TaskCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddFieldValueIDs adds the "field_values" edge to the TaskFieldValue entity by IDs.
+func (_c *TaskCreate) AddFieldValueIDs(ids ...int) *TaskCreate {
+	_c.mutation.AddFieldValueIDs(ids...)
+	return _c
+}
+
+// AddFieldValues adds the "field_values" edges to the TaskFieldValue entity.
+func (_c *TaskCreate) AddFieldValues(v ...*TaskFieldValue) *TaskCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFieldValueIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_c *TaskCreate) Mutation() *TaskMutation {
 	return _c.mutation
@@ -189,6 +291,10 @@ func (_c *TaskCreate) defaults() {
 		v := task.DefaultPosition
 		_c.mutation.SetPosition(v)
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := task.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := task.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -197,6 +303,10 @@ func (_c *TaskCreate) defaults() {
 		v := task.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.ColumnEnteredAt(); !ok {
+		v := task.DefaultColumnEnteredAt()
+		_c.mutation.SetColumnEnteredAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -218,6 +328,14 @@ func (_c *TaskCreate) check() error {
 	if _, ok := _c.mutation.Position(); !ok {
 		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Task.position"`)}
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Task.priority"`)}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
 	}
@@ -270,6 +388,14 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldPosition, field.TypeInt, value)
 		_node.Position = value
 	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(task.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -278,6 +404,18 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(task.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.ColumnEnteredAt(); ok {
+		_spec.SetField(task.FieldColumnEnteredAt, field.TypeTime, value)
+		_node.ColumnEnteredAt = &value
+	}
 	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -310,6 +448,38 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FieldValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
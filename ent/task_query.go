@@ -14,19 +14,23 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
 )
 
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx         *QueryContext
-	order       []task.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Task
-	withTags    *TaskTagQuery
-	withHistory *TaskHistoryQuery
+	ctx             *QueryContext
+	order           []task.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Task
+	withTags        *TaskTagQuery
+	withHistory     *TaskHistoryQuery
+	withRevisions   *TaskRevisionQuery
+	withFieldValues *TaskFieldValueQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,50 @@ func (_q *TaskQuery) QueryHistory() *TaskHistoryQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *TaskQuery) QueryRevisions() *TaskRevisionQuery {
+	query := (&TaskRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskrevision.Table, taskrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.RevisionsTable, task.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFieldValues chains the current query on the "field_values" edge.
+func (_q *TaskQuery) QueryFieldValues() *TaskFieldValueQuery {
+	query := (&TaskFieldValueClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskfieldvalue.Table, taskfieldvalue.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.FieldValuesTable, task.FieldValuesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Task entity from the query.
 // Returns a *NotFoundError when no Task was found.
 func (_q *TaskQuery) First(ctx context.Context) (*Task, error) {
@@ -294,13 +342,15 @@ func (_q *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]task.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Task{}, _q.predicates...),
-		withTags:    _q.withTags.Clone(),
-		withHistory: _q.withHistory.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]task.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Task{}, _q.predicates...),
+		withTags:        _q.withTags.Clone(),
+		withHistory:     _q.withHistory.Clone(),
+		withRevisions:   _q.withRevisions.Clone(),
+		withFieldValues: _q.withFieldValues.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +379,28 @@ func (_q *TaskQuery) WithHistory(opts ...func(*TaskHistoryQuery)) *TaskQuery {
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithRevisions(opts ...func(*TaskRevisionQuery)) *TaskQuery {
+	query := (&TaskRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithFieldValues tells the query-builder to eager-load the nodes that are connected to
+// the "field_values" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithFieldValues(opts ...func(*TaskFieldValueQuery)) *TaskQuery {
+	query := (&TaskFieldValueClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFieldValues = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +479,11 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withTags != nil,
 			_q.withHistory != nil,
+			_q.withRevisions != nil,
+			_q.withFieldValues != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +518,20 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Task) { n.Edges.Revisions = []*TaskRevision{} },
+			func(n *Task, e *TaskRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFieldValues; query != nil {
+		if err := _q.loadFieldValues(ctx, query, nodes,
+			func(n *Task) { n.Edges.FieldValues = []*TaskFieldValue{} },
+			func(n *Task, e *TaskFieldValue) { n.Edges.FieldValues = append(n.Edges.FieldValues, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -509,6 +597,68 @@ func (_q *TaskQuery) loadHistory(ctx context.Context, query *TaskHistoryQuery, n
 	}
 	return nil
 }
+func (_q *TaskQuery) loadRevisions(ctx context.Context, query *TaskRevisionQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadFieldValues(ctx context.Context, query *TaskFieldValueQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskFieldValue)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskFieldValue(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.FieldValuesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_field_values
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_field_values" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_field_values" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
 	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
 )
 
@@ -113,12 +115,106 @@ func (_u *TaskUpdate) AddPosition(v int) *TaskUpdate {
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *TaskUpdate) SetPriority(v task.Priority) *TaskUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillablePriority(v *task.Priority) *TaskUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *TaskUpdate) SetDueAt(v time.Time) *TaskUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableDueAt(v *time.Time) *TaskUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *TaskUpdate) ClearDueAt() *TaskUpdate {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TaskUpdate) SetUpdatedAt(v time.Time) *TaskUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskUpdate) SetDeletedAt(v time.Time) *TaskUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableDeletedAt(v *time.Time) *TaskUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskUpdate) ClearDeletedAt() *TaskUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *TaskUpdate) SetArchivedAt(v time.Time) *TaskUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableArchivedAt(v *time.Time) *TaskUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *TaskUpdate) ClearArchivedAt() *TaskUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetColumnEnteredAt sets the "column_entered_at" field.
+func (_u *TaskUpdate) SetColumnEnteredAt(v time.Time) *TaskUpdate {
+	_u.mutation.SetColumnEnteredAt(v)
+	return _u
+}
+
+// SetNillableColumnEnteredAt sets the "column_entered_at" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableColumnEnteredAt(v *time.Time) *TaskUpdate {
+	if v != nil {
+		_u.SetColumnEnteredAt(*v)
+	}
+	return _u
+}
+
+// ClearColumnEnteredAt clears the value of the "column_entered_at" field.
+func (_u *TaskUpdate) ClearColumnEnteredAt() *TaskUpdate {
+	_u.mutation.ClearColumnEnteredAt()
+	return _u
+}
+
 // AddTagIDs adds the "tags" edge to the TaskTag entity by IDs.
 func (_u *TaskUpdate) AddTagIDs(ids ...int) *TaskUpdate {
 	_u.mutation.AddTagIDs(ids...)
@@ -149,6 +245,36 @@ func (_u *TaskUpdate) AddHistory(v ...*TaskHistory) *TaskUpdate {
 	return _u.AddHistoryIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the TaskRevision entity by IDs.
+func (_u *TaskUpdate) AddRevisionIDs(ids ...int) *TaskUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the TaskRevision entity.
+func (_u *TaskUpdate) AddRevisions(v ...*TaskRevision) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddFieldValueIDs adds the "field_values" edge to the TaskFieldValue entity by IDs.
+func (_u *TaskUpdate) AddFieldValueIDs(ids ...int) *TaskUpdate {
+	_u.mutation.AddFieldValueIDs(ids...)
+	return _u
+}
+
+// AddFieldValues adds the "field_values" edges to the TaskFieldValue entity.
+func (_u *TaskUpdate) AddFieldValues(v ...*TaskFieldValue) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFieldValueIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdate) Mutation() *TaskMutation {
 	return _u.mutation
@@ -196,6 +322,48 @@ func (_u *TaskUpdate) RemoveHistory(v ...*TaskHistory) *TaskUpdate {
 	return _u.RemoveHistoryIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the TaskRevision entity.
+func (_u *TaskUpdate) ClearRevisions() *TaskUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to TaskRevision entities by IDs.
+func (_u *TaskUpdate) RemoveRevisionIDs(ids ...int) *TaskUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to TaskRevision entities.
+func (_u *TaskUpdate) RemoveRevisions(v ...*TaskRevision) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearFieldValues clears all "field_values" edges to the TaskFieldValue entity.
+func (_u *TaskUpdate) ClearFieldValues() *TaskUpdate {
+	_u.mutation.ClearFieldValues()
+	return _u
+}
+
+// RemoveFieldValueIDs removes the "field_values" edge to TaskFieldValue entities by IDs.
+func (_u *TaskUpdate) RemoveFieldValueIDs(ids ...int) *TaskUpdate {
+	_u.mutation.RemoveFieldValueIDs(ids...)
+	return _u
+}
+
+// RemoveFieldValues removes "field_values" edges to TaskFieldValue entities.
+func (_u *TaskUpdate) RemoveFieldValues(v ...*TaskFieldValue) *TaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFieldValueIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TaskUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -239,6 +407,11 @@ func (_u *TaskUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -275,9 +448,36 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedPosition(); ok {
 		_spec.AddField(task.FieldPosition, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(task.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(task.FieldDueAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(task.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(task.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ColumnEnteredAt(); ok {
+		_spec.SetField(task.FieldColumnEnteredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ColumnEnteredAtCleared() {
+		_spec.ClearField(task.FieldColumnEnteredAt, field.TypeTime)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -368,6 +568,96 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFieldValuesIDs(); len(nodes) > 0 && !_u.mutation.FieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FieldValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{task.Label}
@@ -471,12 +761,106 @@ func (_u *TaskUpdateOne) AddPosition(v int) *TaskUpdateOne {
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *TaskUpdateOne) SetPriority(v task.Priority) *TaskUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillablePriority(v *task.Priority) *TaskUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *TaskUpdateOne) SetDueAt(v time.Time) *TaskUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDueAt(v *time.Time) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *TaskUpdateOne) ClearDueAt() *TaskUpdateOne {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TaskUpdateOne) SetUpdatedAt(v time.Time) *TaskUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskUpdateOne) SetDeletedAt(v time.Time) *TaskUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskUpdateOne) ClearDeletedAt() *TaskUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *TaskUpdateOne) SetArchivedAt(v time.Time) *TaskUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableArchivedAt(v *time.Time) *TaskUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *TaskUpdateOne) ClearArchivedAt() *TaskUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetColumnEnteredAt sets the "column_entered_at" field.
+func (_u *TaskUpdateOne) SetColumnEnteredAt(v time.Time) *TaskUpdateOne {
+	_u.mutation.SetColumnEnteredAt(v)
+	return _u
+}
+
+// SetNillableColumnEnteredAt sets the "column_entered_at" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableColumnEnteredAt(v *time.Time) *TaskUpdateOne {
+	if v != nil {
+		_u.SetColumnEnteredAt(*v)
+	}
+	return _u
+}
+
+// ClearColumnEnteredAt clears the value of the "column_entered_at" field.
+func (_u *TaskUpdateOne) ClearColumnEnteredAt() *TaskUpdateOne {
+	_u.mutation.ClearColumnEnteredAt()
+	return _u
+}
+
 // AddTagIDs adds the "tags" edge to the TaskTag entity by IDs.
 func (_u *TaskUpdateOne) AddTagIDs(ids ...int) *TaskUpdateOne {
 	_u.mutation.AddTagIDs(ids...)
@@ -507,6 +891,36 @@ func (_u *TaskUpdateOne) AddHistory(v ...*TaskHistory) *TaskUpdateOne {
 	return _u.AddHistoryIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the TaskRevision entity by IDs.
+func (_u *TaskUpdateOne) AddRevisionIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the TaskRevision entity.
+func (_u *TaskUpdateOne) AddRevisions(v ...*TaskRevision) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddFieldValueIDs adds the "field_values" edge to the TaskFieldValue entity by IDs.
+func (_u *TaskUpdateOne) AddFieldValueIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.AddFieldValueIDs(ids...)
+	return _u
+}
+
+// AddFieldValues adds the "field_values" edges to the TaskFieldValue entity.
+func (_u *TaskUpdateOne) AddFieldValues(v ...*TaskFieldValue) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFieldValueIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (_u *TaskUpdateOne) Mutation() *TaskMutation {
 	return _u.mutation
@@ -554,6 +968,48 @@ func (_u *TaskUpdateOne) RemoveHistory(v ...*TaskHistory) *TaskUpdateOne {
 	return _u.RemoveHistoryIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the TaskRevision entity.
+func (_u *TaskUpdateOne) ClearRevisions() *TaskUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to TaskRevision entities by IDs.
+func (_u *TaskUpdateOne) RemoveRevisionIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to TaskRevision entities.
+func (_u *TaskUpdateOne) RemoveRevisions(v ...*TaskRevision) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearFieldValues clears all "field_values" edges to the TaskFieldValue entity.
+func (_u *TaskUpdateOne) ClearFieldValues() *TaskUpdateOne {
+	_u.mutation.ClearFieldValues()
+	return _u
+}
+
+// RemoveFieldValueIDs removes the "field_values" edge to TaskFieldValue entities by IDs.
+func (_u *TaskUpdateOne) RemoveFieldValueIDs(ids ...int) *TaskUpdateOne {
+	_u.mutation.RemoveFieldValueIDs(ids...)
+	return _u
+}
+
+// RemoveFieldValues removes "field_values" edges to TaskFieldValue entities.
+func (_u *TaskUpdateOne) RemoveFieldValues(v ...*TaskFieldValue) *TaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFieldValueIDs(ids...)
+}
+
 // Where appends a list predicates to the TaskUpdate builder.
 func (_u *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
 	_u.mutation.Where(ps...)
@@ -610,6 +1066,11 @@ func (_u *TaskUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -663,9 +1124,36 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if value, ok := _u.mutation.AddedPosition(); ok {
 		_spec.AddField(task.FieldPosition, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(task.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(task.FieldDueAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(task.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(task.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ColumnEnteredAt(); ok {
+		_spec.SetField(task.FieldColumnEnteredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ColumnEnteredAtCleared() {
+		_spec.ClearField(task.FieldColumnEnteredAt, field.TypeTime)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -756,6 +1244,96 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RevisionsTable,
+			Columns: []string{task.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFieldValuesIDs(); len(nodes) > 0 && !_u.mutation.FieldValuesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FieldValuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.FieldValuesTable,
+			Columns: []string{task.FieldValuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskfieldvalue.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Task{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
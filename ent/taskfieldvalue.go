@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
+)
+
+// TaskFieldValue is the model entity for the TaskFieldValue schema.
+type TaskFieldValue struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskFieldValueQuery when eager-loading is set.
+	Edges             TaskFieldValueEdges `json:"edges"`
+	task_field_values *int
+	selectValues      sql.SelectValues
+}
+
+// TaskFieldValueEdges holds the relations/edges for other nodes in the graph.
+type TaskFieldValueEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskFieldValueEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskFieldValue) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskfieldvalue.FieldID:
+			values[i] = new(sql.NullInt64)
+		case taskfieldvalue.FieldKey, taskfieldvalue.FieldValue:
+			values[i] = new(sql.NullString)
+		case taskfieldvalue.ForeignKeys[0]: // task_field_values
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskFieldValue fields.
+func (_m *TaskFieldValue) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskfieldvalue.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case taskfieldvalue.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case taskfieldvalue.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case taskfieldvalue.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field task_field_values", value)
+			} else if value.Valid {
+				_m.task_field_values = new(int)
+				*_m.task_field_values = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the TaskFieldValue.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskFieldValue) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskFieldValue entity.
+func (_m *TaskFieldValue) QueryTask() *TaskQuery {
+	return NewTaskFieldValueClient(_m.config).QueryTask(_m)
+}
+
+// Update returns a builder for updating this TaskFieldValue.
+// Note that you need to call TaskFieldValue.Unwrap() before calling this method if this TaskFieldValue
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskFieldValue) Update() *TaskFieldValueUpdateOne {
+	return NewTaskFieldValueClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskFieldValue entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskFieldValue) Unwrap() *TaskFieldValue {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskFieldValue is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskFieldValue) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskFieldValue(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskFieldValues is a parsable slice of TaskFieldValue.
+type TaskFieldValues []*TaskFieldValue
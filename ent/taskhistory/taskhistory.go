@@ -20,6 +20,8 @@ const (
 	FieldDetails = "details"
 	// FieldActor holds the string denoting the actor field in the database.
 	FieldActor = "actor"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeTask holds the string denoting the task edge name in mutations.
@@ -41,6 +43,7 @@ var Columns = []string{
 	FieldAction,
 	FieldDetails,
 	FieldActor,
+	FieldChanges,
 	FieldCreatedAt,
 }
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/history"
 )
 
 // TaskHistoryCreate is the builder for creating a TaskHistory entity.
@@ -55,6 +56,12 @@ func (_c *TaskHistoryCreate) SetNillableActor(v *string) *TaskHistoryCreate {
 	return _c
 }
 
+// SetChanges sets the "changes" field.
+func (_c *TaskHistoryCreate) SetChanges(v []history.Change) *TaskHistoryCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TaskHistoryCreate) SetCreatedAt(v time.Time) *TaskHistoryCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -75,6 +82,14 @@ func (_c *TaskHistoryCreate) SetTaskID(id int) *TaskHistoryCreate {
 	return _c
 }
 
+// SetNillableTaskID sets the "task" edge to the Task entity by ID if the given value is not nil.
+func (_c *TaskHistoryCreate) SetNillableTaskID(id *int) *TaskHistoryCreate {
+	if id != nil {
+		_c = _c.SetTaskID(*id)
+	}
+	return _c
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_c *TaskHistoryCreate) SetTask(v *Task) *TaskHistoryCreate {
 	return _c.SetTaskID(v.ID)
@@ -141,9 +156,6 @@ func (_c *TaskHistoryCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskHistory.created_at"`)}
 	}
-	if len(_c.mutation.TaskIDs()) == 0 {
-		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskHistory.task"`)}
-	}
 	return nil
 }
 
@@ -182,6 +194,10 @@ func (_c *TaskHistoryCreate) createSpec() (*TaskHistory, *sqlgraph.CreateSpec) {
 		_spec.SetField(taskhistory.FieldActor, field.TypeString, value)
 		_node.Actor = value
 	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(taskhistory.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(taskhistory.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -9,10 +9,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
 	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/history"
 )
 
 // TaskHistoryUpdate is the builder for updating TaskHistory entities.
@@ -76,12 +78,38 @@ func (_u *TaskHistoryUpdate) SetNillableActor(v *string) *TaskHistoryUpdate {
 	return _u
 }
 
+// SetChanges sets the "changes" field.
+func (_u *TaskHistoryUpdate) SetChanges(v []history.Change) *TaskHistoryUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *TaskHistoryUpdate) AppendChanges(v []history.Change) *TaskHistoryUpdate {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *TaskHistoryUpdate) ClearChanges() *TaskHistoryUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
 // SetTaskID sets the "task" edge to the Task entity by ID.
 func (_u *TaskHistoryUpdate) SetTaskID(id int) *TaskHistoryUpdate {
 	_u.mutation.SetTaskID(id)
 	return _u
 }
 
+// SetNillableTaskID sets the "task" edge to the Task entity by ID if the given value is not nil.
+func (_u *TaskHistoryUpdate) SetNillableTaskID(id *int) *TaskHistoryUpdate {
+	if id != nil {
+		_u = _u.SetTaskID(*id)
+	}
+	return _u
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_u *TaskHistoryUpdate) SetTask(v *Task) *TaskHistoryUpdate {
 	return _u.SetTaskID(v.ID)
@@ -132,9 +160,6 @@ func (_u *TaskHistoryUpdate) check() error {
 			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TaskHistory.action": %w`, err)}
 		}
 	}
-	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "TaskHistory.task"`)
-	}
 	return nil
 }
 
@@ -162,6 +187,17 @@ func (_u *TaskHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Actor(); ok {
 		_spec.SetField(taskhistory.FieldActor, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(taskhistory.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskhistory.FieldChanges, value)
+		})
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(taskhistory.FieldChanges, field.TypeJSON)
+	}
 	if _u.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -259,12 +295,38 @@ func (_u *TaskHistoryUpdateOne) SetNillableActor(v *string) *TaskHistoryUpdateOn
 	return _u
 }
 
+// SetChanges sets the "changes" field.
+func (_u *TaskHistoryUpdateOne) SetChanges(v []history.Change) *TaskHistoryUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *TaskHistoryUpdateOne) AppendChanges(v []history.Change) *TaskHistoryUpdateOne {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *TaskHistoryUpdateOne) ClearChanges() *TaskHistoryUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
 // SetTaskID sets the "task" edge to the Task entity by ID.
 func (_u *TaskHistoryUpdateOne) SetTaskID(id int) *TaskHistoryUpdateOne {
 	_u.mutation.SetTaskID(id)
 	return _u
 }
 
+// SetNillableTaskID sets the "task" edge to the Task entity by ID if the given value is not nil.
+func (_u *TaskHistoryUpdateOne) SetNillableTaskID(id *int) *TaskHistoryUpdateOne {
+	if id != nil {
+		_u = _u.SetTaskID(*id)
+	}
+	return _u
+}
+
 // SetTask sets the "task" edge to the Task entity.
 func (_u *TaskHistoryUpdateOne) SetTask(v *Task) *TaskHistoryUpdateOne {
 	return _u.SetTaskID(v.ID)
@@ -328,9 +390,6 @@ func (_u *TaskHistoryUpdateOne) check() error {
 			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TaskHistory.action": %w`, err)}
 		}
 	}
-	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "TaskHistory.task"`)
-	}
 	return nil
 }
 
@@ -375,6 +434,17 @@ func (_u *TaskHistoryUpdateOne) sqlSave(ctx context.Context) (_node *TaskHistory
 	if value, ok := _u.mutation.Actor(); ok {
 		_spec.SetField(taskhistory.FieldActor, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(taskhistory.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskhistory.FieldChanges, value)
+		})
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(taskhistory.FieldChanges, field.TypeJSON)
+	}
 	if _u.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
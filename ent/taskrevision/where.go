@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldID, id))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldDescription, v))
+}
+
+// Column applies equality check predicate on the "column" field. It's identical to ColumnEQ.
+func Column(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldColumn, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldAssignee, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldPriority, v))
+}
+
+// Due applies equality check predicate on the "due" field. It's identical to DueEQ.
+func Due(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldDue, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldActor, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ColumnEQ applies the EQ predicate on the "column" field.
+func ColumnEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldColumn, v))
+}
+
+// ColumnNEQ applies the NEQ predicate on the "column" field.
+func ColumnNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldColumn, v))
+}
+
+// ColumnIn applies the In predicate on the "column" field.
+func ColumnIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldColumn, vs...))
+}
+
+// ColumnNotIn applies the NotIn predicate on the "column" field.
+func ColumnNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldColumn, vs...))
+}
+
+// ColumnGT applies the GT predicate on the "column" field.
+func ColumnGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldColumn, v))
+}
+
+// ColumnGTE applies the GTE predicate on the "column" field.
+func ColumnGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldColumn, v))
+}
+
+// ColumnLT applies the LT predicate on the "column" field.
+func ColumnLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldColumn, v))
+}
+
+// ColumnLTE applies the LTE predicate on the "column" field.
+func ColumnLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldColumn, v))
+}
+
+// ColumnContains applies the Contains predicate on the "column" field.
+func ColumnContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldColumn, v))
+}
+
+// ColumnHasPrefix applies the HasPrefix predicate on the "column" field.
+func ColumnHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldColumn, v))
+}
+
+// ColumnHasSuffix applies the HasSuffix predicate on the "column" field.
+func ColumnHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldColumn, v))
+}
+
+// ColumnEqualFold applies the EqualFold predicate on the "column" field.
+func ColumnEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldColumn, v))
+}
+
+// ColumnContainsFold applies the ContainsFold predicate on the "column" field.
+func ColumnContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldColumn, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldPriority, v))
+}
+
+// PriorityContains applies the Contains predicate on the "priority" field.
+func PriorityContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldPriority, v))
+}
+
+// PriorityHasPrefix applies the HasPrefix predicate on the "priority" field.
+func PriorityHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldPriority, v))
+}
+
+// PriorityHasSuffix applies the HasSuffix predicate on the "priority" field.
+func PriorityHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldPriority, v))
+}
+
+// PriorityEqualFold applies the EqualFold predicate on the "priority" field.
+func PriorityEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldPriority, v))
+}
+
+// PriorityContainsFold applies the ContainsFold predicate on the "priority" field.
+func PriorityContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldPriority, v))
+}
+
+// DueEQ applies the EQ predicate on the "due" field.
+func DueEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldDue, v))
+}
+
+// DueNEQ applies the NEQ predicate on the "due" field.
+func DueNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldDue, v))
+}
+
+// DueIn applies the In predicate on the "due" field.
+func DueIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldDue, vs...))
+}
+
+// DueNotIn applies the NotIn predicate on the "due" field.
+func DueNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldDue, vs...))
+}
+
+// DueGT applies the GT predicate on the "due" field.
+func DueGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldDue, v))
+}
+
+// DueGTE applies the GTE predicate on the "due" field.
+func DueGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldDue, v))
+}
+
+// DueLT applies the LT predicate on the "due" field.
+func DueLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldDue, v))
+}
+
+// DueLTE applies the LTE predicate on the "due" field.
+func DueLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldDue, v))
+}
+
+// DueContains applies the Contains predicate on the "due" field.
+func DueContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldDue, v))
+}
+
+// DueHasPrefix applies the HasPrefix predicate on the "due" field.
+func DueHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldDue, v))
+}
+
+// DueHasSuffix applies the HasSuffix predicate on the "due" field.
+func DueHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldDue, v))
+}
+
+// DueEqualFold applies the EqualFold predicate on the "due" field.
+func DueEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldDue, v))
+}
+
+// DueContainsFold applies the ContainsFold predicate on the "due" field.
+func DueContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldDue, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotNull(FieldTags))
+}
+
+// FieldsIsNil applies the IsNil predicate on the "fields" field.
+func FieldsIsNil() predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIsNull(FieldFields))
+}
+
+// FieldsNotNil applies the NotNil predicate on the "fields" field.
+func FieldsNotNil() predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotNull(FieldFields))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldContainsFold(FieldActor, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.TaskRevision {
+	return predicate.TaskRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.TaskRevision {
+	return predicate.TaskRevision(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskRevision) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskRevision) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskRevision) predicate.TaskRevision {
+	return predicate.TaskRevision(sql.NotPredicates(p))
+}
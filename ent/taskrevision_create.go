@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
+)
+
+// TaskRevisionCreate is the builder for creating a TaskRevision entity.
+type TaskRevisionCreate struct {
+	config
+	mutation *TaskRevisionMutation
+	hooks    []Hook
+}
+
+// SetRevision sets the "revision" field.
+func (_c *TaskRevisionCreate) SetRevision(v int) *TaskRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *TaskRevisionCreate) SetTitle(v string) *TaskRevisionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TaskRevisionCreate) SetDescription(v string) *TaskRevisionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TaskRevisionCreate) SetNillableDescription(v *string) *TaskRevisionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetColumn sets the "column" field.
+func (_c *TaskRevisionCreate) SetColumn(v string) *TaskRevisionCreate {
+	_c.mutation.SetColumn(v)
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *TaskRevisionCreate) SetAssignee(v string) *TaskRevisionCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *TaskRevisionCreate) SetNillableAssignee(v *string) *TaskRevisionCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TaskRevisionCreate) SetPriority(v string) *TaskRevisionCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TaskRevisionCreate) SetNillablePriority(v *string) *TaskRevisionCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetDue sets the "due" field.
+func (_c *TaskRevisionCreate) SetDue(v string) *TaskRevisionCreate {
+	_c.mutation.SetDue(v)
+	return _c
+}
+
+// SetNillableDue sets the "due" field if the given value is not nil.
+func (_c *TaskRevisionCreate) SetNillableDue(v *string) *TaskRevisionCreate {
+	if v != nil {
+		_c.SetDue(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *TaskRevisionCreate) SetTags(v []string) *TaskRevisionCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetFields sets the "fields" field.
+func (_c *TaskRevisionCreate) SetFields(v map[string]string) *TaskRevisionCreate {
+	_c.mutation.SetFields(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *TaskRevisionCreate) SetActor(v string) *TaskRevisionCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *TaskRevisionCreate) SetNillableActor(v *string) *TaskRevisionCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskRevisionCreate) SetCreatedAt(v time.Time) *TaskRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskRevisionCreate) SetNillableCreatedAt(v *time.Time) *TaskRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_c *TaskRevisionCreate) SetTaskID(id int) *TaskRevisionCreate {
+	_c.mutation.SetTaskID(id)
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskRevisionCreate) SetTask(v *Task) *TaskRevisionCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskRevisionMutation object of the builder.
+func (_c *TaskRevisionCreate) Mutation() *TaskRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskRevision in the database.
+func (_c *TaskRevisionCreate) Save(ctx context.Context) (*TaskRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskRevisionCreate) SaveX(ctx context.Context) *TaskRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskRevisionCreate) defaults() {
+	if _, ok := _c.mutation.Assignee(); !ok {
+		v := taskrevision.DefaultAssignee
+		_c.mutation.SetAssignee(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := taskrevision.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Due(); !ok {
+		v := taskrevision.DefaultDue
+		_c.mutation.SetDue(v)
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		v := taskrevision.DefaultActor
+		_c.mutation.SetActor(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taskrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskRevisionCreate) check() error {
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "TaskRevision.revision"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TaskRevision.title"`)}
+	}
+	if _, ok := _c.mutation.Column(); !ok {
+		return &ValidationError{Name: "column", err: errors.New(`ent: missing required field "TaskRevision.column"`)}
+	}
+	if _, ok := _c.mutation.Assignee(); !ok {
+		return &ValidationError{Name: "assignee", err: errors.New(`ent: missing required field "TaskRevision.assignee"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "TaskRevision.priority"`)}
+	}
+	if _, ok := _c.mutation.Due(); !ok {
+		return &ValidationError{Name: "due", err: errors.New(`ent: missing required field "TaskRevision.due"`)}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "TaskRevision.actor"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskRevision.created_at"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskRevision.task"`)}
+	}
+	return nil
+}
+
+func (_c *TaskRevisionCreate) sqlSave(ctx context.Context) (*TaskRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskRevisionCreate) createSpec() (*TaskRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskrevision.Table, sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(taskrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(taskrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(taskrevision.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Column(); ok {
+		_spec.SetField(taskrevision.FieldColumn, field.TypeString, value)
+		_node.Column = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(taskrevision.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(taskrevision.FieldPriority, field.TypeString, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Due(); ok {
+		_spec.SetField(taskrevision.FieldDue, field.TypeString, value)
+		_node.Due = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(taskrevision.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.GetFields(); ok {
+		_spec.SetField(taskrevision.FieldFields, field.TypeJSON, value)
+		_node.Fields = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(taskrevision.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskrevision.TaskTable,
+			Columns: []string{taskrevision.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.task_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskRevisionCreateBulk is the builder for creating many TaskRevision entities in bulk.
+type TaskRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRevisionCreate
+}
+
+// Save creates the TaskRevision entities in the database.
+func (_c *TaskRevisionCreateBulk) Save(ctx context.Context) ([]*TaskRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskRevisionCreateBulk) SaveX(ctx context.Context) []*TaskRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
+)
+
+// TaskRevisionUpdate is the builder for updating TaskRevision entities.
+type TaskRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskRevisionMutation
+}
+
+// Where appends a list predicates to the TaskRevisionUpdate builder.
+func (_u *TaskRevisionUpdate) Where(ps ...predicate.TaskRevision) *TaskRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the TaskRevisionMutation object of the builder.
+func (_u *TaskRevisionUpdate) Mutation() *TaskRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRevisionUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskRevision.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrevision.Table, taskrevision.Columns, sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(taskrevision.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(taskrevision.FieldTags, field.TypeJSON)
+	}
+	if _u.mutation.FieldsCleared() {
+		_spec.ClearField(taskrevision.FieldFields, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskRevisionUpdateOne is the builder for updating a single TaskRevision entity.
+type TaskRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskRevisionMutation
+}
+
+// Mutation returns the TaskRevisionMutation object of the builder.
+func (_u *TaskRevisionUpdateOne) Mutation() *TaskRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskRevisionUpdate builder.
+func (_u *TaskRevisionUpdateOne) Where(ps ...predicate.TaskRevision) *TaskRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskRevisionUpdateOne) Select(field string, fields ...string) *TaskRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskRevision entity.
+func (_u *TaskRevisionUpdateOne) Save(ctx context.Context) (*TaskRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRevisionUpdateOne) SaveX(ctx context.Context) *TaskRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRevisionUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskRevision.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskRevisionUpdateOne) sqlSave(ctx context.Context) (_node *TaskRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrevision.Table, taskrevision.Columns, sqlgraph.NewFieldSpec(taskrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrevision.FieldID)
+		for _, f := range fields {
+			if !taskrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(taskrevision.FieldDescription, field.TypeString)
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(taskrevision.FieldTags, field.TypeJSON)
+	}
+	if _u.mutation.FieldsCleared() {
+		_spec.ClearField(taskrevision.FieldFields, field.TypeJSON)
+	}
+	_node = &TaskRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
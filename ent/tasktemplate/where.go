@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tasktemplate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// Column applies equality check predicate on the "column" field. It's identical to ColumnEQ.
+func Column(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldColumn, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldAssignee, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotNull(FieldTags))
+}
+
+// ColumnEQ applies the EQ predicate on the "column" field.
+func ColumnEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldColumn, v))
+}
+
+// ColumnNEQ applies the NEQ predicate on the "column" field.
+func ColumnNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldColumn, v))
+}
+
+// ColumnIn applies the In predicate on the "column" field.
+func ColumnIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldColumn, vs...))
+}
+
+// ColumnNotIn applies the NotIn predicate on the "column" field.
+func ColumnNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldColumn, vs...))
+}
+
+// ColumnGT applies the GT predicate on the "column" field.
+func ColumnGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldColumn, v))
+}
+
+// ColumnGTE applies the GTE predicate on the "column" field.
+func ColumnGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldColumn, v))
+}
+
+// ColumnLT applies the LT predicate on the "column" field.
+func ColumnLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldColumn, v))
+}
+
+// ColumnLTE applies the LTE predicate on the "column" field.
+func ColumnLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldColumn, v))
+}
+
+// ColumnContains applies the Contains predicate on the "column" field.
+func ColumnContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldColumn, v))
+}
+
+// ColumnHasPrefix applies the HasPrefix predicate on the "column" field.
+func ColumnHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldColumn, v))
+}
+
+// ColumnHasSuffix applies the HasSuffix predicate on the "column" field.
+func ColumnHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldColumn, v))
+}
+
+// ColumnEqualFold applies the EqualFold predicate on the "column" field.
+func ColumnEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldColumn, v))
+}
+
+// ColumnContainsFold applies the ContainsFold predicate on the "column" field.
+func ColumnContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldColumn, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskTemplate) predicate.TaskTemplate {
+	return predicate.TaskTemplate(sql.NotPredicates(p))
+}
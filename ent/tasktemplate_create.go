@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+)
+
+// TaskTemplateCreate is the builder for creating a TaskTemplate entity.
+type TaskTemplateCreate struct {
+	config
+	mutation *TaskTemplateMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *TaskTemplateCreate) SetName(v string) *TaskTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *TaskTemplateCreate) SetTitle(v string) *TaskTemplateCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *TaskTemplateCreate) SetNillableTitle(v *string) *TaskTemplateCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TaskTemplateCreate) SetDescription(v string) *TaskTemplateCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TaskTemplateCreate) SetNillableDescription(v *string) *TaskTemplateCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *TaskTemplateCreate) SetTags(v []string) *TaskTemplateCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetColumn sets the "column" field.
+func (_c *TaskTemplateCreate) SetColumn(v string) *TaskTemplateCreate {
+	_c.mutation.SetColumn(v)
+	return _c
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_c *TaskTemplateCreate) SetNillableColumn(v *string) *TaskTemplateCreate {
+	if v != nil {
+		_c.SetColumn(*v)
+	}
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *TaskTemplateCreate) SetAssignee(v string) *TaskTemplateCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *TaskTemplateCreate) SetNillableAssignee(v *string) *TaskTemplateCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskTemplateCreate) SetCreatedAt(v time.Time) *TaskTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskTemplateCreate) SetNillableCreatedAt(v *time.Time) *TaskTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (_c *TaskTemplateCreate) Mutation() *TaskTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskTemplate in the database.
+func (_c *TaskTemplateCreate) Save(ctx context.Context) (*TaskTemplate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskTemplateCreate) SaveX(ctx context.Context) *TaskTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskTemplateCreate) defaults() {
+	if _, ok := _c.mutation.Title(); !ok {
+		v := tasktemplate.DefaultTitle
+		_c.mutation.SetTitle(v)
+	}
+	if _, ok := _c.mutation.Column(); !ok {
+		v := tasktemplate.DefaultColumn
+		_c.mutation.SetColumn(v)
+	}
+	if _, ok := _c.mutation.Assignee(); !ok {
+		v := tasktemplate.DefaultAssignee
+		_c.mutation.SetAssignee(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tasktemplate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskTemplateCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaskTemplate.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tasktemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TaskTemplate.title"`)}
+	}
+	if _, ok := _c.mutation.Column(); !ok {
+		return &ValidationError{Name: "column", err: errors.New(`ent: missing required field "TaskTemplate.column"`)}
+	}
+	if _, ok := _c.mutation.Assignee(); !ok {
+		return &ValidationError{Name: "assignee", err: errors.New(`ent: missing required field "TaskTemplate.assignee"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskTemplate.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TaskTemplateCreate) sqlSave(ctx context.Context) (*TaskTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskTemplateCreate) createSpec() (*TaskTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tasktemplate.Table, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tasktemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(tasktemplate.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(tasktemplate.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Column(); ok {
+		_spec.SetField(tasktemplate.FieldColumn, field.TypeString, value)
+		_node.Column = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(tasktemplate.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tasktemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TaskTemplateCreateBulk is the builder for creating many TaskTemplate entities in bulk.
+type TaskTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*TaskTemplateCreate
+}
+
+// Save creates the TaskTemplate entities in the database.
+func (_c *TaskTemplateCreateBulk) Save(ctx context.Context) ([]*TaskTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskTemplateCreateBulk) SaveX(ctx context.Context) []*TaskTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+)
+
+// TaskTemplateUpdate is the builder for updating TaskTemplate entities.
+type TaskTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskTemplateMutation
+}
+
+// Where appends a list predicates to the TaskTemplateUpdate builder.
+func (_u *TaskTemplateUpdate) Where(ps ...predicate.TaskTemplate) *TaskTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TaskTemplateUpdate) SetName(v string) *TaskTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TaskTemplateUpdate) SetNillableName(v *string) *TaskTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TaskTemplateUpdate) SetTitle(v string) *TaskTemplateUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TaskTemplateUpdate) SetNillableTitle(v *string) *TaskTemplateUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TaskTemplateUpdate) SetDescription(v string) *TaskTemplateUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TaskTemplateUpdate) SetNillableDescription(v *string) *TaskTemplateUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TaskTemplateUpdate) ClearDescription() *TaskTemplateUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *TaskTemplateUpdate) SetTags(v []string) *TaskTemplateUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *TaskTemplateUpdate) AppendTags(v []string) *TaskTemplateUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *TaskTemplateUpdate) ClearTags() *TaskTemplateUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetColumn sets the "column" field.
+func (_u *TaskTemplateUpdate) SetColumn(v string) *TaskTemplateUpdate {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *TaskTemplateUpdate) SetNillableColumn(v *string) *TaskTemplateUpdate {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *TaskTemplateUpdate) SetAssignee(v string) *TaskTemplateUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *TaskTemplateUpdate) SetNillableAssignee(v *string) *TaskTemplateUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (_u *TaskTemplateUpdate) Mutation() *TaskTemplateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskTemplateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tasktemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TaskTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tasktemplate.Table, tasktemplate.Columns, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tasktemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(tasktemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tasktemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(tasktemplate.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(tasktemplate.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(tasktemplate.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(tasktemplate.FieldAssignee, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskTemplateUpdateOne is the builder for updating a single TaskTemplate entity.
+type TaskTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskTemplateMutation
+}
+
+// SetName sets the "name" field.
+func (_u *TaskTemplateUpdateOne) SetName(v string) *TaskTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TaskTemplateUpdateOne) SetNillableName(v *string) *TaskTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TaskTemplateUpdateOne) SetTitle(v string) *TaskTemplateUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TaskTemplateUpdateOne) SetNillableTitle(v *string) *TaskTemplateUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TaskTemplateUpdateOne) SetDescription(v string) *TaskTemplateUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TaskTemplateUpdateOne) SetNillableDescription(v *string) *TaskTemplateUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TaskTemplateUpdateOne) ClearDescription() *TaskTemplateUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *TaskTemplateUpdateOne) SetTags(v []string) *TaskTemplateUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *TaskTemplateUpdateOne) AppendTags(v []string) *TaskTemplateUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *TaskTemplateUpdateOne) ClearTags() *TaskTemplateUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetColumn sets the "column" field.
+func (_u *TaskTemplateUpdateOne) SetColumn(v string) *TaskTemplateUpdateOne {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *TaskTemplateUpdateOne) SetNillableColumn(v *string) *TaskTemplateUpdateOne {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *TaskTemplateUpdateOne) SetAssignee(v string) *TaskTemplateUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *TaskTemplateUpdateOne) SetNillableAssignee(v *string) *TaskTemplateUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TaskTemplateMutation object of the builder.
+func (_u *TaskTemplateUpdateOne) Mutation() *TaskTemplateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskTemplateUpdate builder.
+func (_u *TaskTemplateUpdateOne) Where(ps ...predicate.TaskTemplate) *TaskTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskTemplateUpdateOne) Select(field string, fields ...string) *TaskTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskTemplate entity.
+func (_u *TaskTemplateUpdateOne) Save(ctx context.Context) (*TaskTemplate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskTemplateUpdateOne) SaveX(ctx context.Context) *TaskTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tasktemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskTemplate.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TaskTemplateUpdateOne) sqlSave(ctx context.Context) (_node *TaskTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tasktemplate.Table, tasktemplate.Columns, sqlgraph.NewFieldSpec(tasktemplate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tasktemplate.FieldID)
+		for _, f := range fields {
+			if !tasktemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tasktemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tasktemplate.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(tasktemplate.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tasktemplate.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tasktemplate.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(tasktemplate.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tasktemplate.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(tasktemplate.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(tasktemplate.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(tasktemplate.FieldAssignee, field.TypeString, value)
+	}
+	_node = &TaskTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tasktemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+)
+
+// Transition is the model entity for the Transition schema.
+type Transition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FromColumn holds the value of the "from_column" field.
+	FromColumn string `json:"from_column,omitempty"`
+	// ToColumn holds the value of the "to_column" field.
+	ToColumn string `json:"to_column,omitempty"`
+	// Guards holds the value of the "guards" field.
+	Guards []string `json:"guards,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Transition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transition.FieldGuards:
+			values[i] = new([]byte)
+		case transition.FieldID:
+			values[i] = new(sql.NullInt64)
+		case transition.FieldFromColumn, transition.FieldToColumn:
+			values[i] = new(sql.NullString)
+		case transition.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Transition fields.
+func (_m *Transition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case transition.FieldFromColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_column", values[i])
+			} else if value.Valid {
+				_m.FromColumn = value.String
+			}
+		case transition.FieldToColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_column", values[i])
+			} else if value.Valid {
+				_m.ToColumn = value.String
+			}
+		case transition.FieldGuards:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field guards", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Guards); err != nil {
+					return fmt.Errorf("unmarshal field guards: %w", err)
+				}
+			}
+		case transition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Transition.
+// This includes values selected through modifiers, order, etc.
+func (_m *Transition) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Transition.
+// Note that you need to call Transition.Unwrap() before calling this method if this Transition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Transition) Update() *TransitionUpdateOne {
+	return NewTransitionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Transition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Transition) Unwrap() *Transition {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Transition is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Transition) String() string {
+	var builder strings.Builder
+	builder.WriteString("Transition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("from_column=")
+	builder.WriteString(_m.FromColumn)
+	builder.WriteString(", ")
+	builder.WriteString("to_column=")
+	builder.WriteString(_m.ToColumn)
+	builder.WriteString(", ")
+	builder.WriteString("guards=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Guards))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Transitions is a parsable slice of Transition.
+type Transitions []*Transition
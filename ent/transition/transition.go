@@ -0,0 +1,77 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the transition type in the database.
+	Label = "transition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFromColumn holds the string denoting the from_column field in the database.
+	FieldFromColumn = "from_column"
+	// FieldToColumn holds the string denoting the to_column field in the database.
+	FieldToColumn = "to_column"
+	// FieldGuards holds the string denoting the guards field in the database.
+	FieldGuards = "guards"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the transition in the database.
+	Table = "transitions"
+)
+
+// Columns holds all SQL columns for transition fields.
+var Columns = []string{
+	FieldID,
+	FieldFromColumn,
+	FieldToColumn,
+	FieldGuards,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FromColumnValidator is a validator for the "from_column" field. It is called by the builders before save.
+	FromColumnValidator func(string) error
+	// ToColumnValidator is a validator for the "to_column" field. It is called by the builders before save.
+	ToColumnValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Transition queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFromColumn orders the results by the from_column field.
+func ByFromColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromColumn, opts...).ToFunc()
+}
+
+// ByToColumn orders the results by the to_column field.
+func ByToColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToColumn, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
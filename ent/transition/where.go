@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Transition {
+	return predicate.Transition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Transition {
+	return predicate.Transition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Transition {
+	return predicate.Transition(sql.FieldLTE(FieldID, id))
+}
+
+// FromColumn applies equality check predicate on the "from_column" field. It's identical to FromColumnEQ.
+func FromColumn(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldFromColumn, v))
+}
+
+// ToColumn applies equality check predicate on the "to_column" field. It's identical to ToColumnEQ.
+func ToColumn(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldToColumn, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FromColumnEQ applies the EQ predicate on the "from_column" field.
+func FromColumnEQ(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldFromColumn, v))
+}
+
+// FromColumnNEQ applies the NEQ predicate on the "from_column" field.
+func FromColumnNEQ(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldNEQ(FieldFromColumn, v))
+}
+
+// FromColumnIn applies the In predicate on the "from_column" field.
+func FromColumnIn(vs ...string) predicate.Transition {
+	return predicate.Transition(sql.FieldIn(FieldFromColumn, vs...))
+}
+
+// FromColumnNotIn applies the NotIn predicate on the "from_column" field.
+func FromColumnNotIn(vs ...string) predicate.Transition {
+	return predicate.Transition(sql.FieldNotIn(FieldFromColumn, vs...))
+}
+
+// FromColumnGT applies the GT predicate on the "from_column" field.
+func FromColumnGT(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldGT(FieldFromColumn, v))
+}
+
+// FromColumnGTE applies the GTE predicate on the "from_column" field.
+func FromColumnGTE(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldGTE(FieldFromColumn, v))
+}
+
+// FromColumnLT applies the LT predicate on the "from_column" field.
+func FromColumnLT(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldLT(FieldFromColumn, v))
+}
+
+// FromColumnLTE applies the LTE predicate on the "from_column" field.
+func FromColumnLTE(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldLTE(FieldFromColumn, v))
+}
+
+// FromColumnContains applies the Contains predicate on the "from_column" field.
+func FromColumnContains(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldContains(FieldFromColumn, v))
+}
+
+// FromColumnHasPrefix applies the HasPrefix predicate on the "from_column" field.
+func FromColumnHasPrefix(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldHasPrefix(FieldFromColumn, v))
+}
+
+// FromColumnHasSuffix applies the HasSuffix predicate on the "from_column" field.
+func FromColumnHasSuffix(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldHasSuffix(FieldFromColumn, v))
+}
+
+// FromColumnEqualFold applies the EqualFold predicate on the "from_column" field.
+func FromColumnEqualFold(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldEqualFold(FieldFromColumn, v))
+}
+
+// FromColumnContainsFold applies the ContainsFold predicate on the "from_column" field.
+func FromColumnContainsFold(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldContainsFold(FieldFromColumn, v))
+}
+
+// ToColumnEQ applies the EQ predicate on the "to_column" field.
+func ToColumnEQ(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldToColumn, v))
+}
+
+// ToColumnNEQ applies the NEQ predicate on the "to_column" field.
+func ToColumnNEQ(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldNEQ(FieldToColumn, v))
+}
+
+// ToColumnIn applies the In predicate on the "to_column" field.
+func ToColumnIn(vs ...string) predicate.Transition {
+	return predicate.Transition(sql.FieldIn(FieldToColumn, vs...))
+}
+
+// ToColumnNotIn applies the NotIn predicate on the "to_column" field.
+func ToColumnNotIn(vs ...string) predicate.Transition {
+	return predicate.Transition(sql.FieldNotIn(FieldToColumn, vs...))
+}
+
+// ToColumnGT applies the GT predicate on the "to_column" field.
+func ToColumnGT(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldGT(FieldToColumn, v))
+}
+
+// ToColumnGTE applies the GTE predicate on the "to_column" field.
+func ToColumnGTE(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldGTE(FieldToColumn, v))
+}
+
+// ToColumnLT applies the LT predicate on the "to_column" field.
+func ToColumnLT(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldLT(FieldToColumn, v))
+}
+
+// ToColumnLTE applies the LTE predicate on the "to_column" field.
+func ToColumnLTE(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldLTE(FieldToColumn, v))
+}
+
+// ToColumnContains applies the Contains predicate on the "to_column" field.
+func ToColumnContains(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldContains(FieldToColumn, v))
+}
+
+// ToColumnHasPrefix applies the HasPrefix predicate on the "to_column" field.
+func ToColumnHasPrefix(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldHasPrefix(FieldToColumn, v))
+}
+
+// ToColumnHasSuffix applies the HasSuffix predicate on the "to_column" field.
+func ToColumnHasSuffix(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldHasSuffix(FieldToColumn, v))
+}
+
+// ToColumnEqualFold applies the EqualFold predicate on the "to_column" field.
+func ToColumnEqualFold(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldEqualFold(FieldToColumn, v))
+}
+
+// ToColumnContainsFold applies the ContainsFold predicate on the "to_column" field.
+func ToColumnContainsFold(v string) predicate.Transition {
+	return predicate.Transition(sql.FieldContainsFold(FieldToColumn, v))
+}
+
+// GuardsIsNil applies the IsNil predicate on the "guards" field.
+func GuardsIsNil() predicate.Transition {
+	return predicate.Transition(sql.FieldIsNull(FieldGuards))
+}
+
+// GuardsNotNil applies the NotNil predicate on the "guards" field.
+func GuardsNotNil() predicate.Transition {
+	return predicate.Transition(sql.FieldNotNull(FieldGuards))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Transition {
+	return predicate.Transition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Transition) predicate.Transition {
+	return predicate.Transition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Transition) predicate.Transition {
+	return predicate.Transition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Transition) predicate.Transition {
+	return predicate.Transition(sql.NotPredicates(p))
+}
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+)
+
+// TransitionCreate is the builder for creating a Transition entity.
+type TransitionCreate struct {
+	config
+	mutation *TransitionMutation
+	hooks    []Hook
+}
+
+// SetFromColumn sets the "from_column" field.
+func (_c *TransitionCreate) SetFromColumn(v string) *TransitionCreate {
+	_c.mutation.SetFromColumn(v)
+	return _c
+}
+
+// SetToColumn sets the "to_column" field.
+func (_c *TransitionCreate) SetToColumn(v string) *TransitionCreate {
+	_c.mutation.SetToColumn(v)
+	return _c
+}
+
+// SetGuards sets the "guards" field.
+func (_c *TransitionCreate) SetGuards(v []string) *TransitionCreate {
+	_c.mutation.SetGuards(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TransitionCreate) SetCreatedAt(v time.Time) *TransitionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TransitionCreate) SetNillableCreatedAt(v *time.Time) *TransitionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TransitionMutation object of the builder.
+func (_c *TransitionCreate) Mutation() *TransitionMutation {
+	return _c.mutation
+}
+
+// Save creates the Transition in the database.
+func (_c *TransitionCreate) Save(ctx context.Context) (*Transition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransitionCreate) SaveX(ctx context.Context) *Transition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransitionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransitionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransitionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := transition.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransitionCreate) check() error {
+	if _, ok := _c.mutation.FromColumn(); !ok {
+		return &ValidationError{Name: "from_column", err: errors.New(`ent: missing required field "Transition.from_column"`)}
+	}
+	if v, ok := _c.mutation.FromColumn(); ok {
+		if err := transition.FromColumnValidator(v); err != nil {
+			return &ValidationError{Name: "from_column", err: fmt.Errorf(`ent: validator failed for field "Transition.from_column": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToColumn(); !ok {
+		return &ValidationError{Name: "to_column", err: errors.New(`ent: missing required field "Transition.to_column"`)}
+	}
+	if v, ok := _c.mutation.ToColumn(); ok {
+		if err := transition.ToColumnValidator(v); err != nil {
+			return &ValidationError{Name: "to_column", err: fmt.Errorf(`ent: validator failed for field "Transition.to_column": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transition.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TransitionCreate) sqlSave(ctx context.Context) (*Transition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransitionCreate) createSpec() (*Transition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Transition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transition.Table, sqlgraph.NewFieldSpec(transition.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.FromColumn(); ok {
+		_spec.SetField(transition.FieldFromColumn, field.TypeString, value)
+		_node.FromColumn = value
+	}
+	if value, ok := _c.mutation.ToColumn(); ok {
+		_spec.SetField(transition.FieldToColumn, field.TypeString, value)
+		_node.ToColumn = value
+	}
+	if value, ok := _c.mutation.Guards(); ok {
+		_spec.SetField(transition.FieldGuards, field.TypeJSON, value)
+		_node.Guards = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TransitionCreateBulk is the builder for creating many Transition entities in bulk.
+type TransitionCreateBulk struct {
+	config
+	err      error
+	builders []*TransitionCreate
+}
+
+// Save creates the Transition entities in the database.
+func (_c *TransitionCreateBulk) Save(ctx context.Context) ([]*Transition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Transition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransitionCreateBulk) SaveX(ctx context.Context) []*Transition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransitionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
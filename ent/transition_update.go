@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+)
+
+// TransitionUpdate is the builder for updating Transition entities.
+type TransitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TransitionMutation
+}
+
+// Where appends a list predicates to the TransitionUpdate builder.
+func (_u *TransitionUpdate) Where(ps ...predicate.Transition) *TransitionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFromColumn sets the "from_column" field.
+func (_u *TransitionUpdate) SetFromColumn(v string) *TransitionUpdate {
+	_u.mutation.SetFromColumn(v)
+	return _u
+}
+
+// SetNillableFromColumn sets the "from_column" field if the given value is not nil.
+func (_u *TransitionUpdate) SetNillableFromColumn(v *string) *TransitionUpdate {
+	if v != nil {
+		_u.SetFromColumn(*v)
+	}
+	return _u
+}
+
+// SetToColumn sets the "to_column" field.
+func (_u *TransitionUpdate) SetToColumn(v string) *TransitionUpdate {
+	_u.mutation.SetToColumn(v)
+	return _u
+}
+
+// SetNillableToColumn sets the "to_column" field if the given value is not nil.
+func (_u *TransitionUpdate) SetNillableToColumn(v *string) *TransitionUpdate {
+	if v != nil {
+		_u.SetToColumn(*v)
+	}
+	return _u
+}
+
+// SetGuards sets the "guards" field.
+func (_u *TransitionUpdate) SetGuards(v []string) *TransitionUpdate {
+	_u.mutation.SetGuards(v)
+	return _u
+}
+
+// AppendGuards appends value to the "guards" field.
+func (_u *TransitionUpdate) AppendGuards(v []string) *TransitionUpdate {
+	_u.mutation.AppendGuards(v)
+	return _u
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (_u *TransitionUpdate) ClearGuards() *TransitionUpdate {
+	_u.mutation.ClearGuards()
+	return _u
+}
+
+// Mutation returns the TransitionMutation object of the builder.
+func (_u *TransitionUpdate) Mutation() *TransitionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransitionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransitionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransitionUpdate) check() error {
+	if v, ok := _u.mutation.FromColumn(); ok {
+		if err := transition.FromColumnValidator(v); err != nil {
+			return &ValidationError{Name: "from_column", err: fmt.Errorf(`ent: validator failed for field "Transition.from_column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToColumn(); ok {
+		if err := transition.ToColumnValidator(v); err != nil {
+			return &ValidationError{Name: "to_column", err: fmt.Errorf(`ent: validator failed for field "Transition.to_column": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TransitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transition.Table, transition.Columns, sqlgraph.NewFieldSpec(transition.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromColumn(); ok {
+		_spec.SetField(transition.FieldFromColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToColumn(); ok {
+		_spec.SetField(transition.FieldToColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Guards(); ok {
+		_spec.SetField(transition.FieldGuards, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGuards(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transition.FieldGuards, value)
+		})
+	}
+	if _u.mutation.GuardsCleared() {
+		_spec.ClearField(transition.FieldGuards, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransitionUpdateOne is the builder for updating a single Transition entity.
+type TransitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransitionMutation
+}
+
+// SetFromColumn sets the "from_column" field.
+func (_u *TransitionUpdateOne) SetFromColumn(v string) *TransitionUpdateOne {
+	_u.mutation.SetFromColumn(v)
+	return _u
+}
+
+// SetNillableFromColumn sets the "from_column" field if the given value is not nil.
+func (_u *TransitionUpdateOne) SetNillableFromColumn(v *string) *TransitionUpdateOne {
+	if v != nil {
+		_u.SetFromColumn(*v)
+	}
+	return _u
+}
+
+// SetToColumn sets the "to_column" field.
+func (_u *TransitionUpdateOne) SetToColumn(v string) *TransitionUpdateOne {
+	_u.mutation.SetToColumn(v)
+	return _u
+}
+
+// SetNillableToColumn sets the "to_column" field if the given value is not nil.
+func (_u *TransitionUpdateOne) SetNillableToColumn(v *string) *TransitionUpdateOne {
+	if v != nil {
+		_u.SetToColumn(*v)
+	}
+	return _u
+}
+
+// SetGuards sets the "guards" field.
+func (_u *TransitionUpdateOne) SetGuards(v []string) *TransitionUpdateOne {
+	_u.mutation.SetGuards(v)
+	return _u
+}
+
+// AppendGuards appends value to the "guards" field.
+func (_u *TransitionUpdateOne) AppendGuards(v []string) *TransitionUpdateOne {
+	_u.mutation.AppendGuards(v)
+	return _u
+}
+
+// ClearGuards clears the value of the "guards" field.
+func (_u *TransitionUpdateOne) ClearGuards() *TransitionUpdateOne {
+	_u.mutation.ClearGuards()
+	return _u
+}
+
+// Mutation returns the TransitionMutation object of the builder.
+func (_u *TransitionUpdateOne) Mutation() *TransitionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TransitionUpdate builder.
+func (_u *TransitionUpdateOne) Where(ps ...predicate.Transition) *TransitionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransitionUpdateOne) Select(field string, fields ...string) *TransitionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Transition entity.
+func (_u *TransitionUpdateOne) Save(ctx context.Context) (*Transition, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransitionUpdateOne) SaveX(ctx context.Context) *Transition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransitionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransitionUpdateOne) check() error {
+	if v, ok := _u.mutation.FromColumn(); ok {
+		if err := transition.FromColumnValidator(v); err != nil {
+			return &ValidationError{Name: "from_column", err: fmt.Errorf(`ent: validator failed for field "Transition.from_column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToColumn(); ok {
+		if err := transition.ToColumnValidator(v); err != nil {
+			return &ValidationError{Name: "to_column", err: fmt.Errorf(`ent: validator failed for field "Transition.to_column": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TransitionUpdateOne) sqlSave(ctx context.Context) (_node *Transition, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transition.Table, transition.Columns, sqlgraph.NewFieldSpec(transition.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Transition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transition.FieldID)
+		for _, f := range fields {
+			if !transition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromColumn(); ok {
+		_spec.SetField(transition.FieldFromColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToColumn(); ok {
+		_spec.SetField(transition.FieldToColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Guards(); ok {
+		_spec.SetField(transition.FieldGuards, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGuards(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transition.FieldGuards, value)
+		})
+	}
+	if _u.mutation.GuardsCleared() {
+		_spec.ClearField(transition.FieldGuards, field.TypeJSON)
+	}
+	_node = &Transition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AutomationRule is the client for interacting with the AutomationRule builders.
+	AutomationRule *AutomationRuleClient
+	// AutomationRun is the client for interacting with the AutomationRun builders.
+	AutomationRun *AutomationRunClient
+	// FieldDefinition is the client for interacting with the FieldDefinition builders.
+	FieldDefinition *FieldDefinitionClient
+	// RecurringTask is the client for interacting with the RecurringTask builders.
+	RecurringTask *RecurringTaskClient
+	// StaleThreshold is the client for interacting with the StaleThreshold builders.
+	StaleThreshold *StaleThresholdClient
+	// TagDefinition is the client for interacting with the TagDefinition builders.
+	TagDefinition *TagDefinitionClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskFieldValue is the client for interacting with the TaskFieldValue builders.
+	TaskFieldValue *TaskFieldValueClient
 	// TaskHistory is the client for interacting with the TaskHistory builders.
 	TaskHistory *TaskHistoryClient
+	// TaskRevision is the client for interacting with the TaskRevision builders.
+	TaskRevision *TaskRevisionClient
 	// TaskTag is the client for interacting with the TaskTag builders.
 	TaskTag *TaskTagClient
+	// TaskTemplate is the client for interacting with the TaskTemplate builders.
+	TaskTemplate *TaskTemplateClient
+	// Transition is the client for interacting with the Transition builders.
+	Transition *TransitionClient
+	// WipLimit is the client for interacting with the WipLimit builders.
+	WipLimit *WipLimitClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +171,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AutomationRule = NewAutomationRuleClient(tx.config)
+	tx.AutomationRun = NewAutomationRunClient(tx.config)
+	tx.FieldDefinition = NewFieldDefinitionClient(tx.config)
+	tx.RecurringTask = NewRecurringTaskClient(tx.config)
+	tx.StaleThreshold = NewStaleThresholdClient(tx.config)
+	tx.TagDefinition = NewTagDefinitionClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskFieldValue = NewTaskFieldValueClient(tx.config)
 	tx.TaskHistory = NewTaskHistoryClient(tx.config)
+	tx.TaskRevision = NewTaskRevisionClient(tx.config)
 	tx.TaskTag = NewTaskTagClient(tx.config)
+	tx.TaskTemplate = NewTaskTemplateClient(tx.config)
+	tx.Transition = NewTransitionClient(tx.config)
+	tx.WipLimit = NewWipLimitClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +194,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Task.QueryXXX(), the query will be executed
+// applies a query, for example: AutomationRule.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+// WipLimit is the model entity for the WipLimit schema.
+type WipLimit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Column holds the value of the "column" field.
+	Column string `json:"column,omitempty"`
+	// Assignee holds the value of the "assignee" field.
+	Assignee string `json:"assignee,omitempty"`
+	// MaxTasks holds the value of the "max_tasks" field.
+	MaxTasks int `json:"max_tasks,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode wiplimit.Mode `json:"mode,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WipLimit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wiplimit.FieldID, wiplimit.FieldMaxTasks:
+			values[i] = new(sql.NullInt64)
+		case wiplimit.FieldColumn, wiplimit.FieldAssignee, wiplimit.FieldMode:
+			values[i] = new(sql.NullString)
+		case wiplimit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WipLimit fields.
+func (_m *WipLimit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wiplimit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case wiplimit.FieldColumn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field column", values[i])
+			} else if value.Valid {
+				_m.Column = value.String
+			}
+		case wiplimit.FieldAssignee:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee", values[i])
+			} else if value.Valid {
+				_m.Assignee = value.String
+			}
+		case wiplimit.FieldMaxTasks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_tasks", values[i])
+			} else if value.Valid {
+				_m.MaxTasks = int(value.Int64)
+			}
+		case wiplimit.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = wiplimit.Mode(value.String)
+			}
+		case wiplimit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WipLimit.
+// This includes values selected through modifiers, order, etc.
+func (_m *WipLimit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WipLimit.
+// Note that you need to call WipLimit.Unwrap() before calling this method if this WipLimit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WipLimit) Update() *WipLimitUpdateOne {
+	return NewWipLimitClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WipLimit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WipLimit) Unwrap() *WipLimit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WipLimit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WipLimit) String() string {
+	var builder strings.Builder
+	builder.WriteString("WipLimit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("column=")
+	builder.WriteString(_m.Column)
+	builder.WriteString(", ")
+	builder.WriteString("assignee=")
+	builder.WriteString(_m.Assignee)
+	builder.WriteString(", ")
+	builder.WriteString("max_tasks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxTasks))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WipLimits is a parsable slice of WipLimit.
+type WipLimits []*WipLimit
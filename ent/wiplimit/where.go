@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wiplimit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLTE(FieldID, id))
+}
+
+// Column applies equality check predicate on the "column" field. It's identical to ColumnEQ.
+func Column(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldColumn, v))
+}
+
+// Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
+func Assignee(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldAssignee, v))
+}
+
+// MaxTasks applies equality check predicate on the "max_tasks" field. It's identical to MaxTasksEQ.
+func MaxTasks(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldMaxTasks, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ColumnEQ applies the EQ predicate on the "column" field.
+func ColumnEQ(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldColumn, v))
+}
+
+// ColumnNEQ applies the NEQ predicate on the "column" field.
+func ColumnNEQ(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNEQ(FieldColumn, v))
+}
+
+// ColumnIn applies the In predicate on the "column" field.
+func ColumnIn(vs ...string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldIn(FieldColumn, vs...))
+}
+
+// ColumnNotIn applies the NotIn predicate on the "column" field.
+func ColumnNotIn(vs ...string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNotIn(FieldColumn, vs...))
+}
+
+// ColumnGT applies the GT predicate on the "column" field.
+func ColumnGT(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGT(FieldColumn, v))
+}
+
+// ColumnGTE applies the GTE predicate on the "column" field.
+func ColumnGTE(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGTE(FieldColumn, v))
+}
+
+// ColumnLT applies the LT predicate on the "column" field.
+func ColumnLT(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLT(FieldColumn, v))
+}
+
+// ColumnLTE applies the LTE predicate on the "column" field.
+func ColumnLTE(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLTE(FieldColumn, v))
+}
+
+// ColumnContains applies the Contains predicate on the "column" field.
+func ColumnContains(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldContains(FieldColumn, v))
+}
+
+// ColumnHasPrefix applies the HasPrefix predicate on the "column" field.
+func ColumnHasPrefix(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldHasPrefix(FieldColumn, v))
+}
+
+// ColumnHasSuffix applies the HasSuffix predicate on the "column" field.
+func ColumnHasSuffix(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldHasSuffix(FieldColumn, v))
+}
+
+// ColumnEqualFold applies the EqualFold predicate on the "column" field.
+func ColumnEqualFold(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEqualFold(FieldColumn, v))
+}
+
+// ColumnContainsFold applies the ContainsFold predicate on the "column" field.
+func ColumnContainsFold(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldContainsFold(FieldColumn, v))
+}
+
+// AssigneeEQ applies the EQ predicate on the "assignee" field.
+func AssigneeEQ(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldAssignee, v))
+}
+
+// AssigneeNEQ applies the NEQ predicate on the "assignee" field.
+func AssigneeNEQ(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNEQ(FieldAssignee, v))
+}
+
+// AssigneeIn applies the In predicate on the "assignee" field.
+func AssigneeIn(vs ...string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldIn(FieldAssignee, vs...))
+}
+
+// AssigneeNotIn applies the NotIn predicate on the "assignee" field.
+func AssigneeNotIn(vs ...string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNotIn(FieldAssignee, vs...))
+}
+
+// AssigneeGT applies the GT predicate on the "assignee" field.
+func AssigneeGT(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGT(FieldAssignee, v))
+}
+
+// AssigneeGTE applies the GTE predicate on the "assignee" field.
+func AssigneeGTE(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGTE(FieldAssignee, v))
+}
+
+// AssigneeLT applies the LT predicate on the "assignee" field.
+func AssigneeLT(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLT(FieldAssignee, v))
+}
+
+// AssigneeLTE applies the LTE predicate on the "assignee" field.
+func AssigneeLTE(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLTE(FieldAssignee, v))
+}
+
+// AssigneeContains applies the Contains predicate on the "assignee" field.
+func AssigneeContains(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldContains(FieldAssignee, v))
+}
+
+// AssigneeHasPrefix applies the HasPrefix predicate on the "assignee" field.
+func AssigneeHasPrefix(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldHasPrefix(FieldAssignee, v))
+}
+
+// AssigneeHasSuffix applies the HasSuffix predicate on the "assignee" field.
+func AssigneeHasSuffix(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldHasSuffix(FieldAssignee, v))
+}
+
+// AssigneeEqualFold applies the EqualFold predicate on the "assignee" field.
+func AssigneeEqualFold(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEqualFold(FieldAssignee, v))
+}
+
+// AssigneeContainsFold applies the ContainsFold predicate on the "assignee" field.
+func AssigneeContainsFold(v string) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldContainsFold(FieldAssignee, v))
+}
+
+// MaxTasksEQ applies the EQ predicate on the "max_tasks" field.
+func MaxTasksEQ(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldMaxTasks, v))
+}
+
+// MaxTasksNEQ applies the NEQ predicate on the "max_tasks" field.
+func MaxTasksNEQ(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNEQ(FieldMaxTasks, v))
+}
+
+// MaxTasksIn applies the In predicate on the "max_tasks" field.
+func MaxTasksIn(vs ...int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldIn(FieldMaxTasks, vs...))
+}
+
+// MaxTasksNotIn applies the NotIn predicate on the "max_tasks" field.
+func MaxTasksNotIn(vs ...int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNotIn(FieldMaxTasks, vs...))
+}
+
+// MaxTasksGT applies the GT predicate on the "max_tasks" field.
+func MaxTasksGT(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGT(FieldMaxTasks, v))
+}
+
+// MaxTasksGTE applies the GTE predicate on the "max_tasks" field.
+func MaxTasksGTE(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGTE(FieldMaxTasks, v))
+}
+
+// MaxTasksLT applies the LT predicate on the "max_tasks" field.
+func MaxTasksLT(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLT(FieldMaxTasks, v))
+}
+
+// MaxTasksLTE applies the LTE predicate on the "max_tasks" field.
+func MaxTasksLTE(v int) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLTE(FieldMaxTasks, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WipLimit {
+	return predicate.WipLimit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WipLimit) predicate.WipLimit {
+	return predicate.WipLimit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WipLimit) predicate.WipLimit {
+	return predicate.WipLimit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WipLimit) predicate.WipLimit {
+	return predicate.WipLimit(sql.NotPredicates(p))
+}
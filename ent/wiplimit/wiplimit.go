@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wiplimit
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the wiplimit type in the database.
+	Label = "wip_limit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldColumn holds the string denoting the column field in the database.
+	FieldColumn = "column"
+	// FieldAssignee holds the string denoting the assignee field in the database.
+	FieldAssignee = "assignee"
+	// FieldMaxTasks holds the string denoting the max_tasks field in the database.
+	FieldMaxTasks = "max_tasks"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the wiplimit in the database.
+	Table = "wip_limits"
+)
+
+// Columns holds all SQL columns for wiplimit fields.
+var Columns = []string{
+	FieldID,
+	FieldColumn,
+	FieldAssignee,
+	FieldMaxTasks,
+	FieldMode,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ColumnValidator is a validator for the "column" field. It is called by the builders before save.
+	ColumnValidator func(string) error
+	// DefaultAssignee holds the default value on creation for the "assignee" field.
+	DefaultAssignee string
+	// MaxTasksValidator is a validator for the "max_tasks" field. It is called by the builders before save.
+	MaxTasksValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// ModeWarn is the default value of the Mode enum.
+const DefaultMode = ModeWarn
+
+// Mode values.
+const (
+	ModeWarn Mode = "warn"
+	ModeSoft Mode = "soft"
+	ModeHard Mode = "hard"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeWarn, ModeSoft, ModeHard:
+		return nil
+	default:
+		return fmt.Errorf("wiplimit: invalid enum value for mode field: %q", m)
+	}
+}
+
+// OrderOption defines the ordering options for the WipLimit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByColumn orders the results by the column field.
+func ByColumn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColumn, opts...).ToFunc()
+}
+
+// ByAssignee orders the results by the assignee field.
+func ByAssignee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignee, opts...).ToFunc()
+}
+
+// ByMaxTasks orders the results by the max_tasks field.
+func ByMaxTasks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxTasks, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+// WipLimitCreate is the builder for creating a WipLimit entity.
+type WipLimitCreate struct {
+	config
+	mutation *WipLimitMutation
+	hooks    []Hook
+}
+
+// SetColumn sets the "column" field.
+func (_c *WipLimitCreate) SetColumn(v string) *WipLimitCreate {
+	_c.mutation.SetColumn(v)
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *WipLimitCreate) SetAssignee(v string) *WipLimitCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *WipLimitCreate) SetNillableAssignee(v *string) *WipLimitCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetMaxTasks sets the "max_tasks" field.
+func (_c *WipLimitCreate) SetMaxTasks(v int) *WipLimitCreate {
+	_c.mutation.SetMaxTasks(v)
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *WipLimitCreate) SetMode(v wiplimit.Mode) *WipLimitCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *WipLimitCreate) SetNillableMode(v *wiplimit.Mode) *WipLimitCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WipLimitCreate) SetCreatedAt(v time.Time) *WipLimitCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WipLimitCreate) SetNillableCreatedAt(v *time.Time) *WipLimitCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WipLimitMutation object of the builder.
+func (_c *WipLimitCreate) Mutation() *WipLimitMutation {
+	return _c.mutation
+}
+
+// Save creates the WipLimit in the database.
+func (_c *WipLimitCreate) Save(ctx context.Context) (*WipLimit, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WipLimitCreate) SaveX(ctx context.Context) *WipLimit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WipLimitCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WipLimitCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WipLimitCreate) defaults() {
+	if _, ok := _c.mutation.Assignee(); !ok {
+		v := wiplimit.DefaultAssignee
+		_c.mutation.SetAssignee(v)
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := wiplimit.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wiplimit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WipLimitCreate) check() error {
+	if _, ok := _c.mutation.Column(); !ok {
+		return &ValidationError{Name: "column", err: errors.New(`ent: missing required field "WipLimit.column"`)}
+	}
+	if v, ok := _c.mutation.Column(); ok {
+		if err := wiplimit.ColumnValidator(v); err != nil {
+			return &ValidationError{Name: "column", err: fmt.Errorf(`ent: validator failed for field "WipLimit.column": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Assignee(); !ok {
+		return &ValidationError{Name: "assignee", err: errors.New(`ent: missing required field "WipLimit.assignee"`)}
+	}
+	if _, ok := _c.mutation.MaxTasks(); !ok {
+		return &ValidationError{Name: "max_tasks", err: errors.New(`ent: missing required field "WipLimit.max_tasks"`)}
+	}
+	if v, ok := _c.mutation.MaxTasks(); ok {
+		if err := wiplimit.MaxTasksValidator(v); err != nil {
+			return &ValidationError{Name: "max_tasks", err: fmt.Errorf(`ent: validator failed for field "WipLimit.max_tasks": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "WipLimit.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := wiplimit.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "WipLimit.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WipLimit.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *WipLimitCreate) sqlSave(ctx context.Context) (*WipLimit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WipLimitCreate) createSpec() (*WipLimit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WipLimit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wiplimit.Table, sqlgraph.NewFieldSpec(wiplimit.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Column(); ok {
+		_spec.SetField(wiplimit.FieldColumn, field.TypeString, value)
+		_node.Column = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(wiplimit.FieldAssignee, field.TypeString, value)
+		_node.Assignee = value
+	}
+	if value, ok := _c.mutation.MaxTasks(); ok {
+		_spec.SetField(wiplimit.FieldMaxTasks, field.TypeInt, value)
+		_node.MaxTasks = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(wiplimit.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wiplimit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WipLimitCreateBulk is the builder for creating many WipLimit entities in bulk.
+type WipLimitCreateBulk struct {
+	config
+	err      error
+	builders []*WipLimitCreate
+}
+
+// Save creates the WipLimit entities in the database.
+func (_c *WipLimitCreateBulk) Save(ctx context.Context) ([]*WipLimit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WipLimit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WipLimitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WipLimitCreateBulk) SaveX(ctx context.Context) []*WipLimit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WipLimitCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WipLimitCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+// WipLimitUpdate is the builder for updating WipLimit entities.
+type WipLimitUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WipLimitMutation
+}
+
+// Where appends a list predicates to the WipLimitUpdate builder.
+func (_u *WipLimitUpdate) Where(ps ...predicate.WipLimit) *WipLimitUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetColumn sets the "column" field.
+func (_u *WipLimitUpdate) SetColumn(v string) *WipLimitUpdate {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *WipLimitUpdate) SetNillableColumn(v *string) *WipLimitUpdate {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *WipLimitUpdate) SetAssignee(v string) *WipLimitUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *WipLimitUpdate) SetNillableAssignee(v *string) *WipLimitUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// SetMaxTasks sets the "max_tasks" field.
+func (_u *WipLimitUpdate) SetMaxTasks(v int) *WipLimitUpdate {
+	_u.mutation.ResetMaxTasks()
+	_u.mutation.SetMaxTasks(v)
+	return _u
+}
+
+// SetNillableMaxTasks sets the "max_tasks" field if the given value is not nil.
+func (_u *WipLimitUpdate) SetNillableMaxTasks(v *int) *WipLimitUpdate {
+	if v != nil {
+		_u.SetMaxTasks(*v)
+	}
+	return _u
+}
+
+// AddMaxTasks adds value to the "max_tasks" field.
+func (_u *WipLimitUpdate) AddMaxTasks(v int) *WipLimitUpdate {
+	_u.mutation.AddMaxTasks(v)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *WipLimitUpdate) SetMode(v wiplimit.Mode) *WipLimitUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *WipLimitUpdate) SetNillableMode(v *wiplimit.Mode) *WipLimitUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WipLimitMutation object of the builder.
+func (_u *WipLimitUpdate) Mutation() *WipLimitMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WipLimitUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WipLimitUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WipLimitUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WipLimitUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WipLimitUpdate) check() error {
+	if v, ok := _u.mutation.Column(); ok {
+		if err := wiplimit.ColumnValidator(v); err != nil {
+			return &ValidationError{Name: "column", err: fmt.Errorf(`ent: validator failed for field "WipLimit.column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxTasks(); ok {
+		if err := wiplimit.MaxTasksValidator(v); err != nil {
+			return &ValidationError{Name: "max_tasks", err: fmt.Errorf(`ent: validator failed for field "WipLimit.max_tasks": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := wiplimit.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "WipLimit.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WipLimitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wiplimit.Table, wiplimit.Columns, sqlgraph.NewFieldSpec(wiplimit.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(wiplimit.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(wiplimit.FieldAssignee, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxTasks(); ok {
+		_spec.SetField(wiplimit.FieldMaxTasks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTasks(); ok {
+		_spec.AddField(wiplimit.FieldMaxTasks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(wiplimit.FieldMode, field.TypeEnum, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wiplimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WipLimitUpdateOne is the builder for updating a single WipLimit entity.
+type WipLimitUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WipLimitMutation
+}
+
+// SetColumn sets the "column" field.
+func (_u *WipLimitUpdateOne) SetColumn(v string) *WipLimitUpdateOne {
+	_u.mutation.SetColumn(v)
+	return _u
+}
+
+// SetNillableColumn sets the "column" field if the given value is not nil.
+func (_u *WipLimitUpdateOne) SetNillableColumn(v *string) *WipLimitUpdateOne {
+	if v != nil {
+		_u.SetColumn(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *WipLimitUpdateOne) SetAssignee(v string) *WipLimitUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *WipLimitUpdateOne) SetNillableAssignee(v *string) *WipLimitUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// SetMaxTasks sets the "max_tasks" field.
+func (_u *WipLimitUpdateOne) SetMaxTasks(v int) *WipLimitUpdateOne {
+	_u.mutation.ResetMaxTasks()
+	_u.mutation.SetMaxTasks(v)
+	return _u
+}
+
+// SetNillableMaxTasks sets the "max_tasks" field if the given value is not nil.
+func (_u *WipLimitUpdateOne) SetNillableMaxTasks(v *int) *WipLimitUpdateOne {
+	if v != nil {
+		_u.SetMaxTasks(*v)
+	}
+	return _u
+}
+
+// AddMaxTasks adds value to the "max_tasks" field.
+func (_u *WipLimitUpdateOne) AddMaxTasks(v int) *WipLimitUpdateOne {
+	_u.mutation.AddMaxTasks(v)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *WipLimitUpdateOne) SetMode(v wiplimit.Mode) *WipLimitUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *WipLimitUpdateOne) SetNillableMode(v *wiplimit.Mode) *WipLimitUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WipLimitMutation object of the builder.
+func (_u *WipLimitUpdateOne) Mutation() *WipLimitMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WipLimitUpdate builder.
+func (_u *WipLimitUpdateOne) Where(ps ...predicate.WipLimit) *WipLimitUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WipLimitUpdateOne) Select(field string, fields ...string) *WipLimitUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WipLimit entity.
+func (_u *WipLimitUpdateOne) Save(ctx context.Context) (*WipLimit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WipLimitUpdateOne) SaveX(ctx context.Context) *WipLimit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WipLimitUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WipLimitUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WipLimitUpdateOne) check() error {
+	if v, ok := _u.mutation.Column(); ok {
+		if err := wiplimit.ColumnValidator(v); err != nil {
+			return &ValidationError{Name: "column", err: fmt.Errorf(`ent: validator failed for field "WipLimit.column": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxTasks(); ok {
+		if err := wiplimit.MaxTasksValidator(v); err != nil {
+			return &ValidationError{Name: "max_tasks", err: fmt.Errorf(`ent: validator failed for field "WipLimit.max_tasks": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := wiplimit.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "WipLimit.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WipLimitUpdateOne) sqlSave(ctx context.Context) (_node *WipLimit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wiplimit.Table, wiplimit.Columns, sqlgraph.NewFieldSpec(wiplimit.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WipLimit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wiplimit.FieldID)
+		for _, f := range fields {
+			if !wiplimit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wiplimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Column(); ok {
+		_spec.SetField(wiplimit.FieldColumn, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(wiplimit.FieldAssignee, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxTasks(); ok {
+		_spec.SetField(wiplimit.FieldMaxTasks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxTasks(); ok {
+		_spec.AddField(wiplimit.FieldMaxTasks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(wiplimit.FieldMode, field.TypeEnum, value)
+	}
+	_node = &WipLimit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wiplimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
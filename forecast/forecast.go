@@ -0,0 +1,144 @@
+// Package forecast predicts delivery by Monte Carlo simulation: each trial
+// replays days drawn at random from the team's historical daily throughput.
+package forecast
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"time"
+)
+
+// Confidences are the levels a forecast reports, from least to most certain.
+var Confidences = []int{50, 70, 85, 95}
+
+// MaxDays caps how far a "when" trial runs before giving up.
+const MaxDays = 5 * 365
+
+// ErrNoThroughput means the history has no completions to sample from.
+var ErrNoThroughput = errors.New("no completed tasks in the history window")
+
+// Level is a forecast at one confidence: at least Value items by the date,
+// or done within Value days.
+type Level struct {
+	Confidence int
+	Value      int
+}
+
+// Bucket is how many trials ended with a value.
+type Bucket struct {
+	Value  int
+	Trials int
+}
+
+// Forecast is the outcome of a simulation.
+type Forecast struct {
+	Trials       int
+	Levels       []Level  // one per confidence, in order
+	Distribution []Bucket // by value, ascending
+}
+
+// Daily counts the completions on each day from since up to until, including
+// days with none. It is the sample the simulation draws from.
+func Daily(completed []time.Time, since, until time.Time) []int {
+	start := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, since.Location())
+	var days []int
+	for day := start; day.Before(until); day = day.AddDate(0, 0, 1) {
+		days = append(days, 0)
+	}
+	for _, t := range completed {
+		if t.Before(start) || !t.Before(until) {
+			continue
+		}
+		// Count calendar days so daylight saving changes don't shift buckets
+		i := int(math.Round(float64(dayStart(t).Sub(start)) / float64(24*time.Hour)))
+		if i >= 0 && i < len(days) {
+			days[i]++
+		}
+	}
+	return days
+}
+
+func dayStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// HowMany forecasts how many items will be completed in the next days. Each
+// level is the count reached in at least that share of trials.
+func HowMany(daily []int, days, trials int, seed uint64) (Forecast, error) {
+	if err := check(daily); err != nil {
+		return Forecast{}, err
+	}
+	rng := newRand(seed)
+	outcomes := make([]int, trials)
+	for i := range outcomes {
+		for range days {
+			outcomes[i] += daily[rng.IntN(len(daily))]
+		}
+	}
+	sort.Ints(outcomes)
+
+	f := Forecast{Trials: trials, Distribution: distribution(outcomes)}
+	for _, c := range Confidences {
+		// The count that c% of trials reached or beat
+		f.Levels = append(f.Levels, Level{Confidence: c, Value: outcomes[rank(100-c, trials)]})
+	}
+	return f, nil
+}
+
+// When forecasts how many days it takes to complete items. Each level is the
+// number of days that at least that share of trials finished within.
+func When(daily []int, items, trials int, seed uint64) (Forecast, error) {
+	if err := check(daily); err != nil {
+		return Forecast{}, err
+	}
+	rng := newRand(seed)
+	outcomes := make([]int, trials)
+	for i := range outcomes {
+		done, day := 0, 0
+		for done < items && day < MaxDays {
+			done += daily[rng.IntN(len(daily))]
+			day++
+		}
+		outcomes[i] = day
+	}
+	sort.Ints(outcomes)
+
+	f := Forecast{Trials: trials, Distribution: distribution(outcomes)}
+	for _, c := range Confidences {
+		f.Levels = append(f.Levels, Level{Confidence: c, Value: outcomes[rank(c, trials)]})
+	}
+	return f, nil
+}
+
+func check(daily []int) error {
+	for _, n := range daily {
+		if n > 0 {
+			return nil
+		}
+	}
+	return ErrNoThroughput
+}
+
+func newRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// rank is the index of the nearest-rank p-th percentile of n sorted values.
+func rank(p, n int) int {
+	r := int(math.Ceil(float64(p) / 100 * float64(n)))
+	return max(1, min(r, n)) - 1
+}
+
+func distribution(sorted []int) []Bucket {
+	var buckets []Bucket
+	for _, v := range sorted {
+		if n := len(buckets); n > 0 && buckets[n-1].Value == v {
+			buckets[n-1].Trials++
+			continue
+		}
+		buckets = append(buckets, Bucket{Value: v, Trials: 1})
+	}
+	return buckets
+}
@@ -0,0 +1,96 @@
+package forecast
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var day0 = time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+func TestDaily(t *testing.T) {
+	completed := []time.Time{
+		day0.Add(9 * time.Hour),
+		day0.Add(17 * time.Hour),
+		day0.AddDate(0, 0, 2).Add(time.Hour),
+		day0.AddDate(0, 0, -1),        // before the window
+		day0.AddDate(0, 0, 4),         // at until, excluded
+		day0.AddDate(0, 0, 4).Add(-1), // last moment of the window
+	}
+	got := Daily(completed, day0.Add(8*time.Hour), day0.AddDate(0, 0, 4))
+	if want := []int{2, 0, 1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Daily = %v, want %v", got, want)
+	}
+}
+
+func TestHowManyConstant(t *testing.T) {
+	f, err := HowMany([]int{2, 2, 2}, 10, 100, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range f.Levels {
+		if l.Value != 20 {
+			t.Errorf("%d%%: %d items, want 20", l.Confidence, l.Value)
+		}
+	}
+	if want := []Bucket{{Value: 20, Trials: 100}}; !reflect.DeepEqual(f.Distribution, want) {
+		t.Errorf("Distribution = %v, want %v", f.Distribution, want)
+	}
+}
+
+func TestWhenConstant(t *testing.T) {
+	f, err := When([]int{3}, 10, 50, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range f.Levels {
+		if l.Value != 4 {
+			t.Errorf("%d%%: %d days, want 4", l.Confidence, l.Value)
+		}
+	}
+}
+
+func TestConfidenceOrder(t *testing.T) {
+	daily := []int{0, 1, 0, 3, 2, 0, 1}
+	many, err := HowMany(daily, 14, 2000, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	when, err := When(daily, 20, 2000, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(Confidences); i++ {
+		// More confidence promises fewer items and takes longer
+		if many.Levels[i].Value > many.Levels[i-1].Value {
+			t.Errorf("HowMany levels not decreasing: %v", many.Levels)
+		}
+		if when.Levels[i].Value < when.Levels[i-1].Value {
+			t.Errorf("When levels not increasing: %v", when.Levels)
+		}
+	}
+}
+
+func TestSeedReproducible(t *testing.T) {
+	daily := []int{0, 1, 0, 3, 2, 0, 1}
+	a, _ := When(daily, 15, 500, 42)
+	b, _ := When(daily, 15, 500, 42)
+	if !reflect.DeepEqual(a, b) {
+		t.Error("same seed gave different forecasts")
+	}
+	c, _ := HowMany(daily, 30, 500, 42)
+	d, _ := HowMany(daily, 30, 500, 43)
+	if reflect.DeepEqual(c.Distribution, d.Distribution) {
+		t.Error("different seeds gave identical distributions")
+	}
+}
+
+func TestNoThroughput(t *testing.T) {
+	if _, err := HowMany([]int{0, 0}, 5, 10, 1); !errors.Is(err, ErrNoThroughput) {
+		t.Errorf("HowMany err = %v", err)
+	}
+	if _, err := When(nil, 5, 10, 1); !errors.Is(err, ErrNoThroughput) {
+		t.Errorf("When err = %v", err)
+	}
+}
@@ -5,6 +5,7 @@ go 1.25.3
 require (
 	entgo.io/ent v0.14.5
 	github.com/a-h/templ v0.3.977
+	github.com/go-rod/rod v0.116.2
 	github.com/starfederation/datastar-go v1.0.3
 	modernc.org/sqlite v1.44.3
 )
@@ -23,7 +24,6 @@ require (
 	github.com/fatih/color v1.16.0 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
 	github.com/go-openapi/inflect v0.19.0 // indirect
-	github.com/go-rod/rod v0.116.2 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/hcl/v2 v2.18.1 // indirect
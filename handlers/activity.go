@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// activityPageSize is how many history entries are loaded per page of the feed.
+const activityPageSize = 30
+
+// activityFilter narrows the activity feed. Zero values mean "no filter".
+type activityFilter struct {
+	Actor  string
+	Action string
+	TaskID int
+	Since  time.Time
+	Until  time.Time
+	Before int // cursor: only entries with a smaller ID
+}
+
+// parseActivityFilter reads filters from query parameters. Dates use the
+// YYYY-MM-DD format of <input type="date">; "until" includes the whole day.
+func parseActivityFilter(q url.Values) activityFilter {
+	filter := activityFilter{
+		Actor:  strings.TrimSpace(q.Get("actor")),
+		Action: strings.TrimSpace(q.Get("action")),
+	}
+	filter.TaskID, _ = strconv.Atoi(q.Get("task"))
+	filter.Before, _ = strconv.Atoi(q.Get("cursor"))
+	if since, err := time.ParseInLocation("2006-01-02", q.Get("since"), time.Local); err == nil {
+		filter.Since = since
+	}
+	if until, err := time.ParseInLocation("2006-01-02", q.Get("until"), time.Local); err == nil {
+		filter.Until = until.AddDate(0, 0, 1)
+	}
+	return filter
+}
+
+// values encodes the filter (without the cursor) back into query parameters.
+func (f activityFilter) values() url.Values {
+	q := url.Values{}
+	if f.Actor != "" {
+		q.Set("actor", f.Actor)
+	}
+	if f.Action != "" {
+		q.Set("action", f.Action)
+	}
+	if f.TaskID != 0 {
+		q.Set("task", strconv.Itoa(f.TaskID))
+	}
+	if !f.Since.IsZero() {
+		q.Set("since", f.Since.Format("2006-01-02"))
+	}
+	if !f.Until.IsZero() {
+		q.Set("until", f.Until.AddDate(0, 0, -1).Format("2006-01-02"))
+	}
+	return q
+}
+
+// predicates converts the filter into ent predicates.
+func (f activityFilter) predicates() []predicate.TaskHistory {
+	var preds []predicate.TaskHistory
+	if f.Actor != "" {
+		preds = append(preds, taskhistory.ActorEQ(f.Actor))
+	}
+	if f.Action != "" {
+		preds = append(preds, taskhistory.ActionEQ(f.Action))
+	}
+	if f.TaskID != 0 {
+		preds = append(preds, taskhistory.HasTaskWith(task.IDEQ(f.TaskID)))
+	}
+	if !f.Since.IsZero() {
+		preds = append(preds, taskhistory.CreatedAtGTE(f.Since))
+	}
+	if !f.Until.IsZero() {
+		preds = append(preds, taskhistory.CreatedAtLT(f.Until))
+	}
+	if f.Before != 0 {
+		preds = append(preds, taskhistory.IDLT(f.Before))
+	}
+	return preds
+}
+
+// queryActivity loads one page of history, newest first. It returns the cursor
+// for the next page, or 0 when there are no older entries.
+func queryActivity(ctx context.Context, client *ent.Client, filter activityFilter) ([]*ent.TaskHistory, int, error) {
+	entries, err := client.TaskHistory.Query().
+		Where(filter.predicates()...).
+		WithTask().
+		Order(ent.Desc(taskhistory.FieldID)).
+		Limit(activityPageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(entries) <= activityPageSize {
+		return entries, 0, nil
+	}
+	entries = entries[:activityPageSize]
+	return entries, entries[len(entries)-1].ID, nil
+}
+
+// groupActivity collapses consecutive reorders by the same actor into a single
+// feed line ("peter reordered 5 tasks"). Everything else stays one per line.
+func groupActivity(entries []*ent.TaskHistory) []fragments.ActivityGroup {
+	groups := make([]fragments.ActivityGroup, 0, len(entries))
+	for _, entry := range entries {
+		if n := len(groups); n > 0 && entry.Action == "reordered" {
+			last := &groups[n-1]
+			if last.First().Action == "reordered" && last.First().Actor == entry.Actor {
+				last.Entries = append(last.Entries, entry)
+				continue
+			}
+		}
+		groups = append(groups, fragments.ActivityGroup{Entries: []*ent.TaskHistory{entry}})
+	}
+	return groups
+}
+
+// activityNextURL builds the infinite scroll URL for the page after cursor.
+func activityNextURL(filter activityFilter, cursor int) string {
+	if cursor == 0 {
+		return ""
+	}
+	q := filter.values()
+	q.Set("cursor", strconv.Itoa(cursor))
+	return "/datastar/activity?" + q.Encode()
+}
+
+// ActivityPageHandler renders the full-page activity view with filters.
+func (s *Server) ActivityPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseActivityFilter(r.URL.Query())
+	filter.Before = 0
+
+	entries, cursor, err := queryActivity(ctx, s.Client, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get activity", "error", err)
+		http.Error(w, "Failed to load activity", http.StatusInternalServerError)
+		return
+	}
+
+	actors, err := s.Client.TaskHistory.Query().
+		Unique(true).
+		Select(taskhistory.FieldActor).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get activity actors", "error", err)
+		http.Error(w, "Failed to load activity", http.StatusInternalServerError)
+		return
+	}
+	actions, err := s.Client.TaskHistory.Query().
+		Unique(true).
+		Select(taskhistory.FieldAction).
+		Strings(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get activity actions", "error", err)
+		http.Error(w, "Failed to load activity", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.ActivityContent(groupActivity(entries), filter.values(), activityNextURL(filter, cursor), actors, actions)
+	page := templates.Layout("Activity - Bot Task Tracker", pages.ActivityMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// ActivityMoreHandler appends the next page of activity for infinite scroll.
+func (s *Server) ActivityMoreHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseActivityFilter(r.URL.Query())
+
+	sse := datastar.NewSSE(w, r)
+
+	entries, cursor, err := queryActivity(ctx, s.Client, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get activity page", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	var htmlBuilder strings.Builder
+	for _, group := range groupActivity(entries) {
+		// Index 1 so every appended item gets a connecting line above it
+		if err := fragments.ActivityGroupItem(group, 1).Render(ctx, &htmlBuilder); err != nil {
+			slog.ErrorContext(ctx, "failed to render activity item", "error", err)
+			_ = sse.ConsoleError(err)
+			return
+		}
+	}
+	if htmlBuilder.Len() > 0 {
+		_ = sse.PatchElements(htmlBuilder.String(),
+			datastar.WithModeAppend(),
+			datastar.WithSelector("#activity-timeline"))
+	}
+
+	// Replace the scroll sentinel with one for the following page (or nothing)
+	var sentinel strings.Builder
+	if err := fragments.ActivityMore(activityNextURL(filter, cursor)).Render(ctx, &sentinel); err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(sentinel.String())
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/metrics"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// Work starts when a task enters startColumn and is finished in doneColumn.
+const (
+	startColumn = "in_progress"
+	doneColumn  = "done"
+)
+
+// analyticsWeeks is the default reporting range, ending this week.
+const analyticsWeeks = 12
+
+// analyticsFilter narrows the flow metrics. Since and Until bound the
+// completion date; zero values of the others mean "no filter".
+type analyticsFilter struct {
+	Assignee string
+	Tag      string // "key:value"
+	Since    time.Time
+	Until    time.Time // exclusive
+}
+
+// parseAnalyticsFilter reads filters from query parameters. Dates use the
+// YYYY-MM-DD format of <input type="date">; "until" includes the whole day.
+// Without dates the range is the last twelve weeks.
+func parseAnalyticsFilter(q url.Values, now time.Time) analyticsFilter {
+	filter := analyticsFilter{
+		Assignee: strings.TrimSpace(q.Get("assignee")),
+		Tag:      strings.TrimSpace(q.Get("tag")),
+		Since:    metrics.WeekStart(now).AddDate(0, 0, -7*(analyticsWeeks-1)),
+		Until:    time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location()),
+	}
+	if since, err := time.ParseInLocation(dueLayout, q.Get("since"), time.Local); err == nil {
+		filter.Since = since
+	}
+	if until, err := time.ParseInLocation(dueLayout, q.Get("until"), time.Local); err == nil {
+		filter.Until = until.AddDate(0, 0, 1)
+	}
+	return filter
+}
+
+// values encodes the filter back into query parameters.
+func (f analyticsFilter) values() url.Values {
+	q := url.Values{}
+	if f.Assignee != "" {
+		q.Set("assignee", f.Assignee)
+	}
+	if f.Tag != "" {
+		q.Set("tag", f.Tag)
+	}
+	q.Set("since", f.Since.Format(dueLayout))
+	q.Set("until", f.Until.AddDate(0, 0, -1).Format(dueLayout))
+	return q
+}
+
+// flowTask rebuilds where a task loaded with its history has been, and when
+// it was archived or trashed.
+func flowTask(t *ent.Task) metrics.Task {
+	entries := append([]*ent.TaskHistory(nil), t.Edges.History...)
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].CreatedAt.Equal(entries[j].CreatedAt) {
+			return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	replay := make([]metrics.Entry, len(entries))
+	for i, e := range entries {
+		replay[i] = metrics.Entry{At: e.CreatedAt, Action: e.Action, Details: e.Details, Changes: e.Changes}
+	}
+	return metrics.Task{
+		ID:       t.ID,
+		Title:    t.Title,
+		Assignee: t.Assignee,
+		Timeline: metrics.Build(t.CreatedAt, t.Column, replay),
+		States:   metrics.BuildStates(replay),
+	}
+}
+
+// loadFlowTasks returns the history of every task, archived ones included,
+// narrowed by assignee and tag. Tasks in the trash are left out unless trashed
+// is set; replaying the board needs them because they were once on it.
+func loadFlowTasks(ctx context.Context, client *ent.Client, assignee, tag string, trashed bool) ([]metrics.Task, error) {
+	query := client.Task.Query().WithHistory()
+	if !trashed {
+		query = query.Where(task.DeletedAtIsNil())
+	}
+	if assignee != "" {
+		query = query.Where(task.AssigneeEQ(assignee))
+	}
+	if key, value, ok := strings.Cut(tag, ":"); ok {
+		query = query.Where(task.HasTagsWith(tasktag.KeyEQ(key), tasktag.ValueEQ(value)))
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]metrics.Task, len(rows))
+	for i, t := range rows {
+		tasks[i] = flowTask(t)
+	}
+	return tasks, nil
+}
+
+// flowReport computes the metrics for a filter.
+func (s *Server) flowReport(ctx context.Context, filter analyticsFilter) (metrics.Report, error) {
+	tasks, err := loadFlowTasks(ctx, s.Client, filter.Assignee, filter.Tag, false)
+	if err != nil {
+		return metrics.Report{}, err
+	}
+	return metrics.Analyze(tasks, metrics.Options{
+		Start: startColumn,
+		Done:  doneColumn,
+		Since: filter.Since,
+		Until: filter.Until,
+	}), nil
+}
+
+// AnalyticsPageHandler shows lead time, cycle time, time per column and
+// weekly throughput for the tasks completed in a date range.
+func (s *Server) AnalyticsPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseAnalyticsFilter(r.URL.Query(), time.Now())
+
+	report, err := s.flowReport(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute analytics", "error", err)
+		http.Error(w, "Failed to load analytics", http.StatusInternalServerError)
+		return
+	}
+	flow, err := s.cumulativeFlow(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute cumulative flow", "error", err)
+		http.Error(w, "Failed to load analytics", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.AnalyticsContent(report, flow, filter.values(), boardColumns, defaultAssignees)
+	page := templates.Layout("Analytics - Bot Task Tracker", pages.AnalyticsMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// apiSummary is a percentile summary in hours.
+type apiSummary struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50_hours"`
+	P85   float64 `json:"p85_hours"`
+	P95   float64 `json:"p95_hours"`
+}
+
+func toAPISummary(s metrics.Summary) apiSummary {
+	return apiSummary{Count: s.Count, P50: hours(s.P50), P85: hours(s.P85), P95: hours(s.P95)}
+}
+
+// hours converts a duration to hours, rounded to two decimals.
+func hours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100
+}
+
+// apiWeek is a week's completion count.
+type apiWeek struct {
+	Week  string `json:"week"`
+	Count int    `json:"count"`
+}
+
+func toAPIWeeks(weeks []metrics.Week) []apiWeek {
+	out := make([]apiWeek, len(weeks))
+	for i, week := range weeks {
+		out[i] = apiWeek{Week: week.Start.Format(dueLayout), Count: week.Count}
+	}
+	return out
+}
+
+// apiFlow is one completed task's timings in hours.
+type apiFlow struct {
+	ID          int                `json:"id"`
+	Title       string             `json:"title"`
+	Assignee    string             `json:"assignee"`
+	CompletedAt time.Time          `json:"completed_at"`
+	LeadTime    float64            `json:"lead_time_hours"`
+	CycleTime   *float64           `json:"cycle_time_hours"` // null when the task skipped in_progress
+	Columns     map[string]float64 `json:"column_hours"`
+}
+
+// APIAnalyticsHandler returns the analytics page's numbers, with the same
+// assignee, tag, since and until filters. Durations are in hours.
+func (s *Server) APIAnalyticsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseAnalyticsFilter(r.URL.Query(), time.Now())
+
+	report, err := s.flowReport(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute analytics", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to compute analytics")
+		return
+	}
+
+	tasks := make([]apiFlow, len(report.Tasks))
+	for i, f := range report.Tasks {
+		columns := make(map[string]float64, len(f.InColumn))
+		for column, d := range f.InColumn {
+			columns[column] = hours(d)
+		}
+		tasks[i] = apiFlow{
+			ID:          f.ID,
+			Title:       f.Title,
+			Assignee:    f.Assignee,
+			CompletedAt: f.Completed,
+			LeadTime:    hours(f.Lead),
+			Columns:     columns,
+		}
+		if f.Started {
+			cycle := hours(f.Cycle)
+			tasks[i].CycleTime = &cycle
+		}
+	}
+	inColumn := make(map[string]apiSummary, len(report.InColumn))
+	for column, summary := range report.InColumn {
+		inColumn[column] = toAPISummary(summary)
+	}
+	q := filter.values()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"since":      q.Get("since"),
+		"until":      q.Get("until"),
+		"lead_time":  toAPISummary(report.Lead),
+		"cycle_time": toAPISummary(report.Cycle),
+		"columns":    inColumn,
+		"throughput": toAPIWeeks(report.Throughput),
+		"tasks":      tasks,
+	})
+}
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/customfield"
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/stale"
+)
+
+// apiTask is the JSON representation of a task.
+type apiTask struct {
+	ID              int               `json:"id"`
+	Title           string            `json:"title"`
+	Description     string            `json:"description"`
+	Column          string            `json:"column"`
+	Position        int               `json:"position"`
+	Assignee        string            `json:"assignee"`
+	Priority        string            `json:"priority"`
+	DueAt           *time.Time        `json:"due_at,omitempty"`
+	Tags            []string          `json:"tags"`
+	Fields          map[string]string `json:"fields"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	ColumnEnteredAt *time.Time        `json:"column_entered_at,omitempty"`
+}
+
+func toAPITask(t *ent.Task) apiTask {
+	return apiTask{
+		ID:              t.ID,
+		Title:           t.Title,
+		Description:     t.Description,
+		Column:          t.Column,
+		Position:        t.Position,
+		Assignee:        t.Assignee,
+		Priority:        string(t.Priority),
+		DueAt:           t.DueAt,
+		Tags:            taskSnapshot(t).Tags,
+		Fields:          taskFieldValues(t),
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+		ColumnEnteredAt: t.ColumnEnteredAt,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// apiTaskFilter turns list query parameters into predicates:
+//
+//	column, assignee      exact match
+//	priority              comma separated, e.g. high,urgent
+//	tag                   key:value
+//	due_before, due_after YYYY-MM-DD, inclusive
+//	overdue               true for tasks due before today
+//	stuck                 true for tasks past their column's stale threshold
+//	field.<key>           custom field value
+func apiTaskFilter(q url.Values, defs []customfield.Definition, thresholds stale.Thresholds) ([]predicate.Task, error) {
+	preds := []predicate.Task{onBoard()}
+
+	if column := q.Get("column"); column != "" {
+		preds = append(preds, task.ColumnEQ(column))
+	}
+	if q.Has("assignee") {
+		preds = append(preds, task.AssigneeEQ(q.Get("assignee")))
+	}
+	if raw := q.Get("priority"); raw != "" {
+		var priorities []task.Priority
+		for _, name := range strings.Split(raw, ",") {
+			p, ok := parsePriority(name)
+			if !ok {
+				return nil, fmt.Errorf("unknown priority %q", name)
+			}
+			priorities = append(priorities, p)
+		}
+		preds = append(preds, task.PriorityIn(priorities...))
+	}
+	if raw := q.Get("tag"); raw != "" {
+		key, value, _ := strings.Cut(raw, ":")
+		preds = append(preds, task.HasTagsWith(tasktag.KeyEQ(key), tasktag.ValueEQ(value)))
+	}
+	if raw := q.Get("due_before"); raw != "" {
+		due, err := parseDue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due_before: %w", err)
+		}
+		preds = append(preds, task.DueAtLTE(*due))
+	}
+	if raw := q.Get("due_after"); raw != "" {
+		due, err := parseDue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due_after: %w", err)
+		}
+		preds = append(preds, task.DueAtGTE(*due))
+	}
+	if q.Get("overdue") == "true" {
+		preds = append(preds, task.DueAtLT(startOfDay(time.Now())), task.ColumnNEQ("done"))
+	}
+	if q.Get("stuck") == "true" {
+		preds = append(preds, stuckPredicate(thresholds, time.Now()))
+	}
+
+	fieldPreds, err := fieldPredicates(defs, q)
+	if err != nil {
+		return nil, err
+	}
+	return append(preds, fieldPreds...), nil
+}
+
+// APITaskListHandler lists board tasks as JSON. ?sort=priority or ?sort=due
+// orders the result; otherwise tasks are ordered by column position.
+func (s *Server) APITaskListHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to load fields")
+		return
+	}
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to load stale thresholds")
+		return
+	}
+	preds, err := apiTaskFilter(q, defs, thresholds)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tasks, err := s.Client.Task.Query().
+		Where(preds...).
+		WithTags().
+		WithFieldValues().
+		Order(ent.Asc(task.FieldColumn), ent.Asc(task.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list tasks", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to list tasks")
+		return
+	}
+
+	switch sortBy := q.Get("sort"); sortBy {
+	case "":
+	case "priority", "due":
+		sortTasks(tasks, sortBy)
+	default:
+		writeJSONError(w, http.StatusBadRequest, "sort must be priority or due")
+		return
+	}
+
+	result := make([]apiTask, len(tasks))
+	for i, t := range tasks {
+		result[i] = toAPITask(t)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// APITaskHandler returns a single task as JSON.
+func (s *Server) APITaskHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid task ID")
+		return
+	}
+
+	t, err := s.Client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithTags().
+		WithFieldValues().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		writeJSONError(w, http.StatusNotFound, "task not found")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get task", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to get task")
+		return
+	}
+	writeJSON(w, http.StatusOK, toAPITask(t))
+}
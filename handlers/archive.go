@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// archiveTask takes a task off the board. It keeps its column so the archive
+// page can show where it finished.
+func (s *Server) archiveTask(ctx context.Context, t *ent.Task, details, actor string) error {
+	if err := s.Client.Task.UpdateOneID(t.ID).
+		Where(onBoard()).
+		SetArchivedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err := recompactColumnPositions(ctx, s.Client, t.Column); err != nil {
+		slog.ErrorContext(ctx, "failed to recompact column", "column", t.Column, "error", err)
+	}
+	if _, err := s.recordHistory(ctx, t.ID, "archived", details, actor, nil); err != nil {
+		slog.ErrorContext(ctx, "failed to create history for archive", "error", err)
+	}
+
+	s.Broadcaster.BroadcastBoard(t.ID, "task_archived", t.Column, "")
+	return nil
+}
+
+// TaskArchiveHandler archives a task from the card menu.
+func (s *Server) TaskArchiveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	t, err := s.Client.Task.Query().
+		Where(task.IDEQ(id), onBoard()).
+		Only(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to find task for archive", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	before, err := captureState(ctx, s.Client, id)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	if err := s.archiveTask(ctx, t, fmt.Sprintf("archived from %s", t.Column), actorFromRequest(r)); err != nil {
+		slog.ErrorContext(ctx, "failed to archive task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.RemoveElement("#task-card-" + strconv.Itoa(id))
+	patchToast(ctx, sse, s.recordUndo(ctx, r, fmt.Sprintf("Archived %q", t.Title), id, before))
+}
+
+// TaskUnarchiveHandler puts an archived task back at the end of its column.
+func (s *Server) TaskUnarchiveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	t, err := s.Client.Task.Query().
+		Where(task.IDEQ(id), task.ArchivedAtNotNil(), task.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to find archived task", "error", err)
+		_ = datastar.NewSSE(w, r).ConsoleError(err)
+		return
+	}
+
+	// Coming back counts against the column's WIP limits
+	subject := workflowSubject(r, t.Description, t.Assignee, nil)
+	breach, breached, err := s.checkMove(ctx, id, "", t.Column, t.Assignee, subject, false)
+	var rejected *moveRejection
+	if errors.As(err, &rejected) {
+		writeMoveRejected(ctx, w, rejected)
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check unarchive", "task_id", id, "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	position, err := getNextPosition(ctx, s.Client, t.Column)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := s.Client.Task.UpdateOneID(id).
+		ClearArchivedAt().
+		SetPosition(position).
+		Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to unarchive task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	details := fmt.Sprintf("unarchived to %s", t.Column)
+	if _, err := s.recordHistory(ctx, id, "unarchived", details, actorFromRequest(r), nil); err != nil {
+		slog.ErrorContext(ctx, "failed to create history for unarchive", "error", err)
+	}
+	if breached {
+		s.recordWIPBreach(ctx, id, breach, wipOutcome(breach), actorFromRequest(r))
+	}
+
+	s.Broadcaster.BroadcastBoard(id, "task_created", t.Column, "")
+
+	_ = sse.RemoveElement("#archive-row-" + strconv.Itoa(id))
+}
+
+// ArchivePageHandler lists archived tasks, optionally filtered by a search term
+// matched against title, description, assignee and tags.
+func (s *Server) ArchivePageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	search := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	query := s.Client.Task.Query().
+		Where(task.ArchivedAtNotNil(), task.DeletedAtIsNil()).
+		WithTags().
+		Order(ent.Desc(task.FieldArchivedAt))
+	if search != "" {
+		query = query.Where(task.Or(
+			task.TitleContainsFold(search),
+			task.DescriptionContainsFold(search),
+			task.AssigneeContainsFold(search),
+			task.HasTagsWith(tasktag.Or(
+				tasktag.KeyContainsFold(search),
+				tasktag.ValueContainsFold(search),
+			)),
+		))
+	}
+
+	tasks, err := query.All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get archive", "error", err)
+		http.Error(w, "Failed to load archive", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.ArchiveContent(tasks, search, s.Config.ArchiveDoneAfter)
+	page := templates.Layout("Archive - Bot Task Tracker", pages.ArchiveMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// archiveStaleDone archives tasks that have been in done for the configured
+// period, however often they were edited there. A zero period disables the
+// rule.
+func (s *Server) archiveStaleDone(ctx context.Context) error {
+	if s.Config.ArchiveDoneAfter == 0 {
+		return nil
+	}
+
+	cutoff := time.Now().Add(-s.Config.ArchiveDoneAfter)
+	tasks, err := s.Client.Task.Query().
+		Where(onBoard(), task.ColumnEQ("done"), task.ColumnEnteredAtLT(cutoff)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	days := int(s.Config.ArchiveDoneAfter.Hours() / 24)
+	details := fmt.Sprintf("archived automatically after %d days in done", days)
+	for _, t := range tasks {
+		if err := s.archiveTask(ctx, t, details, ""); err != nil {
+			return err
+		}
+	}
+	if len(tasks) > 0 {
+		slog.InfoContext(ctx, "archived done tasks", "count", len(tasks))
+	}
+	return nil
+}
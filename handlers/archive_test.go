@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+func (ts *testServer) archive(t *testing.T, id int) {
+	t.Helper()
+	if rec := ts.do(t, http.MethodPost, fmt.Sprintf("/datastar/tasks/%d/archive", id), nil); rec.Code != http.StatusOK {
+		t.Fatalf("archive #%d: %d %s", id, rec.Code, rec.Body)
+	}
+}
+
+func TestUnarchiveChecksWIP(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	archived := ts.createTask(t, map[string]any{"title": "Archived", "column": "review"})
+	ts.archive(t, archived.ID)
+	ts.createTask(t, map[string]any{"title": "Took its place", "column": "review"})
+	ts.Client.WipLimit.Create().SetColumn("review").SetMaxTasks(1).SetMode(wiplimit.ModeHard).ExecX(ctx)
+
+	rec := ts.do(t, http.MethodPost, fmt.Sprintf("/datastar/tasks/%d/unarchive", archived.ID), nil)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("unarchive into a full column: %d %s", rec.Code, rec.Body)
+	}
+	if got := ts.Client.Task.GetX(ctx, archived.ID); got.ArchivedAt == nil {
+		t.Error("task left the archive")
+	}
+}
+
+func TestArchiveStaleDoneCountsTimeInDone(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	ts.Config.ArchiveDoneAfter = 14 * 24 * time.Hour
+	longDone := ts.createTask(t, map[string]any{"title": "Done for weeks", "column": "done"})
+	justDone := ts.createTask(t, map[string]any{"title": "Just finished", "column": "done"})
+	ts.Client.Task.UpdateOneID(longDone.ID).
+		SetColumnEnteredAt(time.Now().Add(-15 * 24 * time.Hour)).
+		SetTitle("Done for weeks, edited today").
+		ExecX(ctx)
+	ts.Client.Task.UpdateOneID(justDone.ID).
+		SetUpdatedAt(time.Now().Add(-15 * 24 * time.Hour)).
+		ExecX(ctx)
+
+	if err := ts.archiveStaleDone(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := ts.Client.Task.GetX(ctx, longDone.ID); got.ArchivedAt == nil {
+		t.Error("task in done for 15 days was kept because it was edited")
+	}
+	if got := ts.Client.Task.GetX(ctx, justDone.ID); got.ArchivedAt != nil {
+		t.Error("task that just reached done was archived")
+	}
+}
@@ -0,0 +1,744 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/automation"
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/hook"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+	"github.com/j0hnsmith/botTaskTracker/wip"
+	"github.com/j0hnsmith/botTaskTracker/workflow"
+)
+
+const (
+	// automationActor is the history actor for changes made by rules.
+	automationActor = "automation"
+	// maxAutomationDepth caps how many rules can set each other off.
+	maxAutomationDepth = 5
+	// automationLogSize is how many runs the automations page shows per rule.
+	automationLogSize = 20
+)
+
+// Automations queues board events for the rule engine. Events are evaluated
+// one at a time by a single worker, off the request that caused them.
+type Automations struct {
+	events chan automationEvent
+	http   *http.Client
+}
+
+func NewAutomations() *Automations {
+	return &Automations{
+		events: make(chan automationEvent, 256),
+		http:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (a *Automations) enqueue(ev automationEvent) {
+	select {
+	case a.events <- ev:
+	default:
+		slog.Warn("automation queue full, dropping event", "trigger", ev.Trigger, "task_id", ev.TaskID)
+	}
+}
+
+// automationChain follows a cascade of rules started by one change so a rule
+// that sets itself off, directly or through other rules, is stopped.
+type automationChain struct {
+	depth int
+	fired map[[2]int]bool // rule ID, task ID
+}
+
+func newAutomationChain() *automationChain {
+	return &automationChain{fired: make(map[[2]int]bool)}
+}
+
+func (c *automationChain) next() *automationChain {
+	return &automationChain{depth: c.depth + 1, fired: c.fired}
+}
+
+// block returns why a rule may not run on a task in this chain, or "" and
+// marks it as run. Changes made by people can fire a rule any number of
+// times; only changes made by rules are checked for repeats.
+func (c *automationChain) block(ruleID, taskID int) string {
+	if c.depth >= maxAutomationDepth {
+		return fmt.Sprintf("stopped after %d chained automations", maxAutomationDepth)
+	}
+	key := [2]int{ruleID, taskID}
+	if c.depth > 0 && c.fired[key] {
+		return "stopped: this rule already ran on the task earlier in the chain"
+	}
+	c.fired[key] = true
+	return ""
+}
+
+type automationEvent struct {
+	automation.Event
+	chain *automationChain
+}
+
+// eventBuffer holds the events of a request or rule run until it's finished,
+// so rules see committed changes.
+type eventBuffer struct {
+	mu     sync.Mutex
+	chain  *automationChain
+	events []automation.Event
+}
+
+type eventBufferKey struct{}
+
+func withEventBuffer(ctx context.Context, chain *automationChain) (context.Context, *eventBuffer) {
+	buf := &eventBuffer{chain: chain}
+	return context.WithValue(ctx, eventBufferKey{}, buf), buf
+}
+
+func eventBufferFrom(ctx context.Context) *eventBuffer {
+	buf, _ := ctx.Value(eventBufferKey{}).(*eventBuffer)
+	return buf
+}
+
+// mark and rewind let withTx drop the events of a rolled back transaction.
+func (b *eventBuffer) mark() int {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.events)
+}
+
+func (b *eventBuffer) rewind(mark int) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if mark < len(b.events) {
+		b.events = b.events[:mark]
+	}
+}
+
+// emitAutomationEvent buffers ev on ctx, or queues it straight away for
+// changes made outside a request.
+func (s *Server) emitAutomationEvent(ctx context.Context, ev automation.Event) {
+	if buf := eventBufferFrom(ctx); buf != nil {
+		buf.mu.Lock()
+		buf.events = append(buf.events, ev)
+		buf.mu.Unlock()
+		return
+	}
+	s.Automations.enqueue(automationEvent{Event: ev, chain: newAutomationChain()})
+}
+
+func (s *Server) flushAutomationEvents(buf *eventBuffer) {
+	buf.mu.Lock()
+	events := buf.events
+	buf.events = nil
+	buf.mu.Unlock()
+	for _, ev := range events {
+		s.Automations.enqueue(automationEvent{Event: ev, chain: buf.chain})
+	}
+}
+
+// AutomationMiddleware hands the board events of each request to the rule
+// engine once the handler has finished.
+func (s *Server) AutomationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, buf := withEventBuffer(r.Context(), newAutomationChain())
+		next.ServeHTTP(w, r.WithContext(ctx))
+		s.flushAutomationEvents(buf)
+	})
+}
+
+// registerAutomationHooks turns task and tag mutations into automation events,
+// whichever handler, job or API call makes them.
+func (s *Server) registerAutomationHooks() {
+	s.Client.Task.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+			column, moved := m.Column()
+			if moved && m.Op().Is(ent.OpUpdateOne) {
+				old, err := m.OldColumn(ctx)
+				moved = err == nil && old != column
+			} else {
+				moved = false
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				if t, ok := v.(*ent.Task); ok {
+					s.emitAutomationEvent(ctx, automation.Event{Trigger: automation.Created, TaskID: t.ID, Column: t.Column})
+				}
+			case moved:
+				if id, ok := m.ID(); ok {
+					s.emitAutomationEvent(ctx, automation.Event{Trigger: automation.Moved, TaskID: id, Column: column})
+				}
+			}
+			return v, nil
+		})
+	})
+	s.Client.TaskTag.Use(hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TaskTagFunc(func(ctx context.Context, m *ent.TaskTagMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			key, _ := m.Key()
+			value, _ := m.Value()
+			if taskID, ok := m.TaskID(); ok {
+				s.emitAutomationEvent(ctx, automation.Event{Trigger: automation.Tagged, TaskID: taskID, Tag: key + ":" + value})
+			}
+			return v, nil
+		})
+	}, ent.OpCreate))
+}
+
+// runAutomations evaluates queued events until ctx is cancelled.
+func (s *Server) runAutomations(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-s.Automations.events:
+			if err := s.handleAutomationEvent(ctx, ev); err != nil {
+				slog.ErrorContext(ctx, "automation failed", "trigger", ev.Trigger, "task_id", ev.TaskID, "error", err)
+			}
+		}
+	}
+}
+
+// emitOverdueEvents queues a due_passed event for every overdue task on the
+// board; each rule runs once per task and due date.
+func (s *Server) emitOverdueEvents(ctx context.Context) error {
+	rules, err := s.Client.AutomationRule.Query().
+		Where(automationrule.EnabledEQ(true), automationrule.TriggerEQ(automationrule.TriggerDuePassed)).
+		Count(ctx)
+	if err != nil || rules == 0 {
+		return err
+	}
+	ids, err := s.Client.Task.Query().
+		Where(onBoard(), task.DueAtLT(startOfDay(time.Now())), task.ColumnNEQ("done")).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		s.Automations.enqueue(automationEvent{
+			Event: automation.Event{Trigger: automation.DuePassed, TaskID: id},
+			chain: newAutomationChain(),
+		})
+	}
+	return nil
+}
+
+// parseAutomationRule reads a stored rule; lines are validated on save.
+func parseAutomationRule(row *ent.AutomationRule) (automation.Rule, error) {
+	rule := automation.Rule{Trigger: automation.Trigger(row.Trigger), TriggerArg: row.TriggerArg}
+	for _, line := range row.Conditions {
+		c, err := automation.ParseCondition(line)
+		if err != nil {
+			return automation.Rule{}, err
+		}
+		rule.Conditions = append(rule.Conditions, c)
+	}
+	for _, line := range row.Actions {
+		a, err := automation.ParseAction(line)
+		if err != nil {
+			return automation.Rule{}, err
+		}
+		rule.Actions = append(rule.Actions, a)
+	}
+	return rule, nil
+}
+
+// automationTask describes a task loaded with its tags and field values.
+func automationTask(t *ent.Task) automation.Task {
+	return automation.Task{
+		Column:      t.Column,
+		Assignee:    t.Assignee,
+		Priority:    string(t.Priority),
+		Title:       t.Title,
+		Description: t.Description,
+		Tags:        taskSnapshot(t).Tags,
+		Fields:      taskFieldValues(t),
+	}
+}
+
+func (s *Server) loadAutomationTask(ctx context.Context, id int) (*ent.Task, error) {
+	return s.Client.Task.Query().
+		Where(task.IDEQ(id), onBoard()).
+		WithTags().
+		WithFieldValues().
+		Only(ctx)
+}
+
+// handleAutomationEvent runs every enabled rule matching ev, in creation
+// order. Changes made by a rule are fed back as events of the same chain.
+func (s *Server) handleAutomationEvent(ctx context.Context, ev automationEvent) error {
+	rows, err := s.Client.AutomationRule.Query().
+		Where(automationrule.EnabledEQ(true), automationrule.TriggerEQ(automationrule.Trigger(ev.Trigger))).
+		Order(ent.Asc(automationrule.FieldID)).
+		All(ctx)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+
+	t, err := s.loadAutomationTask(ctx, ev.TaskID)
+	if ent.IsNotFound(err) {
+		return nil // trashed or archived since
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		rule, err := parseAutomationRule(row)
+		if err != nil {
+			slog.WarnContext(ctx, "ignoring invalid automation rule", "rule", row.ID, "error", err)
+			continue
+		}
+		if !rule.Matches(ev.Event, automationTask(t)) {
+			continue
+		}
+		if ev.Trigger == automation.DuePassed {
+			if t.DueAt == nil {
+				continue
+			}
+			ran, err := s.Client.AutomationRun.Query().
+				Where(
+					automationrun.HasRuleWith(automationrule.IDEQ(row.ID)),
+					automationrun.TaskIDEQ(t.ID),
+					automationrun.TriggerEQ(string(automation.DuePassed)),
+					automationrun.CreatedAtGTE(*t.DueAt),
+				).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if ran {
+				continue
+			}
+		}
+
+		if reason := ev.chain.block(row.ID, t.ID); reason != "" {
+			s.recordAutomationRun(ctx, row.ID, ev, automationrun.StatusLoopBlocked, reason)
+			continue
+		}
+
+		runCtx, buf := withEventBuffer(ctx, ev.chain.next())
+		done, err := s.runAutomationActions(runCtx, row, rule, t, ev)
+		if err != nil {
+			s.recordAutomationRun(ctx, row.ID, ev, automationrun.StatusFailed, strings.Join(append(done, err.Error()), "; "))
+		} else {
+			s.recordAutomationRun(ctx, row.ID, ev, automationrun.StatusSuccess, strings.Join(done, "; "))
+		}
+		s.flushAutomationEvents(buf)
+
+		// Later rules see the task as this one left it
+		if t, err = s.loadAutomationTask(ctx, ev.TaskID); err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) recordAutomationRun(ctx context.Context, ruleID int, ev automationEvent, status automationrun.Status, message string) {
+	err := s.Client.AutomationRun.Create().
+		SetRuleID(ruleID).
+		SetTaskID(ev.TaskID).
+		SetTrigger(string(ev.Trigger)).
+		SetStatus(status).
+		SetMessage(message).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to record automation run", "rule", ruleID, "error", err)
+	}
+}
+
+// runAutomationActions performs a rule's actions in order, stopping at the
+// first failure. It returns a description of each action taken.
+func (s *Server) runAutomationActions(ctx context.Context, row *ent.AutomationRule, rule automation.Rule, t *ent.Task, ev automationEvent) ([]string, error) {
+	var done []string
+	for _, a := range rule.Actions {
+		msg, err := s.runAutomationAction(ctx, row, a, t, ev)
+		if err != nil {
+			return done, fmt.Errorf("%s: %w", a, err)
+		}
+		done = append(done, msg)
+		if t, err = s.loadAutomationTask(ctx, t.ID); err != nil {
+			return done, err
+		}
+	}
+	return done, nil
+}
+
+func (s *Server) runAutomationAction(ctx context.Context, row *ent.AutomationRule, a automation.Action, t *ent.Task, ev automationEvent) (string, error) {
+	switch a.Kind {
+	case automation.ActionMove:
+		column, top := a.MoveTarget()
+		if t.Column == column {
+			return "already in " + column, nil
+		}
+		// Rules move tasks as a bot would, so humans guards stop them too
+		subject := workflow.Subject{
+			Description: t.Description,
+			Assignee:    t.Assignee,
+			Tags:        taskSnapshot(t).Tags,
+			Actor:       automationActor,
+		}
+		breach, breached, err := s.checkMove(ctx, t.ID, t.Column, column, t.Assignee, subject, false)
+		if err != nil {
+			return "", err
+		}
+		if err := recordBaselineRevision(ctx, s.Client, t.ID); err != nil {
+			return "", err
+		}
+		position := 0
+		if !top {
+			var err error
+			if position, err = getNextPosition(ctx, s.Client, column); err != nil {
+				return "", err
+			}
+		}
+		if err := reorderTasksOnColumnChange(ctx, s.Client, t.ID, t.Column, column, position); err != nil {
+			return "", err
+		}
+		details := fmt.Sprintf("moved from %s to %s", t.Column, column)
+		if _, err := s.recordHistory(ctx, t.ID, "moved", details, automationActor, nil); err != nil {
+			return "", err
+		}
+		if breached {
+			s.recordWIPBreach(ctx, t.ID, breach, wipOutcome(breach), automationActor)
+		}
+		if _, err := recordRevision(ctx, s.Client, t.ID, automationActor); err != nil {
+			return "", err
+		}
+		s.Broadcaster.BroadcastBoard(t.ID, "column_refresh", t.Column, "")
+		s.Broadcaster.BroadcastBoard(t.ID, "column_refresh", column, "")
+		return details, nil
+
+	case automation.ActionAssign:
+		if t.Assignee == a.Arg {
+			return "already assigned", nil
+		}
+		// Taking over a task counts against the new assignee's limits
+		breach, breached, err := checkWIP(ctx, s.Client, t.Column, a.Arg, t.ID)
+		if err != nil {
+			return "", err
+		}
+		if breached && breach.Blocks(false) {
+			if breach.Limit.Mode == wip.HardBlock {
+				s.recordWIPBreach(ctx, t.ID, breach, "blocked", automationActor)
+			}
+			return "", breach
+		}
+		if err := recordBaselineRevision(ctx, s.Client, t.ID); err != nil {
+			return "", err
+		}
+		if err := s.Client.Task.UpdateOneID(t.ID).SetAssignee(a.Arg).Exec(ctx); err != nil {
+			return "", err
+		}
+		details := "assigned to " + a.Arg
+		if a.Arg == "" {
+			details = "unassigned"
+		}
+		if _, err := s.recordHistory(ctx, t.ID, "assigned", details, automationActor, nil); err != nil {
+			return "", err
+		}
+		if breached {
+			s.recordWIPBreach(ctx, t.ID, breach, wipOutcome(breach), automationActor)
+		}
+		if _, err := recordRevision(ctx, s.Client, t.ID, automationActor); err != nil {
+			return "", err
+		}
+		s.Broadcaster.BroadcastBoard(t.ID, "task_updated", t.Column, "")
+		return details, nil
+
+	case automation.ActionTag:
+		tags := taskSnapshot(t).Tags
+		for _, pair := range tags {
+			if pair == a.Arg {
+				return "already tagged " + a.Arg, nil
+			}
+		}
+		// The registry may have changed since the rule was saved
+		reg, err := loadTagRegistry(ctx, s.Client)
+		if err != nil {
+			return "", err
+		}
+		if err := validateTags(reg, parseTags(strings.Join(append(slices.Clone(tags), a.Arg), ","))); err != nil {
+			slog.ErrorContext(ctx, "automation tag rejected by the tag registry", "rule", row.ID, "task_id", t.ID, "tag", a.Arg, "error", err)
+			return "", errors.New(strings.ReplaceAll(err.Error(), "\n", "; "))
+		}
+		if err := recordBaselineRevision(ctx, s.Client, t.ID); err != nil {
+			return "", err
+		}
+		if err := replaceTags(ctx, s.Client, t.ID, append(tags, a.Arg)); err != nil {
+			return "", err
+		}
+		if _, err := s.recordHistory(ctx, t.ID, "tagged", a.Arg, automationActor, nil); err != nil {
+			return "", err
+		}
+		if _, err := recordRevision(ctx, s.Client, t.ID, automationActor); err != nil {
+			return "", err
+		}
+		s.Broadcaster.BroadcastBoard(t.ID, "task_updated", t.Column, "")
+		return "tagged " + a.Arg, nil
+
+	case automation.ActionComment:
+		if _, err := s.recordHistory(ctx, t.ID, "commented", a.Arg, automationActor, nil); err != nil {
+			return "", err
+		}
+		return "commented", nil
+
+	case automation.ActionWebhook:
+		return s.callAutomationWebhook(ctx, row, a.Arg, t, ev)
+	}
+	return "", fmt.Errorf("unknown action %q", a.Kind)
+}
+
+// callAutomationWebhook posts the rule, trigger and task as JSON. Any non-2xx
+// response fails the run.
+func (s *Server) callAutomationWebhook(ctx context.Context, row *ent.AutomationRule, url string, t *ent.Task, ev automationEvent) (string, error) {
+	body, err := json.Marshal(map[string]any{
+		"rule":    row.Name,
+		"trigger": ev.Trigger,
+		"column":  ev.Column,
+		"tag":     ev.Tag,
+		"task":    toAPITask(t),
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.Automations.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return "webhook " + resp.Status, nil
+}
+
+// AutomationsPageHandler lists the rules with their recent runs and a form to
+// add more.
+func (s *Server) AutomationsPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rules, err := s.Client.AutomationRule.Query().
+		Order(ent.Asc(automationrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get automation rules", "error", err)
+		http.Error(w, "Failed to load automations", http.StatusInternalServerError)
+		return
+	}
+	runs := make(map[int][]*ent.AutomationRun, len(rules))
+	for _, rule := range rules {
+		runs[rule.ID], err = rule.QueryRuns().
+			Order(ent.Desc(automationrun.FieldCreatedAt), ent.Desc(automationrun.FieldID)).
+			Limit(automationLogSize).
+			All(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get automation runs", "rule", rule.ID, "error", err)
+			http.Error(w, "Failed to load automations", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	bodyContent := pages.AutomationsContent(rules, runs, automation.Triggers, boardColumns)
+	page := templates.Layout("Automations - Bot Task Tracker", pages.AutomationsMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// AutomationSaveHandler adds a rule. Conditions and actions are one per line.
+func (s *Server) AutomationSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type AutomationSignals struct {
+		Name       string `json:"automation_name"`
+		Trigger    string `json:"automation_trigger"`
+		TriggerArg string `json:"automation_trigger_arg"`
+		Conditions string `json:"automation_conditions"`
+		Actions    string `json:"automation_actions"`
+	}
+	signals := &AutomationSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+	patchError := func(msg string) {
+		_ = sse.PatchElements(`<div id="automation-error" class="alert alert-error text-sm">` + html.EscapeString(msg) + `</div>`)
+	}
+
+	name := strings.TrimSpace(signals.Name)
+	if name == "" {
+		patchError("Give the rule a name")
+		return
+	}
+	trigger := automationrule.Trigger(signals.Trigger)
+	if err := automationrule.TriggerValidator(trigger); err != nil {
+		patchError("Unknown trigger " + signals.Trigger)
+		return
+	}
+	triggerArg := strings.TrimSpace(signals.TriggerArg)
+	switch automation.Trigger(trigger) {
+	case automation.Moved, automation.Stuck:
+		if triggerArg != "" && sanitizeColumn(triggerArg) != triggerArg {
+			patchError("Unknown column " + triggerArg)
+			return
+		}
+	case automation.Tagged:
+	default:
+		triggerArg = ""
+	}
+
+	var conditions []string
+	for _, line := range strings.Split(signals.Conditions, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		c, err := automation.ParseCondition(line)
+		if err != nil {
+			patchError(err.Error())
+			return
+		}
+		conditions = append(conditions, c.String())
+	}
+
+	reg, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	var actions []string
+	for _, line := range strings.Split(signals.Actions, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		a, err := automation.ParseAction(line)
+		if err != nil {
+			patchError(err.Error())
+			return
+		}
+		switch a.Kind {
+		case automation.ActionMove:
+			if column, _ := a.MoveTarget(); sanitizeColumn(column) != column {
+				patchError("Unknown column " + column)
+				return
+			}
+		case automation.ActionTag:
+			key, value, _ := strings.Cut(a.Arg, ":")
+			if err := validateTags(reg, []tagInput{{Key: key, Value: value}}); err != nil {
+				patchError(err.Error())
+				return
+			}
+		}
+		actions = append(actions, a.String())
+	}
+	if len(actions) == 0 {
+		patchError("Add at least one action")
+		return
+	}
+
+	err = s.Client.AutomationRule.Create().
+		SetName(name).
+		SetTrigger(trigger).
+		SetTriggerArg(triggerArg).
+		SetConditions(conditions).
+		SetActions(actions).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save automation rule", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/automations")
+}
+
+// AutomationToggleHandler pauses or resumes a rule.
+func (s *Server) AutomationToggleHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid rule ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	rule, err := s.Client.AutomationRule.Get(ctx, id)
+	if err == nil {
+		err = rule.Update().SetEnabled(!rule.Enabled).Exec(ctx)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to toggle automation rule", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/automations")
+}
+
+// AutomationDeleteHandler removes a rule and its execution log.
+func (s *Server) AutomationDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid rule ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	err = withTx(ctx, s.Client, func(tx *ent.Tx) error {
+		if _, err := tx.AutomationRun.Delete().Where(automationrun.HasRuleWith(automationrule.IDEQ(id))).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.AutomationRule.DeleteOneID(id).Exec(ctx)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete automation rule", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/automations")
+}
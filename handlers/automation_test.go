@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+// runAutomations handles queued events, including the ones rules raise,
+// until none are left.
+func (ts *testServer) runAutomations(t *testing.T) {
+	t.Helper()
+	for {
+		select {
+		case ev := <-ts.Automations.events:
+			if err := ts.handleAutomationEvent(context.Background(), ev); err != nil {
+				t.Fatal(err)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (ts *testServer) addRule(t *testing.T, trigger automationrule.Trigger, actions ...string) {
+	t.Helper()
+	ts.Client.AutomationRule.Create().
+		SetName(strings.Join(actions, ", ")).
+		SetTrigger(trigger).
+		SetConditions([]string{}).
+		SetActions(actions).
+		ExecX(context.Background())
+}
+
+func TestAutomationActionsRecordRevisions(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	ts.addRule(t, automationrule.TriggerCreated, "assign peter", "tag type:bug", "move review")
+
+	created := ts.createTask(t, map[string]any{"title": "Triaged by a rule", "column": "backlog"})
+	ts.runAutomations(t)
+
+	if got := fmt.Sprint(revisionNumbers(t, ts.Client, created.ID)); got != "[1 2 3 4]" {
+		t.Fatalf("revisions = %s, want one for the create and one per action", got)
+	}
+	last := ts.Client.TaskRevision.Query().
+		Where(taskrevision.HasTaskWith(task.IDEQ(created.ID))).
+		Order(ent.Desc(taskrevision.FieldRevision)).
+		FirstX(ctx)
+	if last.Actor != automationActor || last.Assignee != "peter" || last.Column != "review" || !slices.Equal(last.Tags, []string{"type:bug"}) {
+		t.Errorf("last revision = %s %q in %s tagged %q, want the rules' changes", last.Actor, last.Assignee, last.Column, last.Tags)
+	}
+}
+
+func TestAutomationAssignChecksWIP(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	ts.createTask(t, map[string]any{"title": "Peter's", "column": "backlog", "assignee": "peter"})
+	ts.runAutomations(t)
+	ts.Client.WipLimit.Create().SetColumn("backlog").SetAssignee("peter").SetMaxTasks(1).SetMode(wiplimit.ModeHard).ExecX(ctx)
+	ts.addRule(t, automationrule.TriggerCreated, "assign peter")
+
+	created := ts.createTask(t, map[string]any{"title": "One too many", "column": "backlog"})
+	ts.runAutomations(t)
+
+	if got := ts.Client.Task.GetX(ctx, created.ID); got.Assignee != "" {
+		t.Errorf("assignee = %q, want the task left unassigned", got.Assignee)
+	}
+	run := ts.Client.AutomationRun.Query().OnlyX(ctx)
+	if run.Status != automationrun.StatusFailed || !strings.Contains(run.Message, "peter") {
+		t.Errorf("run = %s %q, want a failed run naming the limit", run.Status, run.Message)
+	}
+	blocked := ts.Client.TaskHistory.Query().
+		Where(taskhistory.HasTaskWith(task.IDEQ(created.ID)), taskhistory.ActionEQ("wip_exceeded")).
+		OnlyX(ctx)
+	if blocked.Actor != automationActor || !strings.Contains(blocked.Details, "blocked") {
+		t.Errorf("breach entry = %q by %q", blocked.Details, blocked.Actor)
+	}
+}
+
+func TestAutomationTagChecksRegistryAtRunTime(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	ts.createTask(t, map[string]any{"title": "Seeds the type key", "tags": []string{"type:bug"}})
+	ts.runAutomations(t)
+	ts.addRule(t, automationrule.TriggerCreated, "tag type:feature")
+	ts.Client.TagDefinition.Update().
+		Where(tagdefinition.KeyEQ("type")).
+		SetAllowedValues([]string{"bug"}).
+		ExecX(ctx)
+
+	created := ts.createTask(t, map[string]any{"title": "Rule tags it after the registry changed"})
+	ts.runAutomations(t)
+
+	if n := ts.Client.Task.Query().Where(task.IDEQ(created.ID)).QueryTags().CountX(ctx); n != 0 {
+		t.Errorf("%d tags written, want the registry's rejection to stop the rule", n)
+	}
+	run := ts.Client.AutomationRun.Query().OnlyX(ctx)
+	if run.Status != automationrun.StatusFailed || !strings.Contains(run.Message, "type must be one of bug") {
+		t.Errorf("run = %s %q, want a failed run with the registry's reason", run.Status, run.Message)
+	}
+}
@@ -16,12 +16,16 @@ import (
 
 // UnifiedEvent represents any event that can be broadcast (board or activity)
 type UnifiedEvent struct {
-	EventType string // "board" or "activity"
-	Type      string // "task_created", "task_updated", "task_moved", "task_deleted", "activity_created"
+	EventType string // "board", "activity", "presence" or "batch"
+	Type      string // "task_created", "task_updated", "task_moved", "task_deleted", "task_archived", "column_refresh", "activity_created", "bulk_update"
 	TaskID    int
 	HistoryID int
 	Column    string
 	Nonce     string // Client nonce to prevent echo-back
+
+	// Batch events carry everything a bulk action touched
+	Columns    []string
+	HistoryIDs []int
 }
 
 // Broadcaster manages SSE connections and broadcasts unified events
@@ -76,6 +80,28 @@ func (b *Broadcaster) BroadcastActivity(historyID int) {
 	b.broadcast(event)
 }
 
+// BroadcastPresence tells all connected clients that the viewers of a task changed
+func (b *Broadcaster) BroadcastPresence(taskID int) {
+	event := UnifiedEvent{
+		EventType: "presence",
+		Type:      "presence_changed",
+		TaskID:    taskID,
+	}
+	b.broadcast(event)
+}
+
+// BroadcastBatch sends one event for a bulk action: the columns to re-render
+// and the history entries to add to the activity feed
+func (b *Broadcaster) BroadcastBatch(columns []string, historyIDs []int) {
+	event := UnifiedEvent{
+		EventType:  "batch",
+		Type:       "bulk_update",
+		Columns:    columns,
+		HistoryIDs: historyIDs,
+	}
+	b.broadcast(event)
+}
+
 // broadcast sends a unified event to all connected clients
 func (b *Broadcaster) broadcast(event UnifiedEvent) {
 	b.mu.RLock()
@@ -115,8 +141,24 @@ func (s *Server) HandleEvents(w http.ResponseWriter, r *http.Request) {
 	s.Broadcaster.Register(eventChan)
 	defer s.Broadcaster.Unregister(eventChan)
 	
+	// Track this connection's identity for presence indicators
+	clientID := clientIDFromRequest(r)
+	if clientID != "" {
+		s.setPresence(r, 0, false)
+		defer func() {
+			for _, id := range s.Presence.Remove(clientID) {
+				s.Broadcaster.BroadcastPresence(id)
+			}
+		}()
+	}
+	
 	// Send initial connection message
 	_ = sse.PatchSignals([]byte(`{"sseConnected": true}`))
+	
+	// Send current viewers of every open task
+	for _, id := range s.Presence.ActiveTasks() {
+		_ = s.patchPresence(ctx, sse, id)
+	}
 	if f, ok := w.(http.Flusher); ok {
 		f.Flush()
 	}
@@ -143,6 +185,12 @@ func (s *Server) HandleEvents(w http.ResponseWriter, r *http.Request) {
 				err = s.handleBoardEvent(ctx, sse, event)
 			} else if event.EventType == "activity" {
 				err = s.handleActivityEvent(ctx, sse, event)
+			} else if event.EventType == "presence" {
+				// Presence patches are never echo-back, clear any earlier nonce
+				_ = sse.PatchSignals([]byte(`{"lastEventNonce": ""}`))
+				err = s.patchPresence(ctx, sse, event.TaskID)
+			} else if event.EventType == "batch" {
+				err = s.handleBatchEvent(ctx, sse, event)
 			}
 			
 			if err != nil {
@@ -176,7 +224,15 @@ func (s *Server) handleBoardEvent(ctx context.Context, sse *datastar.ServerSentE
 		
 		// Render the task card
 		var htmlBuilder strings.Builder
-		err = fragments.TaskCard(t, t.Column).Render(ctx, &htmlBuilder)
+		tagRegistry, err := loadTagRegistry(ctx, s.Client)
+		if err != nil {
+			return err
+		}
+		thresholds, err := loadStaleThresholds(ctx, s.Client)
+		if err != nil {
+			return err
+		}
+		err = fragments.TaskCard(t, t.Column, tagRegistry, thresholds).Render(ctx, &htmlBuilder)
 		if err != nil {
 			return err
 		}
@@ -199,7 +255,15 @@ func (s *Server) handleBoardEvent(ctx context.Context, sse *datastar.ServerSentE
 		
 		// Render the task card
 		var htmlBuilder strings.Builder
-		err = fragments.TaskCard(t, t.Column).Render(ctx, &htmlBuilder)
+		tagRegistry, err := loadTagRegistry(ctx, s.Client)
+		if err != nil {
+			return err
+		}
+		thresholds, err := loadStaleThresholds(ctx, s.Client)
+		if err != nil {
+			return err
+		}
+		err = fragments.TaskCard(t, t.Column, tagRegistry, thresholds).Render(ctx, &htmlBuilder)
 		if err != nil {
 			return err
 		}
@@ -220,7 +284,15 @@ func (s *Server) handleBoardEvent(ctx context.Context, sse *datastar.ServerSentE
 		
 		// Render the task card
 		var htmlBuilder strings.Builder
-		err = fragments.TaskCard(t, t.Column).Render(ctx, &htmlBuilder)
+		tagRegistry, err := loadTagRegistry(ctx, s.Client)
+		if err != nil {
+			return err
+		}
+		thresholds, err := loadStaleThresholds(ctx, s.Client)
+		if err != nil {
+			return err
+		}
+		err = fragments.TaskCard(t, t.Column, tagRegistry, thresholds).Render(ctx, &htmlBuilder)
 		if err != nil {
 			return err
 		}
@@ -231,11 +303,54 @@ func (s *Server) handleBoardEvent(ctx context.Context, sse *datastar.ServerSentE
 			datastar.WithModeAppend(),
 			datastar.WithSelector("#column-"+t.Column))
 		
-	case "task_deleted":
+	case "column_refresh":
+		// Re-render the whole column, e.g. after a task is restored in place
+		if err := renderColumnUpdate(ctx, sse, s.Client, event.Column); err != nil {
+			return err
+		}
+		
+	case "task_deleted", "task_archived":
 		// Remove the element
 		_ = sse.RemoveElement("#task-card-" + strconv.Itoa(event.TaskID))
+		return patchWIPBadges(ctx, sse, s.Client)
 	}
 	
+	// Column counts may have changed
+	if err := patchWIPBadges(ctx, sse, s.Client); err != nil {
+		return err
+	}
+	
+	// Re-rendered cards start with an empty avatar list
+	return s.patchPresence(ctx, sse, event.TaskID)
+}
+
+// handleBatchEvent re-renders every column a bulk action touched, once, and
+// adds its history entries to the activity feed
+func (s *Server) handleBatchEvent(ctx context.Context, sse *datastar.ServerSentEventGenerator, event UnifiedEvent) error {
+	// Batches are never echo-back, clear any earlier nonce
+	_ = sse.PatchSignals([]byte(`{"lastEventNonce": ""}`))
+
+	for _, column := range event.Columns {
+		if err := renderColumnUpdate(ctx, sse, s.Client, column); err != nil {
+			return err
+		}
+	}
+	for _, id := range event.HistoryIDs {
+		entry := UnifiedEvent{EventType: "activity", Type: "activity_created", HistoryID: id}
+		if err := s.handleActivityEvent(ctx, sse, entry); err != nil {
+			return err
+		}
+	}
+	if err := patchWIPBadges(ctx, sse, s.Client); err != nil {
+		return err
+	}
+
+	// Re-rendered cards start with an empty avatar list
+	for _, id := range s.Presence.ActiveTasks() {
+		if err := s.patchPresence(ctx, sse, id); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -267,20 +382,6 @@ func (s *Server) handleActivityEvent(ctx context.Context, sse *datastar.ServerSe
 		_ = sse.PatchElements(htmlBuilder.String(),
 			datastar.WithModePrepend(),
 			datastar.WithSelector("#activity-timeline"))
-		
-		// Execute script to maintain max 30 entries
-		_ = sse.ExecuteScript(`
-			const timeline = document.getElementById('activity-timeline');
-			if (timeline) {
-				const items = timeline.querySelectorAll('li');
-				if (items.length > 30) {
-					// Remove items beyond 30
-					for (let i = 30; i < items.length; i++) {
-						items[i].remove();
-					}
-				}
-			}
-		`)
 	}
 	
 	return nil
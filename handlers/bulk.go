@@ -0,0 +1,432 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/history"
+	"github.com/j0hnsmith/botTaskTracker/tagregistry"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/wip"
+	"github.com/j0hnsmith/botTaskTracker/workflow"
+)
+
+// Bulk actions on selected tasks.
+const (
+	bulkMove    = "move"
+	bulkAssign  = "assign"
+	bulkTag     = "tag"
+	bulkUntag   = "untag"
+	bulkArchive = "archive"
+	bulkDelete  = "delete"
+)
+
+// bulkOp is one action applied to several board tasks at once.
+type bulkOp struct {
+	Action      string
+	IDs         []int
+	Column      string // for move
+	Assignee    string // for assign; empty unassigns
+	Tag         string // "key:value" for tag and untag
+	WIPOverride bool
+}
+
+// bulkRejection is a bulk action that fails validation, the workflow or a WIP
+// limit for one of its tasks. Nothing is changed.
+type bulkRejection struct {
+	reason string
+	breach *wip.Breach
+}
+
+func (e *bulkRejection) Error() string { return e.reason }
+
+// bulkUpdate applies op to every selected task in one transaction. Each task
+// that changes gets its own history entry and revision; the board hears about
+// all of it in a single batch broadcast. It returns the tasks that changed.
+func (s *Server) bulkUpdate(ctx context.Context, r *http.Request, op bulkOp) ([]*ent.Task, error) {
+	ids := slices.Compact(slices.Sorted(slices.Values(op.IDs)))
+	if len(ids) == 0 {
+		return nil, &bulkRejection{reason: "No tasks selected"}
+	}
+
+	switch op.Action {
+	case bulkMove:
+		op.Column = strings.ToLower(strings.TrimSpace(op.Column))
+		if sanitizeColumn(op.Column) != op.Column {
+			return nil, &bulkRejection{reason: "Unknown column"}
+		}
+	case bulkTag, bulkUntag:
+		op.Tag = strings.TrimSpace(op.Tag)
+		if tags := parseTags(op.Tag); len(tags) != 1 || strings.Contains(op.Tag, ",") {
+			return nil, &bulkRejection{reason: "Tag must be a single key:value pair"}
+		}
+	case bulkAssign, bulkArchive, bulkDelete:
+	default:
+		return nil, &bulkRejection{reason: fmt.Sprintf("Unknown bulk action %q", op.Action)}
+	}
+
+	tasks, err := s.Client.Task.Query().
+		Where(task.IDIn(ids...), onBoard()).
+		WithTags().
+		Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) != len(ids) {
+		for _, id := range ids {
+			if !slices.ContainsFunc(tasks, func(t *ent.Task) bool { return t.ID == id }) {
+				return nil, &bulkRejection{reason: fmt.Sprintf("Task #%d is no longer on the board", id)}
+			}
+		}
+	}
+	// Keep the board order so moved tasks land in the order they were in
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return slices.Index(boardColumns, tasks[i].Column) < slices.Index(boardColumns, tasks[j].Column)
+	})
+
+	var reg tagregistry.Registry
+	if op.Action == bulkTag {
+		if reg, err = loadTagRegistry(ctx, s.Client); err != nil {
+			return nil, err
+		}
+	}
+
+	actor := actorFromRequest(r)
+	var (
+		changed []int
+		entries []int
+		columns []string
+	)
+	touch := func(column string) {
+		if !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	err = withTx(ctx, s.Client, func(tx *ent.Tx) error {
+		client := tx.Client()
+		addHistory := func(taskID int, action, details string, changes []history.Change) error {
+			create := client.TaskHistory.Create().
+				SetTaskID(taskID).
+				SetAction(action).
+				SetDetails(details).
+				SetActor(actor)
+			if len(changes) > 0 {
+				create = create.SetChanges(changes)
+			}
+			entry, err := create.Save(ctx)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry.ID)
+			return nil
+		}
+
+		for _, t := range tasks {
+			before := taskSnapshot(t)
+			switch op.Action {
+			case bulkMove:
+				if t.Column == op.Column {
+					continue
+				}
+				subject := workflowSubject(r, t.Description, t.Assignee, before.Tags)
+				err := checkWorkflow(ctx, client, t.Column, op.Column, subject)
+				var rejection *workflow.Rejection
+				if errors.As(err, &rejection) {
+					return &bulkRejection{reason: fmt.Sprintf("#%d: %s", t.ID, rejection.Reason)}
+				}
+				if err != nil {
+					return err
+				}
+				// Earlier moves in this batch already count towards the limit
+				breach, breached, err := checkWIP(ctx, client, op.Column, t.Assignee, t.ID)
+				if err != nil {
+					return err
+				}
+				if breached && breach.Blocks(op.WIPOverride) {
+					return &bulkRejection{reason: fmt.Sprintf("#%d: %s", t.ID, breach.Error()), breach: &breach}
+				}
+				if err := recordBaselineRevision(ctx, client, t.ID); err != nil {
+					return err
+				}
+				position, err := getNextPosition(ctx, client, op.Column)
+				if err != nil {
+					return err
+				}
+				if err := reorderTasksOnColumnChange(ctx, client, t.ID, t.Column, op.Column, position); err != nil {
+					return err
+				}
+				if err := addHistory(t.ID, "moved", fmt.Sprintf("moved from %s to %s", t.Column, op.Column), nil); err != nil {
+					return err
+				}
+				if breached {
+					details := breach.Error() + " (" + wipOutcome(breach) + ")"
+					if err := addHistory(t.ID, "wip_exceeded", details, nil); err != nil {
+						return err
+					}
+				}
+				touch(t.Column)
+				touch(op.Column)
+
+			case bulkAssign:
+				if t.Assignee == op.Assignee {
+					continue
+				}
+				// Tasks reassigned earlier in this batch already count too
+				breach, breached, err := checkWIP(ctx, client, t.Column, op.Assignee, t.ID)
+				if err != nil {
+					return err
+				}
+				if breached && breach.Blocks(op.WIPOverride) {
+					return &bulkRejection{reason: fmt.Sprintf("#%d: %s", t.ID, breach.Error()), breach: &breach}
+				}
+				if err := recordBaselineRevision(ctx, client, t.ID); err != nil {
+					return err
+				}
+				if err := client.Task.UpdateOneID(t.ID).SetAssignee(op.Assignee).Exec(ctx); err != nil {
+					return err
+				}
+				details := "assigned to " + op.Assignee
+				if op.Assignee == "" {
+					details = "unassigned"
+				}
+				after := before
+				after.Assignee = op.Assignee
+				if err := addHistory(t.ID, "assigned", details, history.Diff(before, after)); err != nil {
+					return err
+				}
+				if breached {
+					details := breach.Error() + " (" + wipOutcome(breach) + ")"
+					if err := addHistory(t.ID, "wip_exceeded", details, nil); err != nil {
+						return err
+					}
+				}
+				touch(t.Column)
+
+			case bulkTag, bulkUntag:
+				has := slices.Contains(before.Tags, op.Tag)
+				after := before
+				switch {
+				case op.Action == bulkTag && !has:
+					after.Tags = append(slices.Clone(before.Tags), op.Tag)
+					if err := validateTags(reg, parseTags(strings.Join(after.Tags, ","))); err != nil {
+						return &bulkRejection{reason: fmt.Sprintf("#%d: %s", t.ID, strings.ReplaceAll(err.Error(), "\n", "; "))}
+					}
+				case op.Action == bulkUntag && has:
+					after.Tags = slices.DeleteFunc(slices.Clone(before.Tags), func(pair string) bool { return pair == op.Tag })
+				default:
+					continue
+				}
+				if err := recordBaselineRevision(ctx, client, t.ID); err != nil {
+					return err
+				}
+				if err := replaceTags(ctx, client, t.ID, after.Tags); err != nil {
+					return err
+				}
+				details := "added tag " + op.Tag
+				if op.Action == bulkUntag {
+					details = "removed tag " + op.Tag
+				}
+				if err := addHistory(t.ID, "tagged", details, history.Diff(before, after)); err != nil {
+					return err
+				}
+				touch(t.Column)
+
+			case bulkArchive:
+				if err := client.Task.UpdateOneID(t.ID).SetArchivedAt(time.Now()).Exec(ctx); err != nil {
+					return err
+				}
+				if err := addHistory(t.ID, "archived", fmt.Sprintf("archived from %s", t.Column), nil); err != nil {
+					return err
+				}
+				touch(t.Column)
+
+			case bulkDelete:
+				if err := client.Task.UpdateOneID(t.ID).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+					return err
+				}
+				if err := addHistory(t.ID, "deleted", fmt.Sprintf("moved to trash from %s", t.Column), nil); err != nil {
+					return err
+				}
+				touch(t.Column)
+			}
+
+			// Archived and deleted tasks keep their last revision
+			if op.Action != bulkArchive && op.Action != bulkDelete {
+				if _, err := recordRevision(ctx, client, t.ID, actor); err != nil {
+					return err
+				}
+			}
+			changed = append(changed, t.ID)
+		}
+
+		if op.Action == bulkArchive || op.Action == bulkDelete {
+			for _, column := range columns {
+				if err := recompactColumnPositions(ctx, client, column); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "bulk update", "action", op.Action, "selected", len(ids), "changed", len(changed))
+	if len(changed) > 0 {
+		s.Broadcaster.BroadcastBatch(columns, entries)
+	}
+
+	return s.Client.Task.Query().
+		Where(task.IDIn(changed...)).
+		WithTags().
+		WithFieldValues().
+		Order(ent.Asc(task.FieldID)).
+		All(ctx)
+}
+
+// bulkSummary describes a finished bulk action for the toast.
+func bulkSummary(op bulkOp, changed int) string {
+	n := fmt.Sprintf("%d tasks", changed)
+	if changed == 1 {
+		n = "1 task"
+	}
+	switch op.Action {
+	case bulkMove:
+		return fmt.Sprintf("Moved %s to %s", n, op.Column)
+	case bulkAssign:
+		if op.Assignee == "" {
+			return "Unassigned " + n
+		}
+		return fmt.Sprintf("Assigned %s to %s", n, op.Assignee)
+	case bulkTag:
+		return fmt.Sprintf("Tagged %s %s", n, op.Tag)
+	case bulkUntag:
+		return fmt.Sprintf("Removed %s from %s", op.Tag, n)
+	case bulkArchive:
+		return "Archived " + n
+	}
+	return "Moved " + n + " to the trash"
+}
+
+// bulkErrorHTML explains a rejected bulk action in the bulk bar. Soft WIP
+// blocks offer to repeat the action with the override signal.
+func bulkErrorHTML(action string, rejected *bulkRejection) string {
+	msg := html.EscapeString(rejected.reason)
+	if rejected.breach == nil || rejected.breach.Limit.Mode == wip.HardBlock {
+		return `<div id="bulk-error" class="text-error text-sm">` + msg + `</div>`
+	}
+	return `<div id="bulk-error" class="text-warning text-sm flex items-center gap-2"><span>` + msg + `</span>` +
+		`<button type="button" class="btn btn-xs" data-on:click="$wip_override = true; @post('/datastar/tasks/bulk?action=` + action + `')">Move anyway</button></div>`
+}
+
+// TaskBulkHandler applies ?action= to the tasks selected on the board.
+func (s *Server) TaskBulkHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var signals struct {
+		IDs         []int  `json:"bulk_ids"`
+		Column      string `json:"bulk_column"`
+		Assignee    string `json:"bulk_assignee"`
+		Tag         string `json:"bulk_tag"`
+		WIPOverride bool   `json:"wip_override"`
+	}
+	if err := datastar.ReadSignals(r, &signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	op := bulkOp{
+		Action:      r.URL.Query().Get("action"),
+		IDs:         signals.IDs,
+		Column:      signals.Column,
+		Assignee:    strings.TrimSpace(signals.Assignee),
+		Tag:         signals.Tag,
+		WIPOverride: signals.WIPOverride,
+	}
+	changed, err := s.bulkUpdate(ctx, r, op)
+	var rejected *bulkRejection
+	switch {
+	case errors.As(err, &rejected):
+		_ = sse.PatchElements(bulkErrorHTML(op.Action, rejected))
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "bulk update failed", "action", op.Action, "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.PatchSignals([]byte(`{"bulk_ids": [], "wip_override": false}`))
+	_ = sse.PatchElements(`<div id="bulk-error" class="text-sm"></div>`)
+	patchToast(ctx, sse, fragments.UndoToast(bulkSummary(op, len(changed)), ""))
+}
+
+// APITaskBatchHandler applies one action to several tasks in one transaction:
+//
+//	{"ids": [1, 2, 3], "action": "move", "column": "review"}
+//
+// Actions are move (column), assign (assignee), tag and untag (tag), archive
+// and delete. Either every task is updated or none is: invalid input and
+// workflow rejections are 422, WIP limit blocks 409; override confirms a soft
+// limit. The response lists the tasks that changed.
+func (s *Server) APITaskBatchHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req struct {
+		IDs      []int  `json:"ids"`
+		Action   string `json:"action"`
+		Column   string `json:"column"`
+		Assignee string `json:"assignee"`
+		Tag      string `json:"tag"`
+		Override bool   `json:"override"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	changed, err := s.bulkUpdate(ctx, r, bulkOp{
+		Action:      req.Action,
+		IDs:         req.IDs,
+		Column:      req.Column,
+		Assignee:    strings.TrimSpace(req.Assignee),
+		Tag:         req.Tag,
+		WIPOverride: req.Override,
+	})
+	var rejected *bulkRejection
+	switch {
+	case errors.As(err, &rejected):
+		status := http.StatusUnprocessableEntity
+		if rejected.breach != nil {
+			status = http.StatusConflict
+		}
+		writeJSONError(w, status, rejected.reason)
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "bulk update failed", "action", req.Action, "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to update tasks")
+		return
+	}
+
+	out := make([]apiTask, len(changed))
+	for i, t := range changed {
+		out[i] = toAPITask(t)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"action": req.Action, "tasks": out})
+}
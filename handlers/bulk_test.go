@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+func TestBatchMove(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	a := ts.createTask(t, map[string]any{"title": "A", "column": "backlog"})
+	b := ts.createTask(t, map[string]any{"title": "B", "column": "backlog"})
+
+	rec := ts.do(t, http.MethodPost, "/api/tasks/batch", map[string]any{
+		"ids": []int{a.ID, b.ID}, "action": "move", "column": "review",
+	})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("batch move: %d %s", rec.Code, rec.Body)
+	}
+	var resp struct {
+		Tasks []apiTask `json:"tasks"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tasks) != 2 {
+		t.Errorf("%d tasks in the response, want 2", len(resp.Tasks))
+	}
+	for _, id := range []int{a.ID, b.ID} {
+		if got := ts.column(t, id); got != "review" {
+			t.Errorf("#%d column = %q, want review", id, got)
+		}
+	}
+	if n := ts.Client.TaskHistory.Query().Where(taskhistory.ActionEQ("moved")).CountX(ctx); n != 2 {
+		t.Errorf("%d move entries, want 2", n)
+	}
+}
+
+func TestBatchIsAllOrNothing(t *testing.T) {
+	t.Run("workflow rejects one task", func(t *testing.T) {
+		ts := newTestServer(t)
+		ctx := context.Background()
+		ts.Client.Transition.Create().SetFromColumn("backlog").SetToColumn("review").ExecX(ctx)
+		a := ts.createTask(t, map[string]any{"title": "Allowed", "column": "backlog"})
+		b := ts.createTask(t, map[string]any{"title": "Not allowed", "column": "in_progress"})
+
+		rec := ts.do(t, http.MethodPost, "/api/tasks/batch", map[string]any{
+			"ids": []int{a.ID, b.ID}, "action": "move", "column": "review",
+		})
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("batch move: %d %s", rec.Code, rec.Body)
+		}
+		if got := ts.column(t, a.ID); got != "backlog" {
+			t.Errorf("allowed task moved to %q although the batch failed", got)
+		}
+		if ts.Client.TaskHistory.Query().Where(taskhistory.ActionEQ("moved")).ExistX(ctx) {
+			t.Error("history kept a move from the failed batch")
+		}
+	})
+
+	t.Run("registry rejects one task", func(t *testing.T) {
+		ts := newTestServer(t)
+		ctx := context.Background()
+		a := ts.createTask(t, map[string]any{"title": "Untyped", "column": "backlog"})
+		b := ts.createTask(t, map[string]any{"title": "Typed", "column": "backlog", "tags": []string{"type:bug"}})
+		ts.Client.TagDefinition.Update().Where(tagdefinition.KeyEQ("type")).SetMultiValued(false).ExecX(ctx)
+
+		rec := ts.do(t, http.MethodPost, "/api/tasks/batch", map[string]any{
+			"ids": []int{a.ID, b.ID}, "action": "tag", "tag": "type:feature",
+		})
+		if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "one value") {
+			t.Fatalf("batch tag: %d %s", rec.Code, rec.Body)
+		}
+		if tags := ts.Client.Task.Query().Where(task.IDEQ(a.ID)).QueryTags().CountX(ctx); tags != 0 {
+			t.Errorf("untyped task kept %d tags from the failed batch", tags)
+		}
+		if ts.Client.TaskHistory.Query().Where(taskhistory.ActionEQ("tagged")).ExistX(ctx) {
+			t.Error("history kept a tag from the failed batch")
+		}
+	})
+}
+
+func TestBatchAssignChecksWIP(t *testing.T) {
+	t.Run("hard limit", func(t *testing.T) {
+		ts := newTestServer(t)
+		ctx := context.Background()
+		ts.Client.WipLimit.Create().SetColumn("backlog").SetAssignee("peter").SetMaxTasks(1).SetMode(wiplimit.ModeHard).ExecX(ctx)
+		a := ts.createTask(t, map[string]any{"title": "A", "column": "backlog"})
+		b := ts.createTask(t, map[string]any{"title": "B", "column": "backlog"})
+
+		rec := ts.do(t, http.MethodPost, "/api/tasks/batch", map[string]any{
+			"ids": []int{a.ID, b.ID}, "action": "assign", "assignee": "peter",
+		})
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("batch assign past a hard limit: %d %s", rec.Code, rec.Body)
+		}
+		if n := ts.Client.Task.Query().Where(task.AssigneeEQ("peter")).CountX(ctx); n != 0 {
+			t.Errorf("%d tasks assigned although the batch failed", n)
+		}
+	})
+
+	t.Run("warning", func(t *testing.T) {
+		ts := newTestServer(t)
+		ctx := context.Background()
+		ts.Client.WipLimit.Create().SetColumn("backlog").SetAssignee("peter").SetMaxTasks(1).SetMode(wiplimit.ModeWarn).ExecX(ctx)
+		a := ts.createTask(t, map[string]any{"title": "A", "column": "backlog"})
+		b := ts.createTask(t, map[string]any{"title": "B", "column": "backlog"})
+
+		rec := ts.do(t, http.MethodPost, "/api/tasks/batch", map[string]any{
+			"ids": []int{a.ID, b.ID}, "action": "assign", "assignee": "peter",
+		})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("batch assign past a warning: %d %s", rec.Code, rec.Body)
+		}
+		entry := ts.Client.TaskHistory.Query().Where(taskhistory.ActionEQ("wip_exceeded")).OnlyX(ctx)
+		if !strings.Contains(entry.Details, "warned") {
+			t.Errorf("breach entry = %q", entry.Details)
+		}
+	})
+}
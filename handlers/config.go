@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds settings read from the environment at startup.
+type Config struct {
+	// TrashRetention is how long deleted tasks stay in the trash before they
+	// are purged for good (TRASH_RETENTION_DAYS, default 30).
+	TrashRetention time.Duration
+
+	// ArchiveDoneAfter archives tasks that have been in done for this long
+	// (ARCHIVE_DONE_AFTER_DAYS, default 14, 0 disables).
+	ArchiveDoneAfter time.Duration
+
+	// IdleAlertAfter flags an assignee who holds in-progress tasks but hasn't
+	// changed anything for this long (IDLE_ALERT_AFTER_HOURS, default 24, 0
+	// disables).
+	IdleAlertAfter time.Duration
+
+	// ReportWebhookURL receives the scheduled standup and weekly reports
+	// (REPORT_WEBHOOK_URL, empty disables posting).
+	ReportWebhookURL string
+
+	// StandupSchedule and WeeklySchedule are when the reports are posted, as
+	// cron or RRULE (STANDUP_REPORT_SCHEDULE, default weekdays at 09:00, and
+	// WEEKLY_REPORT_SCHEDULE, default Mondays at 09:00; empty disables).
+	StandupSchedule string
+	WeeklySchedule  string
+}
+
+// LoadConfig reads the configuration from environment variables.
+func LoadConfig() Config {
+	return Config{
+		TrashRetention:   envDays("TRASH_RETENTION_DAYS", 30),
+		ArchiveDoneAfter: envDays("ARCHIVE_DONE_AFTER_DAYS", 14),
+		IdleAlertAfter:   envHours("IDLE_ALERT_AFTER_HOURS", 24),
+		ReportWebhookURL: os.Getenv("REPORT_WEBHOOK_URL"),
+		StandupSchedule:  envString("STANDUP_REPORT_SCHEDULE", "0 9 * * MON-FRI"),
+		WeeklySchedule:   envString("WEEKLY_REPORT_SCHEDULE", "0 9 * * MON"),
+	}
+}
+
+// envDays reads a whole number of days from an environment variable.
+func envDays(name string, fallback int) time.Duration {
+	return envUnits(name, fallback, 24*time.Hour)
+}
+
+// envHours reads a whole number of hours from an environment variable.
+func envHours(name string, fallback int) time.Duration {
+	return envUnits(name, fallback, time.Hour)
+}
+
+// envUnits reads a whole, non-negative number of units from an environment
+// variable.
+func envUnits(name string, fallback int, unit time.Duration) time.Duration {
+	n := fallback
+	if raw := os.Getenv(name); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			slog.Warn("ignoring invalid config value", "name", name, "value", raw)
+		} else {
+			n = parsed
+		}
+	}
+	return time.Duration(n) * unit
+}
+
+// envString reads an environment variable, using fallback only when it is
+// unset so that an empty value can switch a default off.
+func envString(name, fallback string) string {
+	if raw, ok := os.LookupEnv(name); ok {
+		return raw
+	}
+	return fallback
+}
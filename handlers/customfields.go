@@ -0,0 +1,304 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/customfield"
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/fielddefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// fieldFilterPrefix marks query parameters that filter on custom fields,
+// e.g. ?field.env=prod.
+const fieldFilterPrefix = "field."
+
+func toDefinition(f *ent.FieldDefinition) customfield.Definition {
+	return customfield.Definition{
+		Key:      f.Key,
+		Label:    f.Label,
+		Type:     customfield.Type(f.Type),
+		Required: f.Required,
+		Default:  f.DefaultValue,
+		Options:  f.Options,
+	}
+}
+
+// loadFieldDefinitions returns the board's custom fields in form order.
+func loadFieldDefinitions(ctx context.Context, client *ent.Client) ([]customfield.Definition, error) {
+	rows, err := client.FieldDefinition.Query().
+		Order(ent.Asc(fielddefinition.FieldPosition), ent.Asc(fielddefinition.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defs := make([]customfield.Definition, len(rows))
+	for i, row := range rows {
+		defs[i] = toDefinition(row)
+	}
+	return defs, nil
+}
+
+// taskFieldValues returns the custom field values of a task loaded with them.
+func taskFieldValues(t *ent.Task) map[string]string {
+	values := make(map[string]string, len(t.Edges.FieldValues))
+	for _, fv := range t.Edges.FieldValues {
+		values[fv.Key] = fv.Value
+	}
+	return values
+}
+
+// replaceFieldValues swaps a task's custom field values for the given set.
+func replaceFieldValues(ctx context.Context, client *ent.Client, taskID int, values map[string]string) error {
+	if _, err := client.TaskFieldValue.Delete().Where(taskfieldvalue.HasTaskWith(task.IDEQ(taskID))).Exec(ctx); err != nil {
+		return err
+	}
+	for key, value := range values {
+		if err := client.TaskFieldValue.Create().
+			SetTaskID(taskID).
+			SetKey(key).
+			SetValue(value).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// signalFieldValues converts the "fields" signal object into raw strings.
+// Checkboxes arrive as booleans and number inputs may arrive as numbers.
+func signalFieldValues(raw map[string]any) map[string]string {
+	values := make(map[string]string, len(raw))
+	for key, v := range raw {
+		switch v := v.(type) {
+		case nil:
+		case string:
+			values[key] = v
+		case float64:
+			values[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+	return values
+}
+
+// fieldSignals is the inverse of signalFieldValues, used to fill the forms.
+func fieldSignals(defs []customfield.Definition, values map[string]string) map[string]any {
+	signals := make(map[string]any, len(defs))
+	for _, d := range defs {
+		if d.Type == customfield.Boolean {
+			signals[d.Key] = values[d.Key] == "true"
+		} else {
+			signals[d.Key] = values[d.Key]
+		}
+	}
+	return signals
+}
+
+// fieldErrors formats validation errors for the add/edit forms.
+func fieldErrors(defs []customfield.Definition, errs map[string]string) string {
+	var messages []string
+	for _, d := range defs {
+		if msg, ok := errs[d.Key]; ok {
+			messages = append(messages, msg)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// fieldPredicates builds filters from ?field.<key>=<value> parameters. Values
+// are normalized like stored values, so ?field.flag=TRUE matches "true".
+func fieldPredicates(defs []customfield.Definition, q url.Values) ([]predicate.Task, error) {
+	byKey := make(map[string]customfield.Definition, len(defs))
+	for _, d := range defs {
+		byKey[d.Key] = d
+	}
+
+	var keys []string
+	for param := range q {
+		if strings.HasPrefix(param, fieldFilterPrefix) {
+			keys = append(keys, strings.TrimPrefix(param, fieldFilterPrefix))
+		}
+	}
+	sort.Strings(keys)
+
+	var preds []predicate.Task
+	for _, key := range keys {
+		d, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+		value, err := d.Normalize(q.Get(fieldFilterPrefix + key))
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			// ?field.key= matches tasks without a value
+			preds = append(preds, task.Not(task.HasFieldValuesWith(taskfieldvalue.KeyEQ(key))))
+			continue
+		}
+		preds = append(preds, task.HasFieldValuesWith(taskfieldvalue.KeyEQ(key), taskfieldvalue.ValueEQ(value)))
+	}
+	return preds, nil
+}
+
+// FieldsPageHandler lists the board's custom fields with a form to add more.
+func (s *Server) FieldsPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	fields, err := s.Client.FieldDefinition.Query().
+		Order(ent.Asc(fielddefinition.FieldPosition), ent.Asc(fielddefinition.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get custom fields", "error", err)
+		http.Error(w, "Failed to load fields", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.FieldsContent(fields, customfield.Types)
+	page := templates.Layout("Custom fields - Bot Task Tracker", pages.FieldsMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// FieldCreateHandler adds a custom field to the board.
+func (s *Server) FieldCreateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type FieldCreateSignals struct {
+		Key      string `json:"field_key"`
+		Label    string `json:"field_label"`
+		Type     string `json:"field_type"`
+		Required bool   `json:"field_required"`
+		Default  string `json:"field_default"`
+		Options  string `json:"field_options"`
+	}
+	signals := &FieldCreateSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	var options []string
+	for _, option := range strings.Split(signals.Options, ",") {
+		if option = strings.TrimSpace(option); option != "" {
+			options = append(options, option)
+		}
+	}
+	def := customfield.Definition{
+		Key:      strings.TrimSpace(signals.Key),
+		Label:    strings.TrimSpace(signals.Label),
+		Type:     customfield.Type(signals.Type),
+		Required: signals.Required,
+		Default:  strings.TrimSpace(signals.Default),
+		Options:  options,
+	}
+	if def.Label == "" {
+		def.Label = def.Key
+	}
+	if err := def.Check(); err != nil {
+		_ = sse.PatchElements(`<div id="field-error" class="alert alert-error text-sm">` + html.EscapeString(err.Error()) + `</div>`)
+		return
+	}
+	if def.Default != "" {
+		def.Default, _ = def.Normalize(def.Default)
+	}
+
+	count, err := s.Client.FieldDefinition.Query().Count(ctx)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := s.Client.FieldDefinition.Create().
+		SetKey(def.Key).
+		SetLabel(def.Label).
+		SetType(fielddefinition.Type(def.Type)).
+		SetRequired(def.Required).
+		SetDefaultValue(def.Default).
+		SetOptions(def.Options).
+		SetPosition(count).
+		Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			_ = sse.PatchElements(`<div id="field-error" class="alert alert-error text-sm">A field with that key already exists</div>`)
+			return
+		}
+		slog.ErrorContext(ctx, "failed to create custom field", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/fields")
+}
+
+// FieldDeleteHandler removes a custom field and every task's value for it.
+func (s *Server) FieldDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid field ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	f, err := s.Client.FieldDefinition.Get(ctx, id)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if _, err := s.Client.TaskFieldValue.Delete().Where(taskfieldvalue.KeyEQ(f.Key)).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete field values", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := s.Client.FieldDefinition.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete custom field", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.RemoveElement("#field-row-" + strconv.Itoa(id))
+}
+
+// APIFieldListHandler returns the board's custom field definitions as JSON.
+func (s *Server) APIFieldListHandler(w http.ResponseWriter, r *http.Request) {
+	defs, err := loadFieldDefinitions(r.Context(), s.Client)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to list fields")
+		return
+	}
+
+	type apiField struct {
+		Key      string   `json:"key"`
+		Label    string   `json:"label"`
+		Type     string   `json:"type"`
+		Required bool     `json:"required"`
+		Default  string   `json:"default,omitempty"`
+		Options  []string `json:"options,omitempty"`
+	}
+	result := make([]apiField, len(defs))
+	for i, d := range defs {
+		result[i] = apiField{d.Key, d.Label, string(d.Type), d.Required, d.Default, d.Options}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/metrics"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// asOfLayout is the format of <input type="datetime-local">.
+const asOfLayout = "2006-01-02T15:04"
+
+// parseAsOf reads the moment to replay the board at. A bare date means the
+// end of that day; an empty value means now.
+func parseAsOf(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return now, nil
+	}
+	if day, err := time.ParseInLocation(dueLayout, value, time.Local); err == nil {
+		return day.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	if at, err := time.ParseInLocation(asOfLayout, value, time.Local); err == nil {
+		return at, nil
+	}
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: use YYYY-MM-DD, YYYY-MM-DDTHH:MM or RFC 3339", value)
+}
+
+// cumulativeFlow counts the tasks in each column at the end of every day in
+// the filter's range, up to today. Trashed tasks count until they were
+// deleted.
+func (s *Server) cumulativeFlow(ctx context.Context, filter analyticsFilter) ([]metrics.Day, error) {
+	tasks, err := loadFlowTasks(ctx, s.Client, filter.Assignee, filter.Tag, true)
+	if err != nil {
+		return nil, err
+	}
+	until := filter.Until
+	if now := time.Now(); now.Before(until) {
+		until = now
+	}
+	return metrics.CumulativeFlow(tasks, filter.Since, until), nil
+}
+
+// boardAt replays the board at a moment from task history.
+func (s *Server) boardAt(ctx context.Context, at time.Time) (map[string][]metrics.Task, error) {
+	tasks, err := loadFlowTasks(ctx, s.Client, "", "", true)
+	if err != nil {
+		return nil, err
+	}
+	return metrics.BoardAt(tasks, at), nil
+}
+
+// BoardAsOfHandler shows a read-only board as it was at ?at=, replayed from
+// task history.
+func (s *Server) BoardAsOfHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	at, err := parseAsOf(r.URL.Query().Get("at"), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	board, err := s.boardAt(ctx, at)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to replay board", "error", err)
+		http.Error(w, "Failed to load board", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.BoardAsOfContent(board, at, boardColumns)
+	page := templates.Layout("Board as of "+at.Format(dueLayout)+" - Bot Task Tracker", pages.BoardAsOfMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// apiBoardTask is a task on a replayed board. Title and assignee are the
+// task's current ones; history doesn't replay edits.
+type apiBoardTask struct {
+	ID       int    `json:"id"`
+	Title    string `json:"title"`
+	Assignee string `json:"assignee"`
+}
+
+// APIBoardHandler returns the tasks in each column at ?at= (default now).
+func (s *Server) APIBoardHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	at, err := parseAsOf(r.URL.Query().Get("at"), time.Now())
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	board, err := s.boardAt(ctx, at)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to replay board", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to replay board")
+		return
+	}
+
+	columns := make(map[string][]apiBoardTask, len(boardColumns))
+	for _, column := range boardColumns {
+		tasks := make([]apiBoardTask, len(board[column]))
+		for i, t := range board[column] {
+			tasks[i] = apiBoardTask{ID: t.ID, Title: t.Title, Assignee: t.Assignee}
+		}
+		columns[column] = tasks
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"at":      at,
+		"columns": columns,
+	})
+}
+
+// APIFlowHandler returns the cumulative flow diagram's daily counts, with the
+// analytics filters.
+func (s *Server) APIFlowHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseAnalyticsFilter(r.URL.Query(), time.Now())
+
+	flow, err := s.cumulativeFlow(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute cumulative flow", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to compute cumulative flow")
+		return
+	}
+
+	type apiDay struct {
+		Date   string         `json:"date"`
+		Counts map[string]int `json:"counts"`
+	}
+	days := make([]apiDay, len(flow))
+	for i, day := range flow {
+		counts := make(map[string]int, len(boardColumns))
+		for _, column := range boardColumns {
+			counts[column] = day.Counts[column]
+		}
+		days[i] = apiDay{Date: day.Date.Format(dueLayout), Counts: counts}
+	}
+
+	q := filter.values()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"since": q.Get("since"),
+		"until": q.Get("until"),
+		"days":  days,
+	})
+}
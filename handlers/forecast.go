@@ -0,0 +1,273 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/forecast"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// Forecast defaults: how far ahead "how many" looks, and how many trials run.
+const (
+	forecastDays   = 14
+	forecastTrials = 10000
+	maxTrials      = 100000
+)
+
+// forecastQuery is what to forecast. Throughput is sampled from tasks
+// completed in History; Tag only selects the remaining items.
+type forecastQuery struct {
+	History analyticsFilter
+	Today   time.Time // start of today; forecasts count days after it
+	By      time.Time // "how many by" date
+	Items   int       // remaining items; 0 counts the open tasks matching Tag
+	Tag     string    // "key:value"
+	Trials  int
+	Seed    uint64
+}
+
+// parseForecastQuery reads a forecast from query parameters. Without a seed
+// a random one is picked, so every result can be reproduced from its URL.
+func parseForecastQuery(q url.Values, now time.Time) (forecastQuery, error) {
+	history := parseAnalyticsFilter(q, now)
+	history.Tag = ""
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	fq := forecastQuery{
+		History: history,
+		Today:   today,
+		By:      today.AddDate(0, 0, forecastDays),
+		Tag:     strings.TrimSpace(q.Get("tag")),
+		Trials:  forecastTrials,
+		Seed:    rand.Uint64N(1_000_000),
+	}
+	if v := q.Get("by"); v != "" {
+		by, err := time.ParseInLocation(dueLayout, v, time.Local)
+		if err != nil {
+			return fq, fmt.Errorf("invalid date %q: use YYYY-MM-DD", v)
+		}
+		if !by.After(today) {
+			return fq, errors.New("the forecast date must be after today")
+		}
+		fq.By = by
+	}
+	if v := strings.TrimSpace(q.Get("items")); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return fq, errors.New("items must be a whole number, at least 1")
+		}
+		fq.Items = n
+	}
+	if v := q.Get("trials"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxTrials {
+			return fq, fmt.Errorf("trials must be between 1 and %d", maxTrials)
+		}
+		fq.Trials = n
+	}
+	if v := q.Get("seed"); v != "" {
+		seed, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fq, fmt.Errorf("invalid seed %q", v)
+		}
+		fq.Seed = seed
+	}
+	return fq, nil
+}
+
+// values encodes the query back into parameters, seed included.
+func (fq forecastQuery) values() url.Values {
+	q := fq.History.values()
+	q.Set("by", fq.By.Format(dueLayout))
+	if fq.Items > 0 {
+		q.Set("items", strconv.Itoa(fq.Items))
+	}
+	if fq.Tag != "" {
+		q.Set("tag", fq.Tag)
+	}
+	q.Set("trials", strconv.Itoa(fq.Trials))
+	q.Set("seed", strconv.FormatUint(fq.Seed, 10))
+	return q
+}
+
+// days is the number of days from today to by.
+func (fq forecastQuery) days() int {
+	return int(fq.By.Sub(fq.Today).Round(24*time.Hour) / (24 * time.Hour))
+}
+
+// forecastResult holds both forecasts for a query.
+type forecastResult struct {
+	Daily     []int // completions per day in the history window
+	HowMany   forecast.Forecast
+	Remaining int
+	When      forecast.Forecast // zero when nothing remains
+}
+
+// forecast samples daily throughput from the history window and runs both
+// simulations. It returns forecast.ErrNoThroughput when nothing was
+// completed in the window.
+func (s *Server) forecast(ctx context.Context, fq forecastQuery) (forecastResult, error) {
+	var res forecastResult
+	tasks, err := loadFlowTasks(ctx, s.Client, fq.History.Assignee, "", false)
+	if err != nil {
+		return res, err
+	}
+	var completed []time.Time
+	for _, t := range tasks {
+		if done, ok := t.Timeline.Completed(doneColumn); ok {
+			completed = append(completed, done)
+		}
+	}
+	until := fq.History.Until
+	if fq.Today.AddDate(0, 0, 1).Before(until) {
+		until = fq.Today.AddDate(0, 0, 1) // future days would dilute the sample
+	}
+	res.Daily = forecast.Daily(completed, fq.History.Since, until)
+
+	res.Remaining = fq.Items
+	if res.Remaining == 0 {
+		query := s.Client.Task.Query().Where(onBoard(), task.ColumnNEQ(doneColumn))
+		if fq.History.Assignee != "" {
+			query = query.Where(task.AssigneeEQ(fq.History.Assignee))
+		}
+		if key, value, ok := strings.Cut(fq.Tag, ":"); ok {
+			query = query.Where(task.HasTagsWith(tasktag.KeyEQ(key), tasktag.ValueEQ(value)))
+		}
+		if res.Remaining, err = query.Count(ctx); err != nil {
+			return res, err
+		}
+	}
+
+	if res.HowMany, err = forecast.HowMany(res.Daily, fq.days(), fq.Trials, fq.Seed); err != nil {
+		return res, err
+	}
+	if res.Remaining > 0 {
+		if res.When, err = forecast.When(res.Daily, res.Remaining, fq.Trials, fq.Seed); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// noThroughputNotice explains an empty history window.
+func noThroughputNotice(fq forecastQuery) string {
+	q := fq.History.values()
+	return "No tasks were completed between " + q.Get("since") + " and " + q.Get("until") +
+		", so there is no throughput to simulate. Widen the history window."
+}
+
+// ForecastPageHandler answers "how many items by a date" and "when will these
+// items be done" by Monte Carlo simulation over historical throughput.
+func (s *Server) ForecastPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var (
+		res    forecastResult
+		notice string
+	)
+	fq, err := parseForecastQuery(r.URL.Query(), time.Now())
+	if err != nil {
+		notice = err.Error()
+	} else {
+		res, err = s.forecast(ctx, fq)
+		switch {
+		case errors.Is(err, forecast.ErrNoThroughput):
+			notice = noThroughputNotice(fq)
+		case err != nil:
+			slog.ErrorContext(ctx, "failed to compute forecast", "error", err)
+			http.Error(w, "Failed to load forecast", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	bodyContent := pages.ForecastContent(fq.values(), fq.Today, fq.By, res.Daily, res.HowMany, res.Remaining, res.When, notice, defaultAssignees)
+	page := templates.Layout("Forecast - Bot Task Tracker", pages.ForecastMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// apiForecastLevel is one confidence level of a forecast.
+type apiForecastLevel struct {
+	Confidence int    `json:"confidence"`
+	Items      *int   `json:"items,omitempty"` // how many: at least this many by the date
+	Days       *int   `json:"days,omitempty"`  // when: done within this many days
+	Date       string `json:"date,omitempty"`  // when: done by this date
+}
+
+// APIForecastHandler returns the forecast page's numbers for the same query
+// parameters: by, items, tag, assignee, since, until, trials and seed.
+func (s *Server) APIForecastHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	fq, err := parseForecastQuery(r.URL.Query(), time.Now())
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := s.forecast(ctx, fq)
+	if errors.Is(err, forecast.ErrNoThroughput) {
+		writeJSONError(w, http.StatusUnprocessableEntity, noThroughputNotice(fq))
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute forecast", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to compute forecast")
+		return
+	}
+
+	howMany := make([]apiForecastLevel, len(res.HowMany.Levels))
+	for i, l := range res.HowMany.Levels {
+		items := l.Value
+		howMany[i] = apiForecastLevel{Confidence: l.Confidence, Items: &items}
+	}
+	when := []apiForecastLevel{}
+	for _, l := range res.When.Levels {
+		days := l.Value
+		level := apiForecastLevel{Confidence: l.Confidence, Days: &days}
+		if days < forecast.MaxDays {
+			level.Date = fq.Today.AddDate(0, 0, days).Format(dueLayout)
+		}
+		when = append(when, level)
+	}
+	completed := 0
+	for _, n := range res.Daily {
+		completed += n
+	}
+
+	q := fq.values()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"seed":   fq.Seed,
+		"trials": fq.Trials,
+		"history": map[string]any{
+			"since":     q.Get("since"),
+			"until":     q.Get("until"),
+			"assignee":  fq.History.Assignee,
+			"days":      len(res.Daily),
+			"completed": completed,
+		},
+		"how_many": map[string]any{
+			"by":     q.Get("by"),
+			"days":   fq.days(),
+			"levels": howMany,
+		},
+		"when": map[string]any{
+			"items":  res.Remaining,
+			"tag":    fq.Tag,
+			"levels": when,
+		},
+	})
+}
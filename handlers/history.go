@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/history"
+)
+
+// recordHistory creates a history entry for a task and broadcasts it to the
+// activity feed. changes may be nil for entries without field-level diffs.
+func (s *Server) recordHistory(ctx context.Context, taskID int, action, details, actor string, changes []history.Change) (*ent.TaskHistory, error) {
+	create := s.Client.TaskHistory.Create().
+		SetTaskID(taskID).
+		SetAction(action).
+		SetDetails(details).
+		SetActor(actor)
+	if len(changes) > 0 {
+		create = create.SetChanges(changes)
+	}
+
+	entry, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Broadcaster.BroadcastActivity(entry.ID)
+	return entry, nil
+}
+
+// taskSnapshot captures the user-editable fields of a task loaded with its tags
+// and custom field values.
+func taskSnapshot(t *ent.Task) history.Snapshot {
+	tags := make([]string, len(t.Edges.Tags))
+	for i, tag := range t.Edges.Tags {
+		tags[i] = tag.Key + ":" + tag.Value
+	}
+	return history.Snapshot{
+		Title:       t.Title,
+		Description: t.Description,
+		Column:      t.Column,
+		Assignee:    t.Assignee,
+		Priority:    string(t.Priority),
+		Due:         formatDue(t.DueAt),
+		Tags:        tags,
+		Fields:      taskFieldValues(t),
+	}
+}
+
+// inputSnapshot builds the snapshot a task will have after saving form input.
+func inputSnapshot(title, description, column, assignee string, priority task.Priority, due *time.Time, tags []tagInput, fields map[string]string) history.Snapshot {
+	pairs := make([]string, len(tags))
+	for i, tag := range tags {
+		pairs[i] = tag.Key + ":" + tag.Value
+	}
+	return history.Snapshot{
+		Title:       title,
+		Description: description,
+		Column:      column,
+		Assignee:    assignee,
+		Priority:    string(priority),
+		Due:         formatDue(due),
+		Tags:        pairs,
+		Fields:      fields,
+	}
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// actorCookie remembers which identity a browser session picked in the navbar.
+const actorCookie = "btt_actor"
+
+// actorFromRequest returns who is making the request. Bots identify themselves
+// with an X-Actor header; browsers carry the identity picked in the navbar as a
+// cookie, which also works for EventSource connections that cannot set headers.
+func actorFromRequest(r *http.Request) string {
+	if actor := strings.TrimSpace(r.Header.Get("X-Actor")); actor != "" {
+		return actor
+	}
+	if cookie, err := r.Cookie(actorCookie); err == nil {
+		return strings.TrimSpace(cookie.Value)
+	}
+	return ""
+}
+
+// actorIsHuman reports whether the request comes from a person: a browser
+// session that picked an identity in the navbar. Bots send X-Actor, but one
+// that leaves it out has no identity either, so an unknown caller is never
+// taken for a human.
+func actorIsHuman(r *http.Request) bool {
+	if strings.TrimSpace(r.Header.Get("X-Actor")) != "" {
+		return false
+	}
+	cookie, err := r.Cookie(actorCookie)
+	return err == nil && strings.TrimSpace(cookie.Value) != ""
+}
+
+// clientIDFromRequest returns the per-tab client nonce generated by the board
+// page. It is sent as a header on fetch/Datastar requests and as a query
+// parameter on the SSE connection.
+func clientIDFromRequest(r *http.Request) string {
+	if id := r.Header.Get("X-Client-Nonce"); id != "" {
+		return id
+	}
+	return r.URL.Query().Get("client")
+}
+
+// IdentityHandler stores the chosen identity in a cookie and returns to the board.
+func (s *Server) IdentityHandler(w http.ResponseWriter, r *http.Request) {
+	actor := strings.TrimSpace(r.URL.Query().Get("actor"))
+	cookie := &http.Cookie{
+		Name:     actorCookie,
+		Value:    actor,
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  time.Now().AddDate(1, 0, 0),
+	}
+	if actor == "" {
+		cookie.MaxAge = -1
+	}
+	http.SetCookie(w, cookie)
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// StartJobs runs the server's periodic background jobs until ctx is cancelled.
+func (s *Server) StartJobs(ctx context.Context) {
+	go runEvery(ctx, time.Hour, "purge trash", s.purgeExpiredTrash)
+	go runEvery(ctx, time.Hour, "archive done tasks", s.archiveStaleDone)
+	go runEvery(ctx, 15*time.Minute, "overdue automations", s.emitOverdueEvents)
+	go runEvery(ctx, time.Minute, "recurring tasks", s.runRecurringTasks)
+	go runEvery(ctx, time.Hour, "idle assignees", s.warnIdleAssignees)
+	go runEvery(ctx, 24*time.Hour, "stuck tasks", s.flagStuckTasks)
+	if s.Config.ReportWebhookURL != "" {
+		go runEvery(ctx, time.Minute, "scheduled reports", s.scheduledReports(time.Now()))
+	}
+	go s.runAutomations(ctx)
+}
+
+// runEvery runs fn once immediately and then at every interval.
+func runEvery(ctx context.Context, interval time.Duration, name string, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := fn(ctx); err != nil {
+			slog.ErrorContext(ctx, "background job failed", "job", name, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
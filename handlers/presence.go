@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/starfederation/datastar-go/datastar"
+)
+
+// presenceTTL is how long a client stays visible without a heartbeat.
+// Browsers send a heartbeat every 15 seconds while the board is open.
+const presenceTTL = 45 * time.Second
+
+// presenceEntry describes what a single connected client has open.
+type presenceEntry struct {
+	Actor    string
+	TaskID   int // 0 when no task modal is open
+	Editing  bool
+	LastSeen time.Time
+}
+
+// Presence tracks which task modal each connected client has open.
+type Presence struct {
+	mu      sync.Mutex
+	clients map[string]*presenceEntry
+}
+
+// NewPresence creates an empty presence tracker
+func NewPresence() *Presence {
+	return &Presence{
+		clients: make(map[string]*presenceEntry),
+	}
+}
+
+// Set records what a client currently has open. It returns the IDs of tasks
+// whose viewer list changed and therefore need their avatars re-rendered.
+func (p *Presence) Set(clientID, actor string, taskID int, editing bool) []int {
+	if clientID == "" {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changed := p.pruneLocked()
+
+	prev, ok := p.clients[clientID]
+	if ok && (prev.TaskID != taskID || prev.Editing != editing || prev.Actor != actor) {
+		changed = append(changed, prev.TaskID)
+	}
+	if !ok || prev.TaskID != taskID || prev.Editing != editing || prev.Actor != actor {
+		changed = append(changed, taskID)
+	}
+
+	p.clients[clientID] = &presenceEntry{
+		Actor:    actor,
+		TaskID:   taskID,
+		Editing:  editing,
+		LastSeen: time.Now(),
+	}
+
+	return uniqueTaskIDs(changed)
+}
+
+// Remove forgets a client, e.g. when its SSE connection closes.
+func (p *Presence) Remove(clientID string) []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changed := p.pruneLocked()
+	if prev, ok := p.clients[clientID]; ok {
+		changed = append(changed, prev.TaskID)
+		delete(p.clients, clientID)
+	}
+	return uniqueTaskIDs(changed)
+}
+
+// Viewers lists who has a task open, one entry per actor. An actor that is
+// editing in any tab is reported as editing.
+func (p *Presence) Viewers(taskID int) []fragments.Viewer {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	byActor := make(map[string]bool)
+	for _, entry := range p.clients {
+		if entry.TaskID != taskID || time.Since(entry.LastSeen) > presenceTTL {
+			continue
+		}
+		byActor[entry.Actor] = byActor[entry.Actor] || entry.Editing
+	}
+
+	viewers := make([]fragments.Viewer, 0, len(byActor))
+	for actor, editing := range byActor {
+		viewers = append(viewers, fragments.Viewer{Actor: actor, Editing: editing})
+	}
+	sort.Slice(viewers, func(i, j int) bool {
+		return viewers[i].Actor < viewers[j].Actor
+	})
+	return viewers
+}
+
+// Editors returns the actors editing a task in clients other than exceptClient.
+func (p *Presence) Editors(taskID int, exceptClient string) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var editors []string
+	for clientID, entry := range p.clients {
+		if clientID == exceptClient || entry.TaskID != taskID || !entry.Editing {
+			continue
+		}
+		if time.Since(entry.LastSeen) > presenceTTL {
+			continue
+		}
+		name := fragments.PresenceName(entry.Actor)
+		if !seen[name] {
+			seen[name] = true
+			editors = append(editors, name)
+		}
+	}
+	sort.Strings(editors)
+	return editors
+}
+
+// ActiveTasks returns the IDs of all tasks that somebody has open.
+func (p *Presence) ActiveTasks() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var ids []int
+	for _, entry := range p.clients {
+		if entry.TaskID != 0 && time.Since(entry.LastSeen) <= presenceTTL {
+			ids = append(ids, entry.TaskID)
+		}
+	}
+	return uniqueTaskIDs(ids)
+}
+
+// pruneLocked drops clients that stopped sending heartbeats. Callers must hold p.mu.
+func (p *Presence) pruneLocked() []int {
+	var changed []int
+	for clientID, entry := range p.clients {
+		if time.Since(entry.LastSeen) > presenceTTL {
+			changed = append(changed, entry.TaskID)
+			delete(p.clients, clientID)
+		}
+	}
+	return changed
+}
+
+// uniqueTaskIDs removes duplicates and the "no task" ID 0.
+func uniqueTaskIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
+}
+
+// setPresence updates presence for the requesting client and notifies other clients.
+func (s *Server) setPresence(r *http.Request, taskID int, editing bool) {
+	changed := s.Presence.Set(clientIDFromRequest(r), actorFromRequest(r), taskID, editing)
+	for _, id := range changed {
+		s.Broadcaster.BroadcastPresence(id)
+	}
+}
+
+// PresenceHeartbeatHandler records which task modal a client has open.
+// The board sends it when a modal closes and every 15 seconds as a heartbeat.
+func (s *Server) PresenceHeartbeatHandler(w http.ResponseWriter, r *http.Request) {
+	type PresenceUpdate struct {
+		TaskID  int  `json:"task_id"`
+		Editing bool `json:"editing"`
+	}
+	var update PresenceUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if clientIDFromRequest(r) == "" {
+		http.Error(w, "Missing client nonce", http.StatusBadRequest)
+		return
+	}
+
+	s.setPresence(r, update.TaskID, update.Editing)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// patchPresence sends the avatar lists for a task's card and details modal.
+func (s *Server) patchPresence(ctx context.Context, sse *datastar.ServerSentEventGenerator, taskID int) error {
+	viewers := s.Presence.Viewers(taskID)
+	id := strconv.Itoa(taskID)
+
+	for _, target := range []string{"presence-card-" + id, "presence-modal-" + id} {
+		var htmlBuilder strings.Builder
+		if err := fragments.PresenceAvatars(target, viewers).Render(ctx, &htmlBuilder); err != nil {
+			return err
+		}
+		_ = sse.PatchElements(htmlBuilder.String())
+	}
+	return nil
+}
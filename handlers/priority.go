@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+)
+
+// dueLayout is the wire and form format of due dates.
+const dueLayout = "2006-01-02"
+
+// parsePriority maps a priority name, including the aliases found in old
+// `priority:` tags, onto the enum.
+func parsePriority(s string) (task.Priority, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none":
+		return task.PriorityNone, true
+	case "low", "minor":
+		return task.PriorityLow, true
+	case "medium", "med", "normal":
+		return task.PriorityMedium, true
+	case "high":
+		return task.PriorityHigh, true
+	case "urgent", "critical", "blocker":
+		return task.PriorityUrgent, true
+	}
+	return "", false
+}
+
+// priorityRank orders priorities from most to least urgent.
+func priorityRank(p task.Priority) int {
+	switch p {
+	case task.PriorityUrgent:
+		return 0
+	case task.PriorityHigh:
+		return 1
+	case task.PriorityMedium:
+		return 2
+	case task.PriorityLow:
+		return 3
+	}
+	return 4
+}
+
+// extractPriorityTag removes a recognised `priority:` tag from form input.
+// Its value becomes the priority unless one was chosen explicitly.
+func extractPriorityTag(tags []tagInput, priority task.Priority) ([]tagInput, task.Priority) {
+	kept := tags[:0:0]
+	for _, tag := range tags {
+		if tag.Key == "priority" {
+			if p, ok := parsePriority(tag.Value); ok {
+				if priority == task.PriorityNone {
+					priority = p
+				}
+				continue
+			}
+		}
+		kept = append(kept, tag)
+	}
+	return kept, priority
+}
+
+// parseDue parses a due date in local time. An empty string means no due date.
+func parseDue(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	due, err := time.ParseInLocation(dueLayout, s, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return &due, nil
+}
+
+// formatDue is the inverse of parseDue.
+func formatDue(due *time.Time) string {
+	if due == nil {
+		return ""
+	}
+	return due.Format(dueLayout)
+}
+
+// setDue sets or clears a task's due date.
+func setDue(update *ent.TaskUpdateOne, due *time.Time) *ent.TaskUpdateOne {
+	if due == nil {
+		return update.ClearDueAt()
+	}
+	return update.SetDueAt(*due)
+}
+
+// startOfDay returns local midnight of t's day; tasks due before it are overdue.
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+// sortTasks orders tasks by priority or due date. Ties keep their current order
+// and tasks without a due date sort last.
+func sortTasks(tasks []*ent.Task, by string) {
+	byPriority := func(a, b *ent.Task) int {
+		return priorityRank(a.Priority) - priorityRank(b.Priority)
+	}
+	byDue := func(a, b *ent.Task) int {
+		switch {
+		case a.DueAt == nil && b.DueAt == nil:
+			return 0
+		case a.DueAt == nil:
+			return 1
+		case b.DueAt == nil:
+			return -1
+		}
+		return a.DueAt.Compare(*b.DueAt)
+	}
+
+	first, second := byPriority, byDue
+	if by == "due" {
+		first, second = byDue, byPriority
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if c := first(tasks[i], tasks[j]); c != 0 {
+			return c < 0
+		}
+		return second(tasks[i], tasks[j]) < 0
+	})
+}
+
+// ColumnSortHandler rewrites the positions in a column so its tasks are
+// ordered by priority (?by=priority) or due date (?by=due).
+func (s *Server) ColumnSortHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	column := sanitizeColumn(r.PathValue("column"))
+	by := r.URL.Query().Get("by")
+	if by != "priority" && by != "due" {
+		http.Error(w, "Invalid sort order", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	tasks, err := s.Client.Task.Query().
+		Where(onBoard(), task.ColumnEQ(column)).
+		Order(ent.Asc(task.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load column for sort", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	sortTasks(tasks, by)
+	for i, t := range tasks {
+		if t.Position == i {
+			continue
+		}
+		if err := s.Client.Task.UpdateOneID(t.ID).SetPosition(i).Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to update position", "task_id", t.ID, "error", err)
+			_ = sse.ConsoleError(err)
+			return
+		}
+	}
+
+	s.Broadcaster.BroadcastBoard(0, "column_refresh", column, "")
+}
+
+// migratePriorityTags moves `priority:` tags into the priority field. Tags with
+// unrecognised values are left alone, so the migration is safe to rerun.
+func migratePriorityTags(ctx context.Context, client *ent.Client) error {
+	tags, err := client.TaskTag.Query().
+		Where(tasktag.KeyEQ("priority")).
+		WithTask().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	migrated := 0
+	for _, tag := range tags {
+		p, ok := parsePriority(tag.Value)
+		if !ok || tag.Edges.Task == nil {
+			continue
+		}
+		if tag.Edges.Task.Priority == task.PriorityNone {
+			if err := client.Task.UpdateOneID(tag.Edges.Task.ID).SetPriority(p).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if err := client.TaskTag.DeleteOneID(tag.ID).Exec(ctx); err != nil {
+			return err
+		}
+		migrated++
+	}
+	if migrated > 0 {
+		slog.InfoContext(ctx, "migrated priority tags", "count", migrated)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"html"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/quickadd"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+)
+
+// quickAddTagKey is the tag key of a bare "#word" in quick-add text.
+const quickAddTagKey = "type"
+
+// parseQuickAdd parses quick-add text against the board's columns and tag
+// registry.
+func (s *Server) parseQuickAdd(ctx context.Context, text string) (quickadd.Result, error) {
+	reg, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		return quickadd.Result{}, err
+	}
+	keys := make(map[string]bool, len(reg))
+	for _, d := range reg {
+		keys[d.Key] = true
+	}
+	return quickadd.Parse(text, quickadd.Options{
+		Columns: boardColumns,
+		Priority: func(v string) (string, bool) {
+			p, ok := parsePriority(v)
+			return string(p), ok
+		},
+		TagKeys:       keys,
+		DefaultTagKey: quickAddTagKey,
+		Now:           time.Now(),
+	}), nil
+}
+
+// applyQuickAdd fills in whatever in leaves empty from parsed quick-add text.
+// Parsed tags are added to the explicit ones.
+func applyQuickAdd(in taskInput, res quickadd.Result) taskInput {
+	if strings.TrimSpace(in.Title) == "" {
+		in.Title = res.Title
+	}
+	if in.Column == "" {
+		in.Column = res.Column
+	}
+	if in.Assignee == "" {
+		in.Assignee = res.Assignee
+	}
+	if in.Priority == "" {
+		in.Priority = res.Priority
+	}
+	if in.Due == "" {
+		in.Due = formatDue(res.Due)
+	}
+	in.Tags = append(in.Tags, parseTags(strings.Join(res.Tags, ","))...)
+	return in
+}
+
+// QuickAddPreviewHandler shows what the quick-add input will create.
+func (s *Server) QuickAddPreviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var signals struct {
+		QuickAdd string `json:"quick_add"`
+	}
+	if err := datastar.ReadSignals(r, &signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	res, err := s.parseQuickAdd(ctx, signals.QuickAdd)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse quick-add text", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	var htmlBuilder strings.Builder
+	if err := fragments.QuickAddPreview(res, strings.TrimSpace(signals.QuickAdd) != "").Render(ctx, &htmlBuilder); err != nil {
+		slog.ErrorContext(ctx, "failed to render quick-add preview", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String())
+}
+
+// QuickAddHandler creates a task from the quick-add input. The card reaches
+// the board through the task_created broadcast.
+func (s *Server) QuickAddHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var signals struct {
+		QuickAdd    string `json:"quick_add"`
+		WIPOverride bool   `json:"wip_override"`
+	}
+	if err := datastar.ReadSignals(r, &signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	res, err := s.parseQuickAdd(ctx, signals.QuickAdd)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to parse quick-add text", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	created, err := s.createTask(ctx, r, applyQuickAdd(taskInput{WIPOverride: signals.WIPOverride}, res))
+	var rejected *createRejection
+	switch {
+	case errors.As(err, &rejected) && rejected.breach != nil:
+		_ = sse.PatchElements(wipErrorHTML("quick-add-preview", *rejected.breach))
+		return
+	case errors.As(err, &rejected):
+		_ = sse.PatchElements(`<div id="quick-add-preview" class="text-error text-sm">` + html.EscapeString(rejected.reason) + `</div>`)
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to create task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	toast := s.recordCreateUndo(ctx, r, created)
+
+	_ = sse.PatchSignals([]byte(`{"quick_add": "", "wip_override": false}`))
+	_ = sse.PatchElements(`<div id="quick-add-preview" class="text-sm"></div>`)
+	patchToast(ctx, sse, toast)
+}
+
+// APIQuickAddHandler parses ?text= without creating anything, so bots can
+// check what POST /api/tasks with "quick" would do.
+func (s *Server) APIQuickAddHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.parseQuickAdd(r.Context(), r.URL.Query().Get("text"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to parse quick-add text", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to parse text")
+		return
+	}
+	writeJSON(w, http.StatusOK, toAPIQuickAdd(res))
+}
+
+// apiQuickAdd is parsed quick-add text in API form.
+type apiQuickAdd struct {
+	Title    string   `json:"title"`
+	Assignee string   `json:"assignee,omitempty"`
+	Column   string   `json:"column,omitempty"`
+	Priority string   `json:"priority,omitempty"`
+	Due      string   `json:"due,omitempty"`
+	Tags     []string `json:"tags"`
+	Ignored  []string `json:"ignored"`
+}
+
+func toAPIQuickAdd(res quickadd.Result) apiQuickAdd {
+	out := apiQuickAdd{
+		Title:    res.Title,
+		Assignee: res.Assignee,
+		Column:   res.Column,
+		Priority: res.Priority,
+		Due:      formatDue(res.Due),
+		Tags:     res.Tags,
+		Ignored:  res.Ignored,
+	}
+	if out.Tags == nil {
+		out.Tags = []string{}
+	}
+	if out.Ignored == nil {
+		out.Ignored = []string{}
+	}
+	return out
+}
@@ -0,0 +1,390 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/customfield"
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/recurringtask"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/schedule"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+const (
+	// scheduleActor is the history actor for tasks created by the scheduler.
+	scheduleActor = "schedule"
+	// upcomingRunsPerSchedule is how many future runs the page shows for each
+	// recurring task.
+	upcomingRunsPerSchedule = 5
+)
+
+// recurrence parses a recurring task's rule, anchored at its creation.
+func recurrence(rt *ent.RecurringTask) (schedule.Schedule, error) {
+	return schedule.Parse(rt.Rule, rt.CreatedAt)
+}
+
+// nextRun returns the schedule's first occurrence after t, or nil if there
+// are no more.
+func nextRun(s schedule.Schedule, t time.Time) *time.Time {
+	next := s.Next(t)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// runRecurringTasks creates an instance of every recurring task that is due.
+// A schedule that fell behind, e.g. while the server was down, runs once and
+// moves on to its next future occurrence.
+func (s *Server) runRecurringTasks(ctx context.Context) error {
+	now := time.Now()
+	due, err := s.Client.RecurringTask.Query().
+		Where(recurringtask.EnabledEQ(true), recurringtask.NextRunAtLTE(now)).
+		Order(ent.Asc(recurringtask.FieldNextRunAt)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rt := range due {
+		if err := s.runRecurringTask(ctx, rt, now); err != nil {
+			slog.ErrorContext(ctx, "recurring task failed", "recurring_task", rt.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) runRecurringTask(ctx context.Context, rt *ent.RecurringTask, now time.Time) error {
+	sched, err := recurrence(rt)
+	if err != nil {
+		return err
+	}
+	update := rt.Update().SetLastRunAt(now)
+	if next := nextRun(sched, now); next != nil {
+		update = update.SetNextRunAt(*next)
+	} else {
+		update = update.ClearNextRunAt()
+	}
+
+	if rt.SkipIfOpen && rt.LastTaskID != nil {
+		open, err := s.Client.Task.Query().
+			Where(task.IDEQ(*rt.LastTaskID), onBoard(), task.ColumnNEQ("done")).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if open {
+			return update.SetLastResult(fmt.Sprintf("skipped: #%d is still open", *rt.LastTaskID)).Exec(ctx)
+		}
+	}
+
+	// Nobody is there to confirm a soft block, so only hard limits stop a run
+	breach, breached, err := checkWIP(ctx, s.Client, sanitizeColumn(rt.Column), rt.Assignee, 0)
+	if err != nil {
+		return err
+	}
+	if breached && breach.Blocks(true) {
+		return update.SetLastResult("skipped: " + breach.Error()).Exec(ctx)
+	}
+
+	created, err := s.createRecurringInstance(ctx, rt)
+	if err != nil {
+		if uerr := update.SetLastResult("failed: " + err.Error()).Exec(ctx); uerr != nil {
+			slog.ErrorContext(ctx, "failed to record recurring task result", "recurring_task", rt.ID, "error", uerr)
+		}
+		return err
+	}
+	if breached {
+		s.recordWIPBreach(ctx, created.ID, breach, wipOutcome(breach), scheduleActor)
+	}
+	return update.
+		SetLastTaskID(created.ID).
+		SetLastResult(fmt.Sprintf("created #%d", created.ID)).
+		Exec(ctx)
+}
+
+// createRecurringInstance adds a task from the template at the bottom of its
+// column, with custom field defaults applied.
+func (s *Server) createRecurringInstance(ctx context.Context, rt *ent.RecurringTask) (*ent.Task, error) {
+	// Automations see the task once it's committed
+	ctx, events := withEventBuffer(ctx, newAutomationChain())
+	defer s.flushAutomationEvents(events)
+
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		return nil, err
+	}
+	fieldValues, fieldErrs := customfield.Validate(defs, nil, true)
+	if len(fieldErrs) > 0 {
+		return nil, fmt.Errorf("%s", fieldErrors(defs, fieldErrs))
+	}
+
+	column := sanitizeColumn(rt.Column)
+	position, err := getNextPosition(ctx, s.Client, column)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *ent.Task
+	err = withTx(ctx, s.Client, func(tx *ent.Tx) error {
+		client := tx.Client()
+		created, err = client.Task.Create().
+			SetTitle(rt.Title).
+			SetDescription(rt.Description).
+			SetColumn(column).
+			SetAssignee(rt.Assignee).
+			SetPriority(task.Priority(rt.Priority)).
+			SetPosition(position).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if err := replaceTags(ctx, client, created.ID, rt.Tags); err != nil {
+			return err
+		}
+		if err := replaceFieldValues(ctx, client, created.ID, fieldValues); err != nil {
+			return err
+		}
+		_, err = recordRevision(ctx, client, created.ID, scheduleActor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	details := fmt.Sprintf("created by schedule in %s (%s)", column, rt.Rule)
+	if _, err := s.recordHistory(ctx, created.ID, "created", details, scheduleActor, nil); err != nil {
+		slog.ErrorContext(ctx, "failed to create history", "error", err)
+	}
+	s.Broadcaster.BroadcastBoard(created.ID, "column_refresh", column, "")
+	slog.InfoContext(ctx, "created recurring task", "recurring_task", rt.ID, "task_id", created.ID)
+	return created, nil
+}
+
+// RecurringPageHandler lists recurring tasks and their upcoming runs, with a
+// form to add more.
+func (s *Server) RecurringPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	recurring, err := s.Client.RecurringTask.Query().
+		Order(ent.Asc(recurringtask.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get recurring tasks", "error", err)
+		http.Error(w, "Failed to load recurring tasks", http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	upcoming := make(map[int][]time.Time, len(recurring))
+	for _, rt := range recurring {
+		sched, err := recurrence(rt)
+		if err != nil || !rt.Enabled {
+			continue
+		}
+		upcoming[rt.ID] = schedule.Upcoming(sched, now, upcomingRunsPerSchedule)
+		// A run that's due but not picked up yet comes first
+		if rt.NextRunAt != nil && !rt.NextRunAt.After(now) {
+			upcoming[rt.ID] = append([]time.Time{*rt.NextRunAt}, upcoming[rt.ID]...)
+		}
+	}
+
+	bodyContent := pages.RecurringContent(recurring, upcoming, boardColumns, defaultAssignees)
+	page := templates.Layout("Recurring tasks - Bot Task Tracker", pages.RecurringMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// RecurringPreviewHandler shows the next runs of the schedule being typed.
+func (s *Server) RecurringPreviewHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type PreviewSignals struct {
+		Rule string `json:"recurring_rule"`
+	}
+	signals := &PreviewSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	var runs []time.Time
+	var problem string
+	if strings.TrimSpace(signals.Rule) != "" {
+		sched, err := schedule.Parse(signals.Rule, time.Now())
+		if err != nil {
+			problem = err.Error()
+		} else if runs = schedule.Upcoming(sched, time.Now(), upcomingRunsPerSchedule); len(runs) == 0 {
+			problem = "This schedule never runs"
+		}
+	}
+
+	var htmlBuilder strings.Builder
+	if err := fragments.RecurringPreview(runs, problem).Render(ctx, &htmlBuilder); err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String())
+}
+
+// RecurringSaveHandler adds a recurring task.
+func (s *Server) RecurringSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type RecurringSignals struct {
+		Title       string `json:"recurring_title"`
+		Description string `json:"recurring_description"`
+		Column      string `json:"recurring_column"`
+		Assignee    string `json:"recurring_assignee"`
+		Priority    string `json:"recurring_priority"`
+		Tags        string `json:"recurring_tags"`
+		Rule        string `json:"recurring_rule"`
+		SkipIfOpen  bool   `json:"recurring_skip_if_open"`
+	}
+	signals := &RecurringSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+	patchError := func(msg string) {
+		_ = sse.PatchElements(`<div id="recurring-error" class="alert alert-error text-sm">` + html.EscapeString(msg) + `</div>`)
+	}
+
+	title := strings.TrimSpace(signals.Title)
+	if title == "" {
+		patchError("Title is required")
+		return
+	}
+	priority := task.Priority(signals.Priority)
+	if priority == "" {
+		priority = task.PriorityNone
+	}
+	if err := task.PriorityValidator(priority); err != nil {
+		patchError("Unknown priority " + signals.Priority)
+		return
+	}
+	tags, priority := extractPriorityTag(parseTags(signals.Tags), priority)
+	reg, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := validateTags(reg, tags); err != nil {
+		patchError(err.Error())
+		return
+	}
+	pairs := make([]string, len(tags))
+	for i, tag := range tags {
+		pairs[i] = tag.Key + ":" + tag.Value
+	}
+
+	now := time.Now()
+	rule := strings.TrimSpace(signals.Rule)
+	sched, err := schedule.Parse(rule, now)
+	if err != nil {
+		patchError(err.Error())
+		return
+	}
+	next := nextRun(sched, now)
+	if next == nil {
+		patchError("This schedule never runs")
+		return
+	}
+
+	err = s.Client.RecurringTask.Create().
+		SetTitle(title).
+		SetDescription(signals.Description).
+		SetColumn(sanitizeColumn(signals.Column)).
+		SetAssignee(signals.Assignee).
+		SetPriority(recurringtask.Priority(priority)).
+		SetTags(pairs).
+		SetRule(rule).
+		SetSkipIfOpen(signals.SkipIfOpen).
+		SetNextRunAt(*next).
+		SetCreatedAt(now).
+		Exec(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save recurring task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/recurring")
+}
+
+// RecurringToggleHandler pauses or resumes a recurring task. Resuming
+// schedules the next future run rather than catching up.
+func (s *Server) RecurringToggleHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid recurring task ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	rt, err := s.Client.RecurringTask.Get(ctx, id)
+	if err == nil {
+		update := rt.Update().SetEnabled(!rt.Enabled)
+		if !rt.Enabled {
+			var sched schedule.Schedule
+			if sched, err = recurrence(rt); err == nil {
+				if next := nextRun(sched, time.Now()); next != nil {
+					update = update.SetNextRunAt(*next)
+				} else {
+					update = update.ClearNextRunAt()
+				}
+			}
+		}
+		if err == nil {
+			err = update.Exec(ctx)
+		}
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to toggle recurring task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/recurring")
+}
+
+// RecurringDeleteHandler removes a recurring task. Tasks it already created
+// stay on the board.
+func (s *Server) RecurringDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid recurring task ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.Client.RecurringTask.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete recurring task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/recurring")
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+func TestRecurringRunChecksWIP(t *testing.T) {
+	cases := []struct {
+		mode    wiplimit.Mode
+		created bool
+		outcome string
+	}{
+		{wiplimit.ModeHard, false, ""},
+		{wiplimit.ModeSoft, true, "overridden"},
+		{wiplimit.ModeWarn, true, "warned"},
+	}
+	for _, c := range cases {
+		t.Run(string(c.mode), func(t *testing.T) {
+			ts := newTestServer(t)
+			ctx := context.Background()
+			ts.createTask(t, map[string]any{"title": "Already there", "column": "in_progress"})
+			ts.Client.WipLimit.Create().SetColumn("in_progress").SetMaxTasks(1).SetMode(c.mode).ExecX(ctx)
+			rt := ts.Client.RecurringTask.Create().
+				SetTitle("Weekly sync").
+				SetColumn("in_progress").
+				SetRule("0 9 * * MON").
+				SetNextRunAt(time.Now().Add(-time.Minute)).
+				SaveX(ctx)
+
+			if err := ts.runRecurringTasks(ctx); err != nil {
+				t.Fatal(err)
+			}
+			rt = ts.Client.RecurringTask.GetX(ctx, rt.ID)
+			instances := ts.Client.Task.Query().Where(task.TitleEQ("Weekly sync")).AllX(ctx)
+			if !c.created {
+				if len(instances) != 0 || !strings.HasPrefix(rt.LastResult, "skipped: WIP limit") {
+					t.Errorf("%d instances, last result %q: want the run skipped", len(instances), rt.LastResult)
+				}
+				return
+			}
+			if len(instances) != 1 {
+				t.Fatalf("%d instances (last result %q), want 1", len(instances), rt.LastResult)
+			}
+			entry := ts.Client.TaskHistory.Query().
+				Where(taskhistory.HasTaskWith(task.IDEQ(instances[0].ID)), taskhistory.ActionEQ("wip_exceeded")).
+				OnlyX(ctx)
+			if entry.Actor != scheduleActor || !strings.Contains(entry.Details, c.outcome) {
+				t.Errorf("breach entry = %q by %q, want it %s", entry.Details, entry.Actor, c.outcome)
+			}
+		})
+	}
+}
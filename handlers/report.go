@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/report"
+	"github.com/j0hnsmith/botTaskTracker/schedule"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// reviewColumn is where a task goes up for review.
+const reviewColumn = "review"
+
+// blockedTag is the tag key that marks a task as blocked, e.g. blocked:infra.
+const blockedTag = "blocked"
+
+// Report output formats.
+var reportFormats = []string{"markdown", "html", "json"}
+
+// reportRequest is a report's period and window, as typed by the user.
+type reportRequest struct {
+	Period report.Period
+	Since  time.Time
+	Until  time.Time // exclusive
+}
+
+// parseReportRequest reads a period and an optional window. Dates use the
+// YYYY-MM-DD format, with "until" including the whole day; times can be
+// YYYY-MM-DDTHH:MM or RFC 3339. Missing bounds come from the period's default
+// window ending now.
+func parseReportRequest(period, since, until string, now time.Time) (reportRequest, error) {
+	p, err := report.ParsePeriod(period)
+	if err != nil {
+		return reportRequest{}, err
+	}
+	req := reportRequest{Period: p}
+	req.Since, req.Until = p.Window(now)
+	if until != "" {
+		if req.Until, err = parseReportTime(until, true); err != nil {
+			return req, err
+		}
+		if since == "" {
+			req.Since = req.Until.Add(req.Since.Sub(now))
+		}
+	}
+	if since != "" {
+		if req.Since, err = parseReportTime(since, false); err != nil {
+			return req, err
+		}
+	}
+	if !req.Since.Before(req.Until) {
+		return req, fmt.Errorf("the report window must start before it ends")
+	}
+	return req, nil
+}
+
+// parseReportTime reads a window bound. A bare date is the start of that day,
+// or the start of the next when it ends the window.
+func parseReportTime(value string, end bool) (time.Time, error) {
+	if day, err := time.ParseInLocation(dueLayout, value, time.Local); err == nil {
+		if end {
+			return day.AddDate(0, 0, 1), nil
+		}
+		return day, nil
+	}
+	if at, err := time.ParseInLocation(asOfLayout, value, time.Local); err == nil {
+		return at, nil
+	}
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: use YYYY-MM-DD, YYYY-MM-DDTHH:MM or RFC 3339", value)
+}
+
+// report builds a report from task history. Blocked tasks are those a WIP
+// limit stopped during the window or that carry a blocked tag; stale ones
+// are open tasks past their column's threshold now.
+func (s *Server) report(ctx context.Context, req reportRequest) (report.Report, error) {
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
+		return report.Report{}, err
+	}
+	rows, err := s.Client.Task.Query().
+		Where(task.DeletedAtIsNil()).
+		WithHistory().
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return report.Report{}, err
+	}
+
+	now := time.Now()
+	tasks := make([]report.Task, len(rows))
+	for i, t := range rows {
+		rt := report.Task{Task: flowTask(t)}
+		for _, e := range t.Edges.History {
+			if reason, ok := strings.CutSuffix(e.Details, " (blocked)"); ok && e.Action == "wip_exceeded" {
+				rt.Blocked = append(rt.Blocked, report.Block{At: e.CreatedAt, Reason: reason})
+			}
+		}
+		open := t.ArchivedAt == nil && t.Column != doneColumn
+		if open {
+			for _, tag := range t.Edges.Tags {
+				if tag.Key == blockedTag {
+					rt.Blocked = append(rt.Blocked, report.Block{Reason: "tagged " + tag.Key + ":" + tag.Value})
+				}
+			}
+			if t.ColumnEnteredAt != nil {
+				rt.Age = thresholds.Age(t.Column, *t.ColumnEnteredAt, now)
+			}
+		}
+		tasks[i] = rt
+	}
+
+	return report.Build(tasks, report.Options{
+		Title:  req.Period.Title(),
+		Since:  req.Since,
+		Until:  req.Until,
+		Done:   doneColumn,
+		Review: reviewColumn,
+	}), nil
+}
+
+// apiReportItem is a task listed in a report section.
+type apiReportItem struct {
+	ID    int        `json:"id"`
+	Title string     `json:"title"`
+	At    *time.Time `json:"at"` // null for standing states such as stale
+	Note  string     `json:"note,omitempty"`
+}
+
+// apiReportGroup is one assignee's sections; an empty assignee is unassigned.
+type apiReportGroup struct {
+	Assignee  string          `json:"assignee"`
+	Completed []apiReportItem `json:"completed"`
+	Review    []apiReportItem `json:"moved_to_review"`
+	Created   []apiReportItem `json:"created"`
+	Blocked   []apiReportItem `json:"blocked"`
+	Stale     []apiReportItem `json:"stale"`
+}
+
+func toAPIReportItems(items []report.Item) []apiReportItem {
+	out := make([]apiReportItem, len(items))
+	for i, item := range items {
+		out[i] = apiReportItem{ID: item.ID, Title: item.Title, Note: item.Note}
+		if !item.At.IsZero() {
+			at := item.At
+			out[i].At = &at
+		}
+	}
+	return out
+}
+
+// writeReport renders a report as markdown, a standalone HTML page or JSON.
+func writeReport(ctx context.Context, w io.Writer, r report.Report, format string) error {
+	switch format {
+	case "", "markdown":
+		_, err := io.WriteString(w, r.Markdown())
+		return err
+	case "html":
+		return pages.ReportDocument(r).Render(ctx, w)
+	case "json":
+		groups := make([]apiReportGroup, len(r.Groups))
+		for i, g := range r.Groups {
+			groups[i] = apiReportGroup{
+				Assignee:  g.Assignee,
+				Completed: toAPIReportItems(g.Completed),
+				Review:    toAPIReportItems(g.Review),
+				Created:   toAPIReportItems(g.Created),
+				Blocked:   toAPIReportItems(g.Blocked),
+				Stale:     toAPIReportItems(g.Stale),
+			}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]any{
+			"title":  r.Title,
+			"since":  r.Since,
+			"until":  r.Until,
+			"groups": groups,
+		})
+	}
+	return fmt.Errorf("unknown format %q: use %s", format, strings.Join(reportFormats, ", "))
+}
+
+// WriteReport writes a standup or weekly report to w, for the report
+// subcommand. Empty since and until use the period's default window.
+func (s *Server) WriteReport(ctx context.Context, w io.Writer, period, since, until, format string) error {
+	req, err := parseReportRequest(period, since, until, time.Now())
+	if err != nil {
+		return err
+	}
+	r, err := s.report(ctx, req)
+	if err != nil {
+		return err
+	}
+	return writeReport(ctx, w, r, format)
+}
+
+// ReportPageHandler shows a standup or weekly report, with its Markdown ready
+// to copy.
+func (s *Server) ReportPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	req, err := parseReportRequest(q.Get("period"), q.Get("since"), q.Get("until"), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rep, err := s.report(ctx, req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build report", "error", err)
+		http.Error(w, "Failed to load report", http.StatusInternalServerError)
+		return
+	}
+
+	var schedules map[report.Period]string
+	if s.Config.ReportWebhookURL != "" {
+		schedules = map[report.Period]string{report.Standup: s.Config.StandupSchedule, report.Weekly: s.Config.WeeklySchedule}
+	}
+	bodyContent := pages.ReportContent(rep, req.Period, report.Periods, q.Get("since"), q.Get("until"), schedules)
+	page := templates.Layout(rep.Title+" - Bot Task Tracker", pages.ReportMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// APIReportHandler returns a report for ?period=standup|weekly and an
+// optional since/until window, as markdown (the default), html or json.
+func (s *Server) APIReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	req, err := parseReportRequest(q.Get("period"), q.Get("since"), q.Get("until"), time.Now())
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	format := q.Get("format")
+	contentType := map[string]string{
+		"":         "text/markdown; charset=utf-8",
+		"markdown": "text/markdown; charset=utf-8",
+		"html":     "text/html; charset=utf-8",
+		"json":     "application/json",
+	}[format]
+	if contentType == "" {
+		writeJSONError(w, http.StatusBadRequest, "format must be one of "+strings.Join(reportFormats, ", "))
+		return
+	}
+
+	rep, err := s.report(ctx, req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to build report", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to build report")
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := writeReport(ctx, &buf, rep, format); err != nil {
+		slog.ErrorContext(ctx, "failed to render report", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to render report")
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = buf.WriteTo(w)
+}
+
+// postReport sends a report to the configured webhook as JSON, with the
+// Markdown in "text" as chat webhooks expect and the HTML alongside.
+func (s *Server) postReport(ctx context.Context, period report.Period) error {
+	req, err := parseReportRequest(string(period), "", "", time.Now())
+	if err != nil {
+		return err
+	}
+	rep, err := s.report(ctx, req)
+	if err != nil {
+		return err
+	}
+	var html bytes.Buffer
+	if err := pages.ReportDocument(rep).Render(ctx, &html); err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]any{
+		"text":   rep.Markdown(),
+		"html":   html.String(),
+		"title":  rep.Heading(),
+		"period": period,
+		"since":  rep.Since,
+		"until":  rep.Until,
+	})
+	if err != nil {
+		return err
+	}
+
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Config.ReportWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	resp, err := s.Automations.http.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("report webhook responded %s", resp.Status)
+	}
+	slog.InfoContext(ctx, "posted report", "period", period, "status", resp.Status)
+	return nil
+}
+
+// scheduledReports returns a job that posts each report when its schedule
+// comes due after start. Invalid schedules are logged and skipped.
+func (s *Server) scheduledReports(start time.Time) func(context.Context) error {
+	schedules := make(map[report.Period]schedule.Schedule)
+	next := make(map[report.Period]time.Time)
+	for period, expr := range map[report.Period]string{
+		report.Standup: s.Config.StandupSchedule,
+		report.Weekly:  s.Config.WeeklySchedule,
+	} {
+		if strings.TrimSpace(expr) == "" {
+			continue
+		}
+		sched, err := schedule.Parse(expr, start)
+		if err != nil {
+			slog.Warn("ignoring invalid report schedule", "period", period, "schedule", expr, "error", err)
+			continue
+		}
+		schedules[period] = sched
+		next[period] = sched.Next(start)
+	}
+
+	return func(ctx context.Context) error {
+		now := time.Now()
+		var errs []error
+		for _, period := range report.Periods {
+			due := next[period]
+			if due.IsZero() || now.Before(due) {
+				continue
+			}
+			next[period] = schedules[period].Next(now)
+			if err := s.postReport(ctx, period); err != nil {
+				errs = append(errs, fmt.Errorf("%s report: %w", period, err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
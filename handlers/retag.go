@@ -0,0 +1,312 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/history"
+	"github.com/j0hnsmith/botTaskTracker/tagregistry"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+)
+
+// withTx runs fn in a transaction, rolling back if it fails. Automation
+// events raised by a rolled back transaction are dropped.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	events := eventBufferFrom(ctx)
+	mark := events.mark()
+	if err := fn(tx); err != nil {
+		events.rewind(mark)
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		events.rewind(mark)
+		return err
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction: a new one, or the caller's when client is
+// already transactional.
+func inTx(ctx context.Context, client *ent.Client, fn func(client *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(client)
+	}
+	if err != nil {
+		return err
+	}
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// tagUsage counts every key:value pair across all tasks, including trashed
+// and archived ones, ordered by key and then by most used value.
+func tagUsage(ctx context.Context, client *ent.Client) ([]tagregistry.Usage, error) {
+	var usage []tagregistry.Usage
+	err := client.TaskTag.Query().
+		GroupBy(tasktag.FieldKey, tasktag.FieldValue).
+		Aggregate(ent.Count()).
+		Scan(ctx, &usage)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Key != usage[j].Key {
+			return usage[i].Key < usage[j].Key
+		}
+		if usage[i].Count != usage[j].Count {
+			return usage[i].Count > usage[j].Count
+		}
+		return usage[i].Value < usage[j].Value
+	})
+	return usage, nil
+}
+
+// checkRetagTarget makes sure a rename or merge doesn't produce tags the
+// registry would reject on the next edit.
+func checkRetagTarget(reg tagregistry.Registry, rt tagregistry.Retag) error {
+	if rt.Action == tagregistry.ActionDelete || len(reg) == 0 {
+		return nil
+	}
+	d, ok := reg.Lookup(rt.Target.Key)
+	if !ok {
+		return fmt.Errorf("register %q on the tags page before retagging to it", rt.Target.Key)
+	}
+	if !rt.Target.AnyValue && !d.Allows(rt.Target.Value) {
+		return fmt.Errorf("%s must be one of %s", d.Key, strings.Join(d.AllowedValues, ", "))
+	}
+	return nil
+}
+
+// retag applies rt to every task with a matching tag. With dryRun nothing is
+// written; otherwise all tasks are updated in one transaction and each gets a
+// single history entry and revision.
+func (s *Server) retag(ctx context.Context, rt tagregistry.Retag, actor string, dryRun bool) ([]tagregistry.TaskChange, error) {
+	matches := make([]predicate.TaskTag, len(rt.Sources))
+	for i, p := range rt.Sources {
+		if p.AnyValue {
+			matches[i] = tasktag.KeyEQ(p.Key)
+		} else {
+			matches[i] = tasktag.And(tasktag.KeyEQ(p.Key), tasktag.ValueEQ(p.Value))
+		}
+	}
+	tasks, err := s.Client.Task.Query().
+		Where(task.HasTagsWith(tasktag.Or(matches...))).
+		WithTags().
+		WithFieldValues().
+		Order(ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []tagregistry.TaskChange
+	for _, t := range tasks {
+		tags := make([]tagregistry.Tag, len(t.Edges.Tags))
+		for i, tag := range t.Edges.Tags {
+			tags[i] = tagregistry.Tag{Key: tag.Key, Value: tag.Value}
+		}
+		after, changed := rt.Apply(tags)
+		if !changed {
+			continue
+		}
+		pairs := make([]string, len(after))
+		for i, tag := range after {
+			pairs[i] = tag.Key + ":" + tag.Value
+		}
+		changes = append(changes, tagregistry.TaskChange{
+			TaskID: t.ID,
+			Title:  t.Title,
+			Before: taskSnapshot(t).Tags,
+			After:  pairs,
+		})
+	}
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+
+	var entries []int
+	details := rt.Describe()
+	err = withTx(ctx, s.Client, func(tx *ent.Tx) error {
+		client := tx.Client()
+		for _, c := range changes {
+			if err := replaceTags(ctx, client, c.TaskID, c.After); err != nil {
+				return err
+			}
+			entry, err := client.TaskHistory.Create().
+				SetTaskID(c.TaskID).
+				SetAction("tagged").
+				SetDetails(details).
+				SetActor(actor).
+				SetChanges(history.Diff(history.Snapshot{Tags: c.Before}, history.Snapshot{Tags: c.After})).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry.ID)
+			if _, err := recordRevision(ctx, client, c.TaskID, actor); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "retagged tasks", "action", rt.Action, "details", details, "tasks", len(changes))
+	for _, id := range entries {
+		s.Broadcaster.BroadcastActivity(id)
+	}
+	columns := make(map[string]bool)
+	for _, t := range tasks {
+		if t.DeletedAt == nil && t.ArchivedAt == nil && !columns[t.Column] {
+			columns[t.Column] = true
+			s.Broadcaster.BroadcastBoard(0, "column_refresh", t.Column, "")
+		}
+	}
+	return changes, nil
+}
+
+// TagRetagHandler renames, merges or deletes tags from the tags page. With
+// ?dry_run=true it only previews the affected tasks.
+func (s *Server) TagRetagHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type RetagSignals struct {
+		Action string `json:"retag_action"`
+		From   string `json:"retag_from"`
+		To     string `json:"retag_to"`
+	}
+	signals := &RetagSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	sse := datastar.NewSSE(w, r)
+	patchError := func(msg string) {
+		_ = sse.PatchElements(`<div id="retag-preview" class="alert alert-error text-sm">` + html.EscapeString(msg) + `</div>`)
+	}
+
+	rt, err := tagregistry.ParseRetag(signals.Action, strings.Split(signals.From, ","), signals.To)
+	if err != nil {
+		patchError(err.Error())
+		return
+	}
+	reg, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := checkRetagTarget(reg, rt); err != nil {
+		patchError(err.Error())
+		return
+	}
+
+	changes, err := s.retag(ctx, rt, actorFromRequest(r), dryRun)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to retag", "error", err)
+		patchError("Retag failed, nothing was changed: " + err.Error())
+		return
+	}
+
+	if !dryRun && len(changes) > 0 {
+		_ = sse.Redirect("/tags")
+		return
+	}
+	var htmlBuilder strings.Builder
+	if err := fragments.RetagPreview(rt.Describe(), changes).Render(ctx, &htmlBuilder); err != nil {
+		slog.ErrorContext(ctx, "failed to render retag preview", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String())
+}
+
+// APITagListHandler returns every tag in use with its count.
+func (s *Server) APITagListHandler(w http.ResponseWriter, r *http.Request) {
+	usage, err := tagUsage(r.Context(), s.Client)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to list tags")
+		return
+	}
+	if usage == nil {
+		usage = []tagregistry.Usage{}
+	}
+	writeJSON(w, http.StatusOK, usage)
+}
+
+// APITagRetagHandler is the JSON form of TagRetagHandler:
+//
+//	{"action": "merge", "from": ["type:Bug", "bug:true"], "to": "type:bug", "dry_run": true}
+func (s *Server) APITagRetagHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req struct {
+		Action string   `json:"action"`
+		From   []string `json:"from"`
+		To     string   `json:"to"`
+		DryRun bool     `json:"dry_run"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	rt, err := tagregistry.ParseRetag(req.Action, req.From, req.To)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reg, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "failed to load tag registry")
+		return
+	}
+	if err := checkRetagTarget(reg, rt); err != nil {
+		writeJSONError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	changes, err := s.retag(ctx, rt, actorFromRequest(r), req.DryRun)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to retag", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "retag failed, nothing was changed")
+		return
+	}
+	if changes == nil {
+		changes = []tagregistry.TaskChange{}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"description": rt.Describe(),
+		"dry_run":     req.DryRun,
+		"tasks":       changes,
+	})
+}
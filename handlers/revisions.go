@@ -0,0 +1,315 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
+	"github.com/j0hnsmith/botTaskTracker/history"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+)
+
+// recordRevision stores an immutable snapshot of a task and its current tags.
+// It is called after every save so any earlier state can be restored. The
+// number is taken in the same transaction as the insert, and a unique index
+// on (task, revision) stops two saves from sharing one.
+func recordRevision(ctx context.Context, client *ent.Client, taskID int, actor string) (*ent.TaskRevision, error) {
+	var rev *ent.TaskRevision
+	err := inTx(ctx, client, func(client *ent.Client) error {
+		t, err := client.Task.Query().
+			Where(task.IDEQ(taskID)).
+			WithTags().
+			WithFieldValues().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		number := 1
+		last, err := client.TaskRevision.Query().
+			Where(taskrevision.HasTaskWith(task.IDEQ(taskID))).
+			Order(ent.Desc(taskrevision.FieldRevision)).
+			First(ctx)
+		switch {
+		case err == nil:
+			number = last.Revision + 1
+		case !ent.IsNotFound(err):
+			return err
+		}
+
+		snap := taskSnapshot(t)
+		rev, err = client.TaskRevision.Create().
+			SetTaskID(taskID).
+			SetRevision(number).
+			SetTitle(snap.Title).
+			SetDescription(snap.Description).
+			SetColumn(snap.Column).
+			SetAssignee(snap.Assignee).
+			SetPriority(snap.Priority).
+			SetDue(snap.Due).
+			SetTags(snap.Tags).
+			SetFields(snap.Fields).
+			SetActor(actor).
+			Save(ctx)
+		return err
+	})
+	return rev, err
+}
+
+// renumberDuplicateRevisions gives each of a task's revisions its own number
+// before the unique index on (task, revision) is created. Concurrent saves
+// could once number two snapshots alike; they are renumbered in the order
+// they were written.
+func renumberDuplicateRevisions(ctx context.Context, db *sql.DB) error {
+	var tables int
+	err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'task_revisions'`).Scan(&tables)
+	if err != nil || tables == 0 {
+		return err
+	}
+	res, err := db.ExecContext(ctx, `
+		UPDATE task_revisions SET revision = (
+			SELECT COUNT(*) FROM task_revisions AS earlier
+			WHERE earlier.task_revisions = task_revisions.task_revisions AND earlier.id <= task_revisions.id
+		)
+		WHERE task_revisions IN (
+			SELECT task_revisions FROM task_revisions GROUP BY task_revisions, revision HAVING COUNT(*) > 1
+		)`)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		slog.InfoContext(ctx, "renumbered revisions of tasks with duplicate numbers", "count", n)
+	}
+	return nil
+}
+
+// recordBaselineRevision snapshots tasks created before revisions existed, so
+// the state before their first tracked save can still be restored.
+func recordBaselineRevision(ctx context.Context, client *ent.Client, taskID int) error {
+	exists, err := client.TaskRevision.Query().
+		Where(taskrevision.HasTaskWith(task.IDEQ(taskID))).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	_, err = recordRevision(ctx, client, taskID, "")
+	return err
+}
+
+// revisionSnapshot converts a stored revision into a comparable snapshot.
+func revisionSnapshot(rev *ent.TaskRevision) history.Snapshot {
+	return history.Snapshot{
+		Title:       rev.Title,
+		Description: rev.Description,
+		Column:      rev.Column,
+		Assignee:    rev.Assignee,
+		Priority:    rev.Priority,
+		Due:         rev.Due,
+		Tags:        rev.Tags,
+		Fields:      rev.Fields,
+	}
+}
+
+// loadRevision finds a revision of a task by its per-task revision number.
+func (s *Server) loadRevision(ctx context.Context, r *http.Request) (*ent.Task, *ent.TaskRevision, error) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return nil, nil, err
+	}
+	number, err := strconv.Atoi(r.PathValue("rev"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t, err := s.Client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithTags().
+		WithFieldValues().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rev, err := s.Client.TaskRevision.Query().
+		Where(
+			taskrevision.HasTaskWith(task.IDEQ(id)),
+			taskrevision.RevisionEQ(number),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, rev, nil
+}
+
+// TaskRevisionCompareHandler shows a revision side by side with the current task.
+func (s *Server) TaskRevisionCompareHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	sse := datastar.NewSSE(w, r)
+
+	t, rev, err := s.loadRevision(ctx, r)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load revision", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	var htmlBuilder strings.Builder
+	err = fragments.RevisionCompare(t.ID, rev, revisionSnapshot(rev), taskSnapshot(t)).Render(ctx, &htmlBuilder)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to render revision compare", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String())
+}
+
+// TaskRevisionRestoreHandler rolls a task back to an earlier revision. The
+// restore is itself recorded as a new revision and a history entry.
+func (s *Server) TaskRevisionRestoreHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	t, rev, err := s.loadRevision(ctx, r)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load revision", "error", err)
+		_ = datastar.NewSSE(w, r).ConsoleError(err)
+		return
+	}
+
+	// Going back to another column is a move like any other
+	actor := actorFromRequest(r)
+	target := revisionSnapshot(rev)
+	subject := workflowSubject(r, target.Description, target.Assignee, target.Tags)
+	breach, breached, err := s.checkMove(ctx, t.ID, t.Column, target.Column, target.Assignee, subject, false)
+	var rejected *moveRejection
+	if errors.As(err, &rejected) {
+		writeMoveRejected(ctx, w, rejected)
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check restore", "task_id", t.ID, "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	changes := history.Diff(taskSnapshot(t), target)
+	if len(changes) == 0 {
+		_ = sse.ExecuteScript("alert('This revision matches the current task')")
+		return
+	}
+
+	// The registry may have changed since, so check the tags like an edit would
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := validateTags(tagRegistry, parseTags(strings.Join(target.Tags, ","))); err != nil {
+		msg := "Can't restore r" + strconv.Itoa(rev.Revision) + ": " + strings.ReplaceAll(err.Error(), "\n", "; ")
+		patchToast(ctx, sse, fragments.UndoToast(msg, ""))
+		return
+	}
+
+	due, err := parseDue(target.Due)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	priority, _ := parsePriority(target.Priority)
+	update := setDue(s.Client.Task.UpdateOneID(t.ID).
+		SetTitle(target.Title).
+		SetDescription(target.Description).
+		SetAssignee(target.Assignee).
+		SetPriority(priority), due)
+
+	// Restored column goes to the end of that column
+	oldColumn := t.Column
+	if target.Column != oldColumn {
+		position, err := getNextPosition(ctx, s.Client, target.Column)
+		if err != nil {
+			_ = sse.ConsoleError(err)
+			return
+		}
+		update = update.SetColumn(target.Column).SetPosition(position)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to restore task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if target.Column != oldColumn {
+		if err := recompactColumnPositions(ctx, s.Client, oldColumn); err != nil {
+			slog.ErrorContext(ctx, "failed to recompact column", "column", oldColumn, "error", err)
+		}
+	}
+
+	// Replace the tag set
+	if err := replaceTags(ctx, s.Client, t.ID, target.Tags); err != nil {
+		slog.ErrorContext(ctx, "failed to restore tags", "error", err)
+	}
+	if err := replaceFieldValues(ctx, s.Client, t.ID, target.Fields); err != nil {
+		slog.ErrorContext(ctx, "failed to restore custom fields", "error", err)
+	}
+
+	details := "restored revision " + strconv.Itoa(rev.Revision)
+	if _, err := s.recordHistory(ctx, t.ID, "restored", details, actor, changes); err != nil {
+		slog.ErrorContext(ctx, "failed to create history for restore", "error", err)
+	}
+	if breached {
+		s.recordWIPBreach(ctx, t.ID, breach, wipOutcome(breach), actor)
+	}
+	if _, err := recordRevision(ctx, s.Client, t.ID, actor); err != nil {
+		slog.ErrorContext(ctx, "failed to record revision", "error", err)
+	}
+
+	// Update every board, then re-render this client's details modal
+	if target.Column != oldColumn {
+		s.Broadcaster.BroadcastBoard(t.ID, "task_moved", target.Column, "")
+	} else {
+		s.Broadcaster.BroadcastBoard(t.ID, "task_updated", target.Column, "")
+	}
+
+	restored, err := loadTaskDetails(ctx, s.Client, t.ID)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	var htmlBuilder strings.Builder
+	if err := fragments.TaskDetailsModal(restored, defs, tagRegistry).Render(ctx, &htmlBuilder); err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(`<div id="modal-container">` + htmlBuilder.String() + `</div>`)
+	_ = sse.ExecuteScript("document.getElementById('task-details-modal').showModal()")
+}
+
+// loadTaskDetails loads a task with everything the details modal shows.
+func loadTaskDetails(ctx context.Context, client *ent.Client, id int) (*ent.Task, error) {
+	return client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithTags().
+		WithFieldValues().
+		WithHistory(func(q *ent.TaskHistoryQuery) {
+			q.Order(ent.Desc(taskhistory.FieldCreatedAt))
+		}).
+		WithRevisions(func(q *ent.TaskRevisionQuery) {
+			q.Order(ent.Desc(taskrevision.FieldRevision))
+		}).
+		Only(ctx)
+}
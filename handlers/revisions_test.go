@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
+)
+
+func revisionNumbers(t *testing.T, client *ent.Client, taskID int) []int {
+	t.Helper()
+	revs := client.TaskRevision.Query().
+		Where(taskrevision.HasTaskWith(task.IDEQ(taskID))).
+		Order(ent.Asc(taskrevision.FieldID)).
+		AllX(context.Background())
+	numbers := make([]int, len(revs))
+	for i, rev := range revs {
+		numbers[i] = rev.Revision
+	}
+	return numbers
+}
+
+func TestRecordRevisionNumbers(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	task := ts.createTask(t, map[string]any{"title": "Numbered"}) // revision 1
+
+	if _, err := recordRevision(ctx, ts.Client, task.ID, ""); err != nil {
+		t.Fatal(err)
+	}
+	// Inside a caller's transaction, as bulk edits and retagging do
+	err := withTx(ctx, ts.Client, func(tx *ent.Tx) error {
+		_, err := recordRevision(ctx, tx.Client(), task.ID, "")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recordRevision(ctx, ts.Client, task.ID, ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(revisionNumbers(t, ts.Client, task.ID)); got != "[1 2 3 4]" {
+		t.Errorf("revisions = %s, want [1 2 3 4]", got)
+	}
+
+	err = ts.Client.TaskRevision.Create().
+		SetTaskID(task.ID).SetRevision(2).SetTitle("Twin").SetColumn("backlog").
+		Exec(ctx)
+	if !ent.IsConstraintError(err) {
+		t.Errorf("duplicate revision number: %v, want a constraint error", err)
+	}
+}
+
+func TestRenumberDuplicateRevisions(t *testing.T) {
+	ctx := context.Background()
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db")
+	s, err := openServer(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := s.Client.Task.Create().SetTitle("A").SetColumn("backlog").SaveX(ctx)
+	b := s.Client.Task.Create().SetTitle("B").SetColumn("backlog").SaveX(ctx)
+	s.Close()
+
+	// Numbers as the old count-based numbering could leave them
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.ExecContext(ctx, `DROP INDEX taskrevision_revision_task_revisions`); err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range [][2]int{{a.ID, 1}, {a.ID, 2}, {a.ID, 2}, {a.ID, 3}, {b.ID, 1}, {b.ID, 2}} {
+		_, err := db.ExecContext(ctx,
+			`INSERT INTO task_revisions (revision, title, "column", assignee, priority, due, actor, created_at, task_revisions)
+			VALUES (?, 'x', 'backlog', '', 'none', '', '', CURRENT_TIMESTAMP, ?)`, row[1], row[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, err = openServer(ctx, dsn)
+	if err != nil {
+		t.Fatalf("reopening with duplicate revisions: %v", err)
+	}
+	defer s.Close()
+	if got := fmt.Sprint(revisionNumbers(t, s.Client, a.ID)); got != "[1 2 3 4]" {
+		t.Errorf("A's revisions = %s, want [1 2 3 4]", got)
+	}
+	if got := fmt.Sprint(revisionNumbers(t, s.Client, b.ID)); got != "[1 2]" {
+		t.Errorf("B's revisions = %s, want them left alone", got)
+	}
+}
+
+func TestRevisionRestoreValidatesTags(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	task := ts.createTask(t, map[string]any{"title": "Retyped", "column": "backlog", "tags": []string{"type:bug"}})
+	ts.move(t, task.ID, "in_progress", 0)
+	ts.Client.TagDefinition.Update().
+		Where(tagdefinition.KeyEQ("type")).
+		SetAllowedValues([]string{"feature"}).
+		ExecX(ctx)
+
+	rec := ts.do(t, http.MethodPost, fmt.Sprintf("/datastar/tasks/%d/revisions/1/restore", task.ID), nil)
+	if !strings.Contains(rec.Body.String(), "type must be one of feature") {
+		t.Errorf("restore with a tag the registry no longer allows: %s", rec.Body)
+	}
+	if got := ts.column(t, task.ID); got != "in_progress" {
+		t.Errorf("column = %q, want in_progress", got)
+	}
+}
@@ -16,6 +16,10 @@ import (
 type Server struct {
 	Client      *ent.Client
 	Broadcaster *Broadcaster
+	Presence    *Presence
+	Undo        *UndoStacks
+	Automations *Automations
+	Config      Config
 }
 
 func NewServer(ctx context.Context) (*Server, error) {
@@ -24,7 +28,13 @@ func NewServer(ctx context.Context) (*Server, error) {
 	}
 
 	sql.Register("sqlite3", &sqlite.Driver{})
-	drv, err := entsql.Open("sqlite3", "file:data/bot_task_tracker.db")
+	return openServer(ctx, "file:data/bot_task_tracker.db")
+}
+
+// openServer connects to the SQLite database at dsn, migrates it and wires up
+// the server. The sqlite3 driver must already be registered.
+func openServer(ctx context.Context, dsn string) (*Server, error) {
+	drv, err := entsql.Open("sqlite3", dsn)
 	if err != nil {
 		return nil, err
 	}
@@ -33,16 +43,38 @@ func NewServer(ctx context.Context) (*Server, error) {
 		return nil, err
 	}
 
+	if err := renumberDuplicateRevisions(ctx, drv.DB()); err != nil {
+		return nil, err
+	}
+
 	client := ent.NewClient(ent.Driver(drv))
 
 	if err := client.Schema.Create(ctx); err != nil {
 		return nil, err
 	}
 
-	return &Server{
+	// Data migrations
+	if err := migratePriorityTags(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := seedTagRegistry(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := migrateColumnEnteredAt(ctx, client); err != nil {
+		return nil, err
+	}
+
+	s := &Server{
 		Client:      client,
 		Broadcaster: NewBroadcaster(),
-	}, nil
+		Presence:    NewPresence(),
+		Undo:        NewUndoStacks(),
+		Automations: NewAutomations(),
+		Config:      LoadConfig(),
+	}
+	s.registerAutomationHooks()
+	client.Task.Use(trackColumnEntry)
+	return s, nil
 }
 
 func (s *Server) Close() error {
@@ -59,25 +91,129 @@ func (s *Server) Routes(staticFS fs.FS) *http.ServeMux {
 	// Page routes
 	mux.HandleFunc("GET /{$}", s.BoardViewHandler)
 
+	// Activity feed (full page view and infinite scroll)
+	mux.HandleFunc("GET /activity", s.ActivityPageHandler)
+	mux.HandleFunc("GET /datastar/activity", s.ActivityMoreHandler)
+
+	// Trash (soft-deleted tasks)
+	mux.HandleFunc("GET /trash", s.TrashPageHandler)
+	mux.HandleFunc("POST /datastar/tasks/{id}/restore", s.TaskRestoreHandler)
+	mux.HandleFunc("DELETE /datastar/tasks/{id}/purge", s.TaskPurgeHandler)
+
+	// Archive
+	mux.HandleFunc("GET /archive", s.ArchivePageHandler)
+	mux.HandleFunc("POST /datastar/tasks/{id}/archive", s.TaskArchiveHandler)
+	mux.HandleFunc("POST /datastar/tasks/{id}/unarchive", s.TaskUnarchiveHandler)
+
+	// Undo/redo of the requesting actor's board actions
+	mux.HandleFunc("POST /datastar/undo", s.UndoHandler)
+	mux.HandleFunc("POST /datastar/redo", s.RedoHandler)
+
 	// Column content endpoint (for drag-drop refresh)
 	mux.HandleFunc("GET /columns/{column}", s.ColumnContentHandler)
+	mux.HandleFunc("POST /datastar/columns/{column}/sort", s.ColumnSortHandler)
+
+	// JSON API
+	mux.HandleFunc("GET /api/tasks", s.APITaskListHandler)
+	mux.HandleFunc("POST /api/tasks", s.APITaskCreateHandler)
+	mux.HandleFunc("POST /api/tasks/batch", s.APITaskBatchHandler)
+	mux.HandleFunc("GET /api/tasks/{id}", s.APITaskHandler)
+	mux.HandleFunc("POST /api/tasks/{id}/move", s.APITaskMoveHandler)
+	mux.HandleFunc("POST /api/tasks/{id}/duplicate", s.APITaskDuplicateHandler)
+	mux.HandleFunc("GET /api/quick-add", s.APIQuickAddHandler)
+	mux.HandleFunc("GET /api/templates", s.APITaskTemplateListHandler)
+	mux.HandleFunc("GET /api/fields", s.APIFieldListHandler)
+	mux.HandleFunc("GET /api/analytics", s.APIAnalyticsHandler)
+	mux.HandleFunc("GET /api/analytics/flow", s.APIFlowHandler)
+	mux.HandleFunc("GET /api/board", s.APIBoardHandler)
+	mux.HandleFunc("GET /api/workload", s.APIWorkloadHandler)
+	mux.HandleFunc("GET /api/forecast", s.APIForecastHandler)
+	mux.HandleFunc("GET /api/report", s.APIReportHandler)
+	mux.HandleFunc("GET /api/tags", s.APITagListHandler)
+	mux.HandleFunc("POST /api/tags/retag", s.APITagRetagHandler)
+	mux.HandleFunc("GET /api/suggestions/tags", s.APITagSuggestionsHandler)
+	mux.HandleFunc("GET /api/suggestions/assignees", s.APIAssigneeSuggestionsHandler)
+
+	// Tag registry
+	mux.HandleFunc("GET /tags", s.TagsPageHandler)
+	mux.HandleFunc("POST /datastar/tag-definitions", s.TagDefinitionSaveHandler)
+	mux.HandleFunc("DELETE /datastar/tag-definitions/{id}", s.TagDefinitionDeleteHandler)
+	mux.HandleFunc("POST /datastar/tags/retag", s.TagRetagHandler)
+	mux.HandleFunc("GET /datastar/suggestions/tags", s.TagInputHandler)
+	mux.HandleFunc("GET /datastar/suggestions/assignees", s.AssigneeInputHandler)
+
+	// Custom fields
+	mux.HandleFunc("GET /fields", s.FieldsPageHandler)
+	mux.HandleFunc("POST /datastar/fields", s.FieldCreateHandler)
+	mux.HandleFunc("DELETE /datastar/fields/{id}", s.FieldDeleteHandler)
+
+	// WIP limits
+	mux.HandleFunc("GET /limits", s.WIPLimitsPageHandler)
+	mux.HandleFunc("GET /wip/badges", s.WIPBadgesHandler)
+	mux.HandleFunc("POST /datastar/wip-limits", s.WIPLimitSaveHandler)
+	mux.HandleFunc("DELETE /datastar/wip-limits/{id}", s.WIPLimitDeleteHandler)
+
+	// Stale thresholds
+	mux.HandleFunc("GET /stale", s.StalePageHandler)
+	mux.HandleFunc("POST /datastar/stale-thresholds", s.StaleThresholdSaveHandler)
+	mux.HandleFunc("DELETE /datastar/stale-thresholds/{id}", s.StaleThresholdDeleteHandler)
+
+	// Workflow
+	mux.HandleFunc("GET /workflow", s.WorkflowPageHandler)
+	mux.HandleFunc("POST /datastar/transitions", s.TransitionSaveHandler)
+	mux.HandleFunc("DELETE /datastar/transitions/{id}", s.TransitionDeleteHandler)
+
+	// Automation rules and their execution log
+	mux.HandleFunc("GET /automations", s.AutomationsPageHandler)
+	mux.HandleFunc("POST /datastar/automations", s.AutomationSaveHandler)
+	mux.HandleFunc("POST /datastar/automations/{id}/toggle", s.AutomationToggleHandler)
+	mux.HandleFunc("DELETE /datastar/automations/{id}", s.AutomationDeleteHandler)
+
+	// Recurring tasks
+	mux.HandleFunc("GET /recurring", s.RecurringPageHandler)
+	mux.HandleFunc("GET /datastar/recurring/preview", s.RecurringPreviewHandler)
+	mux.HandleFunc("POST /datastar/recurring", s.RecurringSaveHandler)
+	mux.HandleFunc("POST /datastar/recurring/{id}/toggle", s.RecurringToggleHandler)
+	mux.HandleFunc("DELETE /datastar/recurring/{id}", s.RecurringDeleteHandler)
+
+	// Flow analytics
+	mux.HandleFunc("GET /analytics", s.AnalyticsPageHandler)
+	mux.HandleFunc("GET /board/as-of", s.BoardAsOfHandler)
+	mux.HandleFunc("GET /workload", s.WorkloadPageHandler)
+	mux.HandleFunc("GET /forecast", s.ForecastPageHandler)
+	mux.HandleFunc("GET /report", s.ReportPageHandler)
+
+	// Task templates
+	mux.HandleFunc("GET /templates", s.TaskTemplatesPageHandler)
+	mux.HandleFunc("POST /datastar/task-templates", s.TaskTemplateSaveHandler)
+	mux.HandleFunc("DELETE /datastar/task-templates/{id}", s.TaskTemplateDeleteHandler)
 
 	// SSE endpoint for unified real-time updates (board + activity)
 	mux.HandleFunc("GET /datastar/events", s.HandleEvents)
 
+	// Identity and presence (who is viewing or editing which task)
+	mux.HandleFunc("GET /identity", s.IdentityHandler)
+	mux.HandleFunc("POST /presence", s.PresenceHeartbeatHandler)
+
 	// Datastar SSE routes for tasks
 	mux.HandleFunc("GET /datastar/tasks/add-form", s.TaskAddFormHandler)
 	mux.HandleFunc("POST /datastar/tasks", s.TaskCreateHandler)
+	mux.HandleFunc("GET /datastar/quick-add/preview", s.QuickAddPreviewHandler)
+	mux.HandleFunc("POST /datastar/quick-add", s.QuickAddHandler)
+	mux.HandleFunc("POST /datastar/tasks/bulk", s.TaskBulkHandler)
 	mux.HandleFunc("GET /datastar/tasks/details/{id}", s.TaskDetailsHandler)
 	mux.HandleFunc("GET /datastar/tasks/edit/{id}", s.TaskEditFormHandler)
 	mux.HandleFunc("PUT /datastar/tasks/{id}", s.TaskUpdateHandler)
 	mux.HandleFunc("PATCH /datastar/tasks/{id}/column", s.TaskColumnUpdateHandler)
 	mux.HandleFunc("PATCH /datastar/tasks/{id}/position", s.TaskPositionUpdateHandler)
 	mux.HandleFunc("DELETE /datastar/tasks/{id}", s.TaskDeleteHandler)
+	mux.HandleFunc("POST /datastar/tasks/{id}/duplicate", s.TaskDuplicateHandler)
 	mux.HandleFunc("POST /datastar/tasks/{id}/move", s.TaskMoveHandler)
 	mux.HandleFunc("POST /datastar/tasks/{id}/assign", s.TaskAssignHandler)
 	mux.HandleFunc("POST /datastar/tasks/{id}/tag", s.TaskAddTagHandler)
 	mux.HandleFunc("DELETE /datastar/tasks/{id}/tags/{tagId}", s.TaskRemoveTagHandler)
+	mux.HandleFunc("GET /datastar/tasks/{id}/revisions/{rev}", s.TaskRevisionCompareHandler)
+	mux.HandleFunc("POST /datastar/tasks/{id}/revisions/{rev}/restore", s.TaskRevisionRestoreHandler)
 
 	return mux
 }
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	sqlite "modernc.org/sqlite"
+)
+
+func TestMain(m *testing.M) {
+	sql.Register("sqlite3", &sqlite.Driver{})
+	os.Exit(m.Run())
+}
+
+// testServer is a server on a fresh database, with the same middleware as
+// the real one.
+type testServer struct {
+	*Server
+	handler http.Handler
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db")
+	s, err := openServer(context.Background(), dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	h := LoggingMiddleware(s.AutomationMiddleware(s.Routes(fstest.MapFS{"static/x": {}})))
+	return &testServer{Server: s, handler: h}
+}
+
+// do sends a request with a JSON body (unless body is nil) and the given
+// headers, in name/value pairs.
+func (ts *testServer) do(t *testing.T, method, path string, body any, headers ...string) *httptest.ResponseRecorder {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	ts.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// createTask creates a task through the API and returns it.
+func (ts *testServer) createTask(t *testing.T, body map[string]any) apiTask {
+	t.Helper()
+	rec := ts.do(t, http.MethodPost, "/api/tasks", body)
+	if rec.Code != http.StatusCreated && rec.Code != http.StatusOK {
+		t.Fatalf("create task: %d %s", rec.Code, rec.Body)
+	}
+	var task apiTask
+	if err := json.Unmarshal(rec.Body.Bytes(), &task); err != nil {
+		t.Fatalf("create task: %v: %s", err, rec.Body)
+	}
+	return task
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/automation"
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/hook"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/stalethreshold"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/stale"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// loadStaleThresholds returns the configured threshold of every column that
+// has one.
+func loadStaleThresholds(ctx context.Context, client *ent.Client) (stale.Thresholds, error) {
+	rows, err := client.StaleThreshold.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	thresholds := make(stale.Thresholds, len(rows))
+	for _, row := range rows {
+		thresholds[row.Column] = time.Duration(row.Days) * stale.Day
+	}
+	return thresholds, nil
+}
+
+// stuckPredicate matches tasks that have been in their column longer than its
+// threshold. Without thresholds nothing is stuck.
+func stuckPredicate(thresholds stale.Thresholds, now time.Time) predicate.Task {
+	var preds []predicate.Task
+	for column, cutoff := range thresholds.Cutoffs(now) {
+		preds = append(preds, task.And(task.ColumnEQ(column), task.ColumnEnteredAtLTE(cutoff)))
+	}
+	if len(preds) == 0 {
+		return func(s *entsql.Selector) { s.Where(entsql.False()) }
+	}
+	return task.Or(preds...)
+}
+
+// trackColumnEntry stamps column_entered_at whenever an update moves a task to
+// another column, however the move happened.
+func trackColumnEntry(next ent.Mutator) ent.Mutator {
+	return hook.TaskFunc(func(ctx context.Context, m *ent.TaskMutation) (ent.Value, error) {
+		if column, ok := m.Column(); ok && m.Op().Is(ent.OpUpdateOne) {
+			if old, err := m.OldColumn(ctx); err == nil && old != column {
+				m.SetColumnEnteredAt(time.Now())
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// migrateColumnEnteredAt fills in column_entered_at for tasks created before
+// it existed, replaying their history to find their last move.
+func migrateColumnEnteredAt(ctx context.Context, client *ent.Client) error {
+	tasks, err := client.Task.Query().
+		Where(task.ColumnEnteredAtIsNil()).
+		WithHistory().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		timeline := flowTask(t).Timeline
+		entered := timeline[len(timeline)-1].At
+		if err := client.Task.UpdateOneID(t.ID).SetColumnEnteredAt(entered).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if len(tasks) > 0 {
+		slog.InfoContext(ctx, "backfilled column entry times", "count", len(tasks))
+	}
+	return nil
+}
+
+// flagStuckTasks records a "stuck" history entry and fires the stuck
+// automation trigger for every task past its column's threshold, once per
+// stay in a column.
+func (s *Server) flagStuckTasks(ctx context.Context) error {
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil || len(thresholds) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	tasks, err := s.Client.Task.Query().
+		Where(onBoard(), stuckPredicate(thresholds, now)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		columns []string
+		entries []int
+	)
+	for _, t := range tasks {
+		age := thresholds.Age(t.Column, *t.ColumnEnteredAt, now)
+		flagged, err := s.Client.TaskHistory.Query().
+			Where(
+				taskhistory.HasTaskWith(task.IDEQ(t.ID)),
+				taskhistory.ActionEQ("stuck"),
+				taskhistory.CreatedAtGTE(*t.ColumnEnteredAt), // flagged during this stay
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if flagged {
+			continue
+		}
+
+		details := fmt.Sprintf("stuck in %s for %d days (threshold %d)", t.Column, age.Days(), int(age.Threshold/stale.Day))
+		entry, err := s.Client.TaskHistory.Create().
+			SetTaskID(t.ID).
+			SetAction("stuck").
+			SetDetails(details).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry.ID)
+		if !slices.Contains(columns, t.Column) {
+			columns = append(columns, t.Column)
+		}
+		s.Automations.enqueue(automationEvent{
+			Event: automation.Event{Trigger: automation.Stuck, TaskID: t.ID, Column: t.Column},
+			chain: newAutomationChain(),
+		})
+	}
+	if len(entries) > 0 {
+		s.Broadcaster.BroadcastBatch(columns, entries)
+		slog.InfoContext(ctx, "flagged stuck tasks", "count", len(entries))
+	}
+	return nil
+}
+
+// StalePageHandler lists the stale thresholds and the tasks currently past
+// them.
+func (s *Server) StalePageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rows, err := s.Client.StaleThreshold.Query().
+		Order(ent.Asc(stalethreshold.FieldColumn)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get stale thresholds", "error", err)
+		http.Error(w, "Failed to load stale thresholds", http.StatusInternalServerError)
+		return
+	}
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
+		http.Error(w, "Failed to load stale thresholds", http.StatusInternalServerError)
+		return
+	}
+	stuck, err := s.Client.Task.Query().
+		Where(onBoard(), stuckPredicate(thresholds, time.Now())).
+		Order(ent.Asc(task.FieldColumnEnteredAt)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get stuck tasks", "error", err)
+		http.Error(w, "Failed to load stuck tasks", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.StaleContent(rows, stuck, thresholds, boardColumns)
+	page := templates.Layout("Stale thresholds - Bot Task Tracker", pages.StaleMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// StaleThresholdSaveHandler sets the threshold of a column, replacing any
+// existing one.
+func (s *Server) StaleThresholdSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type StaleThresholdSignals struct {
+		Column string `json:"stale_column"`
+		Days   string `json:"stale_days"`
+	}
+	signals := &StaleThresholdSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	column := sanitizeColumn(signals.Column)
+	days, err := strconv.Atoi(strings.TrimSpace(signals.Days))
+	if err != nil || days < 1 {
+		_ = sse.PatchElements(`<div id="stale-error" class="alert alert-error text-sm">Threshold must be a whole number of days, at least 1</div>`)
+		return
+	}
+
+	existing, err := s.Client.StaleThreshold.Query().
+		Where(stalethreshold.ColumnEQ(column)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = s.Client.StaleThreshold.Create().
+			SetColumn(column).
+			SetDays(days).
+			Exec(ctx)
+	case err == nil:
+		err = existing.Update().
+			SetDays(days).
+			Exec(ctx)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save stale threshold", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/stale")
+}
+
+// StaleThresholdDeleteHandler removes a column's threshold.
+func (s *Server) StaleThresholdDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid threshold ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.Client.StaleThreshold.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete stale threshold", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/stale")
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/suggest"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+)
+
+// defaultAssignees are always offered, even before they have any tasks.
+var defaultAssignees = []string{"peter", "john"}
+
+// suggestionLimit caps how many suggestions the forms show.
+const suggestionLimit = 8
+
+// tagKeyTally counts tag keys in use. Registered keys are included even when
+// no task uses them yet.
+func tagKeyTally(ctx context.Context, client *ent.Client) (suggest.Tally, error) {
+	tags, err := client.TaskTag.Query().
+		Select(tasktag.FieldKey, tasktag.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tally := suggest.Tally{}
+	for _, tag := range tags {
+		tally.Add(tag.Key, tag.CreatedAt)
+	}
+
+	reg, err := loadTagRegistry(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range reg {
+		tally.AddKnown(d.Key)
+	}
+	return tally, nil
+}
+
+// tagValueTally counts the values used with a key. If the registry restricts
+// the key, only allowed values are offered.
+func tagValueTally(ctx context.Context, client *ent.Client, key string) (suggest.Tally, error) {
+	tags, err := client.TaskTag.Query().
+		Where(tasktag.KeyEQ(key)).
+		Select(tasktag.FieldValue, tasktag.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reg, err := loadTagRegistry(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	d, registered := reg.Lookup(key)
+
+	tally := suggest.Tally{}
+	for _, tag := range tags {
+		if !registered || d.Allows(tag.Value) {
+			tally.Add(tag.Value, tag.CreatedAt)
+		}
+	}
+	for _, value := range d.AllowedValues {
+		tally.AddKnown(value)
+	}
+	return tally, nil
+}
+
+// assigneeTally counts assignees by the tasks they hold, most recently
+// updated first.
+func assigneeTally(ctx context.Context, client *ent.Client) (suggest.Tally, error) {
+	tasks, err := client.Task.Query().
+		Where(task.AssigneeNEQ("")).
+		Select(task.FieldAssignee, task.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tally := suggest.Tally{}
+	for _, t := range tasks {
+		tally.Add(t.Assignee, t.UpdatedAt)
+	}
+	for _, name := range defaultAssignees {
+		tally.AddKnown(name)
+	}
+	return tally, nil
+}
+
+// suggestTags returns tag keys matching q, or values of key matching q.
+func suggestTags(ctx context.Context, client *ent.Client, key, q string, limit int) ([]suggest.Candidate, error) {
+	var (
+		tally suggest.Tally
+		err   error
+	)
+	if key == "" {
+		tally, err = tagKeyTally(ctx, client)
+	} else {
+		tally, err = tagValueTally(ctx, client, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tally.Rank(q, time.Now(), limit), nil
+}
+
+func suggestionLimitParam(r *http.Request) int {
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		return suggestionLimit
+	}
+	return limit
+}
+
+// APITagSuggestionsHandler suggests tag keys (?q=) or the values of a key
+// (?key=&q=), ranked by frequency and recency.
+func (s *Server) APITagSuggestionsHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	result, err := suggestTags(r.Context(), s.Client, q.Get("key"), q.Get("q"), suggestionLimitParam(r))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to suggest tags", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to suggest tags")
+		return
+	}
+	if result == nil {
+		result = []suggest.Candidate{}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// APIAssigneeSuggestionsHandler suggests assignees matching ?q=.
+func (s *Server) APIAssigneeSuggestionsHandler(w http.ResponseWriter, r *http.Request) {
+	tally, err := assigneeTally(r.Context(), s.Client)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to suggest assignees", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to suggest assignees")
+		return
+	}
+	result := tally.Rank(r.URL.Query().Get("q"), time.Now(), suggestionLimitParam(r))
+	if result == nil {
+		result = []suggest.Candidate{}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// suggestionForm returns the ?form= of a chip input, which keeps the element
+// IDs of the add and edit modals apart.
+func suggestionForm(r *http.Request) string {
+	if r.URL.Query().Get("form") == "edit" {
+		return "edit"
+	}
+	return "add"
+}
+
+// TagInputHandler re-renders the tag chips of a form and the suggestions for
+// what is being typed. Before a colon keys are suggested, after it values.
+// ?browse=true suggests keys for an empty query, when the input gets focus.
+func (s *Server) TagInputHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type TagInputSignals struct {
+		Tags  string `json:"tags"`
+		Query string `json:"tag_query"`
+	}
+	signals := &TagInputSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	form := suggestionForm(r)
+
+	sse := datastar.NewSSE(w, r)
+
+	tags := parseTags(signals.Tags)
+	pairs := make([]string, len(tags))
+	for i, tag := range tags {
+		pairs[i] = tag.Key + ":" + tag.Value
+	}
+
+	query := strings.TrimSpace(signals.Query)
+	key, value, pickingValue := strings.Cut(query, ":")
+	var suggestions []suggest.Candidate
+	if query != "" || r.URL.Query().Get("browse") == "true" {
+		var err error
+		if pickingValue {
+			suggestions, err = suggestTags(ctx, s.Client, strings.TrimSpace(key), strings.TrimSpace(value), suggestionLimit)
+		} else {
+			suggestions, err = suggestTags(ctx, s.Client, "", key, suggestionLimit)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to suggest tags", "error", err)
+			_ = sse.ConsoleError(err)
+			return
+		}
+	}
+	if !pickingValue {
+		key = ""
+	}
+
+	var htmlBuilder strings.Builder
+	if err := fragments.TagChips(form, pairs).Render(ctx, &htmlBuilder); err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := fragments.TagSuggestions(form, pairs, strings.TrimSpace(key), suggestions).Render(ctx, &htmlBuilder); err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String())
+}
+
+// AssigneeInputHandler renders assignee suggestions for the add and edit forms.
+func (s *Server) AssigneeInputHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type AssigneeInputSignals struct {
+		Assignee string `json:"assignee"`
+	}
+	signals := &AssigneeInputSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	form := suggestionForm(r)
+
+	sse := datastar.NewSSE(w, r)
+
+	tally, err := assigneeTally(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to suggest assignees", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+	query := strings.TrimSpace(signals.Assignee)
+	suggestions := tally.Rank(query, time.Now(), suggestionLimit)
+	// Nothing left to suggest once the input holds an exact match
+	if len(suggestions) == 1 && suggestions[0].Value == query {
+		suggestions = nil
+	}
+
+	var htmlBuilder strings.Builder
+	if err := fragments.AssigneeSuggestions(form, suggestions).Render(ctx, &htmlBuilder); err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String())
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"context"
+	"html"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+	"github.com/j0hnsmith/botTaskTracker/tagregistry"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// legacyTagStyles keeps the look of the categories that used to be hardcoded
+// in the card template when the registry is first seeded.
+var legacyTagStyles = map[string]tagregistry.Definition{
+	"auth":        {DisplayName: "Security", Emoji: "🔐", Color: "secondary"},
+	"bug":         {DisplayName: "Bugs", Emoji: "🐛", Color: "error"},
+	"ops":         {DisplayName: "Ops", Emoji: "⚙️", Color: "warning"},
+	"ui":          {DisplayName: "UI/UX", Emoji: "🎨", Color: "secondary"},
+	"docs":        {DisplayName: "Docs", Emoji: "📚", Color: "accent"},
+	"enhancement": {DisplayName: "Core", Emoji: "📊", Color: "success"},
+	"payment":     {DisplayName: "Payments", Emoji: "💳", Color: "success"},
+	"testing":     {DisplayName: "Payments", Emoji: "💳", Color: "warning"},
+	"complete":    {DisplayName: "Done", Emoji: "✅", Color: "success"},
+	"feature":     {Color: "info"},
+	"critical":    {Color: "warning"},
+	"infra":       {Color: "accent"},
+	"daisyui":     {Color: "success"},
+}
+
+func toTagDefinition(d *ent.TagDefinition) tagregistry.Definition {
+	return tagregistry.Definition{
+		Key:           d.Key,
+		DisplayName:   d.DisplayName,
+		Emoji:         d.Emoji,
+		Color:         d.Color,
+		AllowedValues: d.AllowedValues,
+		MultiValued:   d.MultiValued,
+	}
+}
+
+// loadTagRegistry returns the tag definitions in registry order.
+func loadTagRegistry(ctx context.Context, client *ent.Client) (tagregistry.Registry, error) {
+	rows, err := client.TagDefinition.Query().
+		Order(ent.Asc(tagdefinition.FieldPosition), ent.Asc(tagdefinition.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reg := make(tagregistry.Registry, len(rows))
+	for i, row := range rows {
+		reg[i] = toTagDefinition(row)
+	}
+	return reg, nil
+}
+
+// validateTags checks form input against the registry.
+func validateTags(reg tagregistry.Registry, tags []tagInput) error {
+	pairs := make([]tagregistry.Tag, len(tags))
+	for i, tag := range tags {
+		pairs[i] = tagregistry.Tag{Key: tag.Key, Value: tag.Value}
+	}
+	return reg.Validate(pairs)
+}
+
+// tagErrorHTML renders a validation error for the add/edit forms.
+func tagErrorHTML(id string, err error) string {
+	msg := strings.ReplaceAll(err.Error(), "\n", "; ")
+	return `<div id="` + id + `" class="text-error text-sm">` + html.EscapeString(msg) + `</div>`
+}
+
+// seedTagRegistry creates a free-text definition for every tag key already in
+// use, so existing tasks stay valid, the first time the registry is empty.
+// Legacy categories used as values, as in type:bug, get a definition too so
+// those cards keep their category badge.
+func seedTagRegistry(ctx context.Context, client *ent.Client) error {
+	exists, err := client.TagDefinition.Query().Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	var keys, values []string
+	if err := client.TaskTag.Query().GroupBy("key").Scan(ctx, &keys); err != nil {
+		return err
+	}
+	if err := client.TaskTag.Query().GroupBy("value").Scan(ctx, &values); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		keys = []string{"type", "project"}
+	}
+	for _, value := range values {
+		if legacyTagStyles[value].Emoji != "" && !slices.Contains(keys, value) {
+			keys = append(keys, value)
+		}
+	}
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		style := legacyTagStyles[key]
+		color := style.Color
+		if color == "" {
+			color = "neutral"
+		}
+		if err := client.TagDefinition.Create().
+			SetKey(key).
+			SetDisplayName(style.DisplayName).
+			SetEmoji(style.Emoji).
+			SetColor(color).
+			SetMultiValued(true).
+			SetPosition(i).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	slog.InfoContext(ctx, "seeded tag registry", "keys", len(keys))
+	return nil
+}
+
+// TagsPageHandler shows the tag registry and the tags in use.
+func (s *Server) TagsPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	defs, err := s.Client.TagDefinition.Query().
+		Order(ent.Asc(tagdefinition.FieldPosition), ent.Asc(tagdefinition.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get tag definitions", "error", err)
+		http.Error(w, "Failed to load tags", http.StatusInternalServerError)
+		return
+	}
+
+	usage, err := tagUsage(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count tags", "error", err)
+		http.Error(w, "Failed to load tags", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.TagsContent(defs, tagregistry.Colors, usage)
+	page := templates.Layout("Tags - Bot Task Tracker", pages.TagsMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// TagDefinitionSaveHandler creates a tag definition or updates the one with
+// the same key.
+func (s *Server) TagDefinitionSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type TagDefinitionSignals struct {
+		Key           string `json:"tag_key"`
+		DisplayName   string `json:"tag_name"`
+		Emoji         string `json:"tag_emoji"`
+		Color         string `json:"tag_color"`
+		AllowedValues string `json:"tag_values"`
+		MultiValued   bool   `json:"tag_multi"`
+	}
+	signals := &TagDefinitionSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	var values []string
+	for _, v := range strings.Split(signals.AllowedValues, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	def := tagregistry.Definition{
+		Key:           strings.TrimSpace(signals.Key),
+		DisplayName:   strings.TrimSpace(signals.DisplayName),
+		Emoji:         strings.TrimSpace(signals.Emoji),
+		Color:         signals.Color,
+		AllowedValues: values,
+		MultiValued:   signals.MultiValued,
+	}
+	if err := def.Check(); err != nil {
+		_ = sse.PatchElements(`<div id="tag-error" class="alert alert-error text-sm">` + html.EscapeString(err.Error()) + `</div>`)
+		return
+	}
+
+	existing, err := s.Client.TagDefinition.Query().
+		Where(tagdefinition.KeyEQ(def.Key)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		count, err := s.Client.TagDefinition.Query().Count(ctx)
+		if err != nil {
+			_ = sse.ConsoleError(err)
+			return
+		}
+		err = s.Client.TagDefinition.Create().
+			SetKey(def.Key).
+			SetDisplayName(def.DisplayName).
+			SetEmoji(def.Emoji).
+			SetColor(def.Color).
+			SetAllowedValues(def.AllowedValues).
+			SetMultiValued(def.MultiValued).
+			SetPosition(count).
+			Exec(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to create tag definition", "error", err)
+			_ = sse.ConsoleError(err)
+			return
+		}
+	case err != nil:
+		_ = sse.ConsoleError(err)
+		return
+	default:
+		err = existing.Update().
+			SetDisplayName(def.DisplayName).
+			SetEmoji(def.Emoji).
+			SetColor(def.Color).
+			SetAllowedValues(def.AllowedValues).
+			SetMultiValued(def.MultiValued).
+			Exec(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to update tag definition", "error", err)
+			_ = sse.ConsoleError(err)
+			return
+		}
+	}
+
+	_ = sse.Redirect("/tags")
+}
+
+// TagDefinitionDeleteHandler removes a key from the registry. Tags already
+// using it are kept and flagged on their cards.
+func (s *Server) TagDefinitionDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid tag definition ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.Client.TagDefinition.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete tag definition", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.RemoveElement("#tag-def-row-" + strconv.Itoa(id))
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/tagdefinition"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+)
+
+func TestSeedKeepsValueCategories(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	ts.Client.TagDefinition.Delete().ExecX(ctx)
+	created := ts.Client.Task.Create().SetTitle("Crash on login").SetColumn("backlog").SaveX(ctx)
+	ts.Client.TaskTag.Create().SetKey("type").SetValue("bug").SetTaskID(created.ID).ExecX(ctx)
+
+	if err := seedTagRegistry(ctx, ts.Client); err != nil {
+		t.Fatal(err)
+	}
+	bug := ts.Client.TagDefinition.Query().Where(tagdefinition.KeyEQ("bug")).OnlyX(ctx)
+	if bug.Emoji != "🐛" {
+		t.Errorf("bug category emoji = %q", bug.Emoji)
+	}
+
+	reg, err := loadTagRegistry(ctx, ts.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	card := ts.Client.Task.Query().Where(task.IDEQ(created.ID)).WithTags().OnlyX(ctx)
+	var b strings.Builder
+	if err := fragments.CategoryBadge(card, reg).Render(ctx, &b); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "🐛") {
+		t.Errorf("type:bug card has no bug badge: %s", b.String())
+	}
+}
@@ -3,21 +3,27 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"html"
 	"log/slog"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/a-h/templ"
 	"github.com/starfederation/datastar-go/datastar"
 
+	"github.com/j0hnsmith/botTaskTracker/customfield"
 	"github.com/j0hnsmith/botTaskTracker/ent"
 	"github.com/j0hnsmith/botTaskTracker/ent/task"
-	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
-	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/history"
 	"github.com/j0hnsmith/botTaskTracker/templates"
 	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
 	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+	"github.com/j0hnsmith/botTaskTracker/wip"
+	"github.com/j0hnsmith/botTaskTracker/workflow"
 )
 
 // ColumnContentHandler returns the HTML content for a single column.
@@ -31,11 +37,8 @@ func (s *Server) ColumnContentHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Get all tasks in the column
 	tasks, err := s.Client.Task.Query().
-		Where(task.ColumnEQ(column)).
+		Where(onBoard(), task.ColumnEQ(column)).
 		WithTags().
-		WithHistory(func(q *ent.TaskHistoryQuery) {
-			q.Order(ent.Desc(taskhistory.FieldCreatedAt))
-		}).
 		Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
@@ -43,11 +46,21 @@ func (s *Server) ColumnContentHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Failed to load column", http.StatusInternalServerError)
 		return
 	}
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		http.Error(w, "Failed to load tags", http.StatusInternalServerError)
+		return
+	}
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
+		http.Error(w, "Failed to load stale thresholds", http.StatusInternalServerError)
+		return
+	}
 
 	// Render all task cards
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	for _, t := range tasks {
-		if err := fragments.TaskCard(t, column).Render(ctx, w); err != nil {
+		if err := fragments.TaskCard(t, column, tagRegistry, thresholds).Render(ctx, w); err != nil {
 			slog.ErrorContext(ctx, "failed to render task card", "task_id", t.ID, "error", err)
 			http.Error(w, "Failed to render task", http.StatusInternalServerError)
 			return
@@ -62,12 +75,10 @@ func (s *Server) BoardViewHandler(w http.ResponseWriter, r *http.Request) {
 	// Get filter parameters
 	selectedAssignee := r.URL.Query().Get("assignee")
 
-	// Build query
+	// Build query (cards don't show history, so it isn't loaded here)
 	query := s.Client.Task.Query().
+		Where(onBoard()).
 		WithTags().
-		WithHistory(func(q *ent.TaskHistoryQuery) {
-			q.Order(ent.Desc(taskhistory.FieldCreatedAt))
-		}).
 		Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreatedAt))
 
 	// Apply assignee filter
@@ -75,6 +86,39 @@ func (s *Server) BoardViewHandler(w http.ResponseWriter, r *http.Request) {
 		query = query.Where(task.AssigneeEQ(selectedAssignee))
 	}
 
+	// Apply custom field filters (?field.<key>=<value>)
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get custom fields", "error", err)
+		http.Error(w, "Failed to load fields", http.StatusInternalServerError)
+		return
+	}
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get tag registry", "error", err)
+		http.Error(w, "Failed to load tags", http.StatusInternalServerError)
+		return
+	}
+	fieldPreds, err := fieldPredicates(defs, r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query = query.Where(fieldPreds...)
+
+	// Apply the stuck filter (?stuck=true): tasks past their column's stale
+	// threshold
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get stale thresholds", "error", err)
+		http.Error(w, "Failed to load stale thresholds", http.StatusInternalServerError)
+		return
+	}
+	stuckOnly := r.URL.Query().Get("stuck") == "true"
+	if stuckOnly {
+		query = query.Where(stuckPredicate(thresholds, time.Now()))
+	}
+
 	// Get all tasks
 	tasks, err := query.All(ctx)
 	if err != nil {
@@ -83,12 +127,8 @@ func (s *Server) BoardViewHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get activity feed
-	activity, err := s.Client.TaskHistory.Query().
-		WithTask().
-		Order(ent.Desc(taskhistory.FieldCreatedAt)).
-		Limit(30).
-		All(ctx)
+	// Get first page of the activity feed
+	activity, cursor, err := queryActivity(ctx, s.Client, activityFilter{})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to get activity", "error", err)
 		http.Error(w, "Failed to load activity", http.StatusInternalServerError)
@@ -96,11 +136,24 @@ func (s *Server) BoardViewHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get unique assignees
-	assignees := []string{"peter", "john"}
+	assignees := defaultAssignees
+
+	limits, err := loadWIPLimits(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get WIP limits", "error", err)
+		http.Error(w, "Failed to load WIP limits", http.StatusInternalServerError)
+		return
+	}
+	counts, err := columnCounts(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to count tasks", "error", err)
+		http.Error(w, "Failed to load tasks", http.StatusInternalServerError)
+		return
+	}
 
 	// Render page
 	metaTags := pages.BoardMetaTags()
-	bodyContent := pages.BoardContent(tasks, activity, assignees, selectedAssignee)
+	bodyContent := pages.BoardContent(tasks, groupActivity(activity), activityNextURL(activityFilter{}, cursor), assignees, selectedAssignee, actorFromRequest(r), defs, tagRegistry, limits, counts, thresholds, stuckOnly)
 	boardTemplate := templates.Layout("Bot Task Tracker", metaTags, bodyContent)
 
 	err = boardTemplate.Render(ctx, w)
@@ -113,15 +166,36 @@ func (s *Server) BoardViewHandler(w http.ResponseWriter, r *http.Request) {
 	slog.InfoContext(ctx, "render page", "method", r.Method, "status", http.StatusOK, "path", r.URL.Path)
 }
 
-// TaskAddFormHandler returns an empty add task form via SSE.
+// TaskAddFormHandler returns an empty add task form via SSE. With
+// ?template=<id> the form starts from that task template.
 func (s *Server) TaskAddFormHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 	sse := datastar.NewSSE(w, r)
 
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	taskTemplates, err := loadTaskTemplates(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	var tpl *ent.TaskTemplate
+	if id, err := strconv.Atoi(r.URL.Query().Get("template")); err == nil {
+		for _, t := range taskTemplates {
+			if t.ID == id {
+				tpl = t
+			}
+		}
+	}
+
 	// Render modal with empty form
 	var htmlBuilder strings.Builder
-	err := fragments.TaskAddModalWithForm().Render(r.Context(), &htmlBuilder)
+	err = fragments.TaskAddModalWithForm(defs, taskTemplates, tpl).Render(ctx, &htmlBuilder)
 	if err != nil {
-		slog.ErrorContext(r.Context(), "failed to render add modal", "error", err)
+		slog.ErrorContext(ctx, "failed to render add modal", "error", err)
 		_ = sse.ConsoleError(err)
 		return
 	}
@@ -130,12 +204,30 @@ func (s *Server) TaskAddFormHandler(w http.ResponseWriter, r *http.Request) {
 	_ = sse.PatchElements(`<div id="modal-container">` + htmlBuilder.String() + `</div>`)
 
 	// Set default signals
+	defaults := make(map[string]string, len(defs))
+	for _, d := range defs {
+		defaults[d.Key] = d.Default
+	}
 	signals := map[string]interface{}{
-		"title":       "",
-		"description": "",
-		"column":      "backlog",
-		"assignee":    "",
-		"tags":        "",
+		"title":        "",
+		"description":  "",
+		"column":       "backlog",
+		"assignee":     "",
+		"priority":     "none",
+		"due":          "",
+		"tags":         "",
+		"tag_query":    "",
+		"wip_override": false,
+		"template_id":  "",
+		"fields":       fieldSignals(defs, defaults),
+	}
+	if tpl != nil {
+		signals["title"] = expandTitlePattern(tpl.Title, time.Now())
+		signals["description"] = tpl.Description
+		signals["column"] = sanitizeColumn(tpl.Column)
+		signals["assignee"] = tpl.Assignee
+		signals["tags"] = strings.Join(tpl.Tags, ",")
+		signals["template_id"] = strconv.Itoa(tpl.ID)
 	}
 	signalsJSON, _ := json.Marshal(signals)
 	_ = sse.PatchSignals(signalsJSON)
@@ -143,119 +235,245 @@ func (s *Server) TaskAddFormHandler(w http.ResponseWriter, r *http.Request) {
 	_ = sse.ExecuteScript("document.getElementById('add-task-modal').showModal()")
 }
 
-// TaskCreateHandler creates a new task via SSE.
-func (s *Server) TaskCreateHandler(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// taskInput is a task to create, as submitted by the add form or the API.
+type taskInput struct {
+	Title       string
+	Description string
+	Column      string
+	Assignee    string
+	Priority    string
+	Due         string
+	Tags        []tagInput
+	Fields      map[string]string
+	WIPOverride bool
+	// Details replaces the default "created in <column>" history entry
+	Details string
+}
 
-	// Read signals BEFORE creating SSE
-	type TaskCreateSignals struct {
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Column      string `json:"column"`
-		Assignee    string `json:"assignee"`
-		Tags        string `json:"tags"`
+// createRejection is a new task that fails validation or a WIP limit.
+type createRejection struct {
+	reason string
+	breach *wip.Breach
+}
+
+func (e *createRejection) Error() string { return e.reason }
+
+// createTask validates and creates a task with its tags and custom fields,
+// records its history and first revision, and broadcasts it. Invalid input
+// and WIP blocks are returned as *createRejection.
+func (s *Server) createTask(ctx context.Context, r *http.Request, in taskInput) (*ent.Task, error) {
+	if strings.TrimSpace(in.Title) == "" {
+		return nil, &createRejection{reason: "Title is required"}
 	}
-	signals := &TaskCreateSignals{}
-	err := datastar.ReadSignals(r, signals)
+	column := sanitizeColumn(in.Column)
+
+	priority, ok := parsePriority(in.Priority)
+	if !ok {
+		return nil, &createRejection{reason: "Unknown priority"}
+	}
+	due, err := parseDue(in.Due)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to read signals", "error", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return nil, &createRejection{reason: "Invalid due date"}
 	}
+	tags, priority := extractPriorityTag(in.Tags, priority)
 
-	// Create SSE AFTER reading signals
-	sse := datastar.NewSSE(w, r)
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		return nil, err
+	}
+	fieldValues, fieldErrs := customfield.Validate(defs, in.Fields, true)
+	if len(fieldErrs) > 0 {
+		return nil, &createRejection{reason: fieldErrors(defs, fieldErrs)}
+	}
 
-	// Validate required fields
-	if signals.Title == "" {
-		_ = sse.PatchElements(`<div id="add-error" class="text-error text-sm">Title is required</div>`)
-		return
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTags(tagRegistry, tags); err != nil {
+		return nil, &createRejection{reason: strings.ReplaceAll(err.Error(), "\n", "; ")}
 	}
 
-	// Sanitize column
-	column := sanitizeColumn(signals.Column)
+	breach, breached, err := checkWIP(ctx, s.Client, column, in.Assignee, 0)
+	if err != nil {
+		return nil, err
+	}
+	if breached && breach.Blocks(in.WIPOverride) {
+		slog.InfoContext(ctx, "task creation blocked by WIP limit", "column", column, "mode", breach.Limit.Mode)
+		return nil, &createRejection{reason: breach.Error(), breach: &breach}
+	}
 
 	// Get next position in column
 	position, err := getNextPosition(ctx, s.Client, column)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to get next position", "error", err)
-		_ = sse.ConsoleError(err)
-		return
+		return nil, err
 	}
 
 	// Create task
-	newTask, err := s.Client.Task.Create().
-		SetTitle(signals.Title).
-		SetDescription(signals.Description).
+	created, err := s.Client.Task.Create().
+		SetTitle(in.Title).
+		SetDescription(in.Description).
 		SetColumn(column).
-		SetAssignee(signals.Assignee).
+		SetAssignee(in.Assignee).
+		SetPriority(priority).
+		SetNillableDueAt(due).
 		SetPosition(position).
 		Save(ctx)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to create task", "error", err)
-		_ = sse.ConsoleError(err)
-		return
+		return nil, err
 	}
 
 	// Create history entry
-	historyEntry, err := s.Client.TaskHistory.Create().
-		SetTaskID(newTask.ID).
-		SetAction("created").
-		SetDetails(fmt.Sprintf("created in %s", column)).
-		SetActor(signals.Assignee).
-		Save(ctx)
-	if err != nil {
+	details := in.Details
+	if details == "" {
+		details = fmt.Sprintf("created in %s", column)
+	}
+	if _, err := s.recordHistory(ctx, created.ID, "created", details, in.Assignee, nil); err != nil {
 		slog.ErrorContext(ctx, "failed to create history", "error", err)
-	} else {
-		// Broadcast activity update
-		s.Broadcaster.BroadcastActivity(historyEntry.ID)
-	}
-
-	// Parse and add tags
-	if signals.Tags != "" {
-		tags := parseTags(signals.Tags)
-		for _, tag := range tags {
-			_, err = s.Client.TaskTag.Create().
-				SetTaskID(newTask.ID).
-				SetKey(tag.Key).
-				SetValue(tag.Value).
-				Save(ctx)
-			if err != nil {
-				slog.ErrorContext(ctx, "failed to create tag", "error", err)
-			}
+	}
+
+	// Add tags
+	for _, tag := range tags {
+		_, err = s.Client.TaskTag.Create().
+			SetTaskID(created.ID).
+			SetKey(tag.Key).
+			SetValue(tag.Value).
+			Save(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to create tag", "error", err)
 		}
 	}
 
+	if err := replaceFieldValues(ctx, s.Client, created.ID, fieldValues); err != nil {
+		slog.ErrorContext(ctx, "failed to save custom fields", "error", err)
+	}
+
+	if breached {
+		s.recordWIPBreach(ctx, created.ID, breach, wipOutcome(breach), actorFromRequest(r))
+	}
+
+	// Snapshot the new task as revision 1
+	if _, err := recordRevision(ctx, s.Client, created.ID, in.Assignee); err != nil {
+		slog.ErrorContext(ctx, "failed to record revision", "error", err)
+	}
+
 	// Reload task with edges
-	newTask, err = s.Client.Task.Query().
-		Where(task.IDEQ(newTask.ID)).
+	created, err = s.Client.Task.Query().
+		Where(task.IDEQ(created.ID)).
 		WithTags().
+		WithFieldValues().
 		WithHistory().
 		Only(ctx)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to reload task", "error", err)
+		return nil, err
+	}
+
+	// Broadcast event to other clients
+	s.Broadcaster.BroadcastBoard(created.ID, "task_created", column, "")
+	return created, nil
+}
+
+// recordCreateUndo lets the actor undo a create, which moves the task to the
+// trash.
+func (s *Server) recordCreateUndo(ctx context.Context, r *http.Request, t *ent.Task) templ.Component {
+	before, err := captureState(ctx, s.Client, t.ID)
+	if err != nil {
+		return nil
+	}
+	before.Status = statusTrash
+	return s.recordUndo(ctx, r, fmt.Sprintf("Created %q", t.Title), t.ID, before)
+}
+
+// TaskCreateHandler creates a new task via SSE.
+func (s *Server) TaskCreateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Read signals BEFORE creating SSE
+	type TaskCreateSignals struct {
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Column      string         `json:"column"`
+		Assignee    string         `json:"assignee"`
+		Priority    string         `json:"priority"`
+		Due         string         `json:"due"`
+		Tags        string         `json:"tags"`
+		Fields      map[string]any `json:"fields"`
+		WIPOverride bool           `json:"wip_override"`
+		TemplateID  string         `json:"template_id"`
+	}
+	signals := &TaskCreateSignals{}
+	err := datastar.ReadSignals(r, signals)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read signals", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Create SSE AFTER reading signals
+	sse := datastar.NewSSE(w, r)
+
+	in := taskInput{
+		Title:       signals.Title,
+		Description: signals.Description,
+		Column:      signals.Column,
+		Assignee:    signals.Assignee,
+		Priority:    signals.Priority,
+		Due:         signals.Due,
+		Tags:        parseTags(signals.Tags),
+		Fields:      signalFieldValues(signals.Fields),
+		WIPOverride: signals.WIPOverride,
+	}
+	if id, err := strconv.Atoi(signals.TemplateID); err == nil {
+		if tpl, err := s.Client.TaskTemplate.Get(ctx, id); err == nil {
+			in.Details = fmt.Sprintf("created in %s from template %q", sanitizeColumn(in.Column), tpl.Name)
+		}
+	}
+
+	newTask, err := s.createTask(ctx, r, in)
+	var rejected *createRejection
+	switch {
+	case errors.As(err, &rejected) && rejected.breach != nil:
+		_ = sse.PatchElements(wipErrorHTML("add-error", *rejected.breach))
+		return
+	case errors.As(err, &rejected):
+		_ = sse.PatchElements(`<div id="add-error" class="text-error text-sm">` + html.EscapeString(rejected.reason) + `</div>`)
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to create task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
 		_ = sse.ConsoleError(err)
 		return
 	}
 
 	// Render task card
 	var htmlBuilder strings.Builder
-	err = fragments.TaskCard(newTask, column).Render(ctx, &htmlBuilder)
+	err = fragments.TaskCard(newTask, newTask.Column, tagRegistry, thresholds).Render(ctx, &htmlBuilder)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to render task card", "error", err)
 		_ = sse.ConsoleError(err)
 		return
 	}
 
-	// Broadcast event to other clients
-	s.Broadcaster.BroadcastBoard(newTask.ID, "task_created", column, "")
+	// Undoing a create moves the task to the trash
+	toast := s.recordCreateUndo(ctx, r, newTask)
 
 	// Clear error, append card to column, close modal
 	_ = sse.PatchElements(`<div id="add-error" class="text-error text-sm hidden"></div>`)
 	_ = sse.PatchElements(htmlBuilder.String(),
 		datastar.WithModeAppend(),
-		datastar.WithSelector("#column-"+column))
+		datastar.WithSelector("#column-"+newTask.Column))
 	_ = sse.PatchElements(`<div id="modal-container"></div>`)
+	patchToast(ctx, sse, toast)
 }
 
 // TaskDetailsHandler returns a task details modal via SSE.
@@ -271,22 +489,27 @@ func (s *Server) TaskDetailsHandler(w http.ResponseWriter, r *http.Request) {
 	sse := datastar.NewSSE(w, r)
 
 	// Get task from database with all edges
-	t, err := s.Client.Task.Query().
-		Where(task.IDEQ(id)).
-		WithTags().
-		WithHistory(func(q *ent.TaskHistoryQuery) {
-			q.Order(ent.Desc(taskhistory.FieldCreatedAt))
-		}).
-		Only(ctx)
+	t, err := loadTaskDetails(ctx, s.Client, id)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to get task", "error", err)
 		_ = sse.ConsoleError(err)
 		return
 	}
 
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+
 	// Render modal with task details
 	var htmlBuilder strings.Builder
-	err = fragments.TaskDetailsModal(t).Render(ctx, &htmlBuilder)
+	err = fragments.TaskDetailsModal(t, defs, tagRegistry).Render(ctx, &htmlBuilder)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to render details modal", "error", err)
 		_ = sse.ConsoleError(err)
@@ -296,6 +519,9 @@ func (s *Server) TaskDetailsHandler(w http.ResponseWriter, r *http.Request) {
 	// Insert modal and show it
 	_ = sse.PatchElements(`<div id="modal-container">` + htmlBuilder.String() + `</div>`)
 	_ = sse.ExecuteScript("document.getElementById('task-details-modal').showModal()")
+
+	// Show this client as viewing the task
+	s.setPresence(r, t.ID, false)
 }
 
 // TaskEditFormHandler returns a populated edit form via SSE.
@@ -314,6 +540,7 @@ func (s *Server) TaskEditFormHandler(w http.ResponseWriter, r *http.Request) {
 	t, err := s.Client.Task.Query().
 		Where(task.IDEQ(id)).
 		WithTags().
+		WithFieldValues().
 		WithHistory().
 		Only(ctx)
 	if err != nil {
@@ -321,10 +548,18 @@ func (s *Server) TaskEditFormHandler(w http.ResponseWriter, r *http.Request) {
 		_ = sse.ConsoleError(err)
 		return
 	}
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	// Warn when someone else already has the edit form open
+	editors := s.Presence.Editors(t.ID, clientIDFromRequest(r))
 
 	// Render modal with populated form
 	var htmlBuilder strings.Builder
-	err = fragments.TaskEditModalWithForm(t).Render(ctx, &htmlBuilder)
+	err = fragments.TaskEditModalWithForm(t, editors, defs).Render(ctx, &htmlBuilder)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to render edit modal", "error", err)
 		_ = sse.ConsoleError(err)
@@ -345,17 +580,25 @@ func (s *Server) TaskEditFormHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	signals := map[string]interface{}{
-		"task_id":     t.ID,
-		"title":       t.Title,
-		"description": t.Description,
-		"column":      t.Column,
-		"assignee":    t.Assignee,
-		"tags":        tagsStr,
+		"task_id":      t.ID,
+		"title":        t.Title,
+		"description":  t.Description,
+		"column":       t.Column,
+		"assignee":     t.Assignee,
+		"priority":     string(t.Priority),
+		"due":          formatDue(t.DueAt),
+		"tags":         tagsStr,
+		"tag_query":    "",
+		"wip_override": false,
+		"fields":       fieldSignals(defs, taskFieldValues(t)),
 	}
 	signalsJSON, _ := json.Marshal(signals)
 	_ = sse.PatchSignals(signalsJSON)
 
 	_ = sse.ExecuteScript("document.getElementById(\"edit-task-modal\").showModal()")
+
+	// Show this client as editing the task
+	s.setPresence(r, t.ID, true)
 }
 
 // TaskUpdateHandler updates an existing task via SSE.
@@ -364,12 +607,16 @@ func (s *Server) TaskUpdateHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Read signals BEFORE creating SSE
 	type TaskUpdateSignals struct {
-		TaskID      int    `json:"task_id"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Column      string `json:"column"`
-		Assignee    string `json:"assignee"`
-		Tags        string `json:"tags"`
+		TaskID      int            `json:"task_id"`
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Column      string         `json:"column"`
+		Assignee    string         `json:"assignee"`
+		Priority    string         `json:"priority"`
+		Due         string         `json:"due"`
+		Tags        string         `json:"tags"`
+		Fields      map[string]any `json:"fields"`
+		WIPOverride bool           `json:"wip_override"`
 	}
 	signals := &TaskUpdateSignals{}
 	err := datastar.ReadSignals(r, signals)
@@ -388,9 +635,11 @@ func (s *Server) TaskUpdateHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check if task exists
+	// Check if task exists (with tags, to diff against)
 	existingTask, err := s.Client.Task.Query().
 		Where(task.IDEQ(signals.TaskID)).
+		WithTags().
+		WithFieldValues().
 		Only(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to find task for update", "error", err)
@@ -398,12 +647,91 @@ func (s *Server) TaskUpdateHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	column := sanitizeColumn(signals.Column)
+	priority, ok := parsePriority(signals.Priority)
+	if !ok {
+		_ = sse.PatchElements(`<div id="edit-error" class="text-error text-sm">Unknown priority</div>`)
+		return
+	}
+	due, err := parseDue(signals.Due)
+	if err != nil {
+		_ = sse.PatchElements(`<div id="edit-error" class="text-error text-sm">Invalid due date</div>`)
+		return
+	}
+	tags, priority := extractPriorityTag(parseTags(signals.Tags), priority)
+
+	defs, err := loadFieldDefinitions(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	fieldValues, fieldErrs := customfield.Validate(defs, signalFieldValues(signals.Fields), false)
+	if len(fieldErrs) > 0 {
+		_ = sse.PatchElements(`<div id="edit-error" class="text-error text-sm">` + html.EscapeString(fieldErrors(defs, fieldErrs)) + `</div>`)
+		return
+	}
+
+	tagRegistry, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := validateTags(tagRegistry, tags); err != nil {
+		_ = sse.PatchElements(tagErrorHTML("edit-error", err))
+		return
+	}
+
+	if column != existingTask.Column {
+		pairs := inputSnapshot(signals.Title, signals.Description, column, signals.Assignee, priority, due, tags, fieldValues).Tags
+		err := checkWorkflow(ctx, s.Client, existingTask.Column, column, workflowSubject(r, signals.Description, signals.Assignee, pairs))
+		var rejection *workflow.Rejection
+		if errors.As(err, &rejection) {
+			_ = sse.PatchElements(`<div id="edit-error" class="alert alert-error text-sm">` + html.EscapeString(rejection.Reason) + `</div>`)
+			return
+		}
+		if err != nil {
+			_ = sse.ConsoleError(err)
+			return
+		}
+	}
+
+	// Only entering a column, or taking over a task in it, counts against a limit
+	var (
+		breach   wip.Breach
+		breached bool
+	)
+	if column != existingTask.Column || signals.Assignee != existingTask.Assignee {
+		breach, breached, err = checkWIP(ctx, s.Client, column, signals.Assignee, existingTask.ID)
+		if err != nil {
+			_ = sse.ConsoleError(err)
+			return
+		}
+		if breached && breach.Blocks(signals.WIPOverride) {
+			s.recordWIPBreach(ctx, existingTask.ID, breach, "blocked", actorFromRequest(r))
+			_ = sse.PatchElements(wipErrorHTML("edit-error", breach))
+			return
+		}
+	}
+
+	changes := history.Diff(taskSnapshot(existingTask),
+		inputSnapshot(signals.Title, signals.Description, column, signals.Assignee, priority, due, tags, fieldValues))
+
+	if err := recordBaselineRevision(ctx, s.Client, existingTask.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to record baseline revision", "error", err)
+	}
+	before, err := captureState(ctx, s.Client, existingTask.ID)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+
 	// Update task
-	updatedTask, err := s.Client.Task.UpdateOneID(existingTask.ID).
+	updatedTask, err := setDue(s.Client.Task.UpdateOneID(existingTask.ID).
 		SetTitle(signals.Title).
 		SetDescription(signals.Description).
-		SetColumn(sanitizeColumn(signals.Column)).
+		SetColumn(column).
 		SetAssignee(signals.Assignee).
+		SetPriority(priority), due).
 		Save(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to update task", "error", err)
@@ -411,28 +739,31 @@ func (s *Server) TaskUpdateHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create history entry
-	historyEntry, err := s.Client.TaskHistory.Create().
-		SetTaskID(updatedTask.ID).
-		SetAction("updated").
-		SetDetails("updated task").
-		SetActor(signals.Assignee).
-		Save(ctx)
-	if err == nil {
-		// Broadcast activity update
-		s.Broadcaster.BroadcastActivity(historyEntry.ID)
+	// Create history entry recording what changed
+	if len(changes) > 0 {
+		if _, err := s.recordHistory(ctx, updatedTask.ID, "updated", history.Summary(changes), signals.Assignee, changes); err != nil {
+			slog.ErrorContext(ctx, "failed to create history for update", "error", err)
+		}
 	}
 
 	// Update tags
-	_, _ = s.Client.TaskTag.Delete().Where(tasktag.HasTaskWith(task.IDEQ(updatedTask.ID))).Exec(ctx)
-	if signals.Tags != "" {
-		tags := parseTags(signals.Tags)
-		for _, tag := range tags {
-			_, _ = s.Client.TaskTag.Create().
-				SetTaskID(updatedTask.ID).
-				SetKey(tag.Key).
-				SetValue(tag.Value).
-				Save(ctx)
+	pairs := inputSnapshot(signals.Title, signals.Description, column, signals.Assignee, priority, due, tags, fieldValues).Tags
+	if err := replaceTags(ctx, s.Client, updatedTask.ID, pairs); err != nil {
+		slog.ErrorContext(ctx, "failed to save tags", "error", err)
+	}
+
+	if err := replaceFieldValues(ctx, s.Client, updatedTask.ID, fieldValues); err != nil {
+		slog.ErrorContext(ctx, "failed to save custom fields", "error", err)
+	}
+
+	if breached {
+		s.recordWIPBreach(ctx, updatedTask.ID, breach, wipOutcome(breach), actorFromRequest(r))
+	}
+
+	// Snapshot the saved task
+	if len(changes) > 0 {
+		if _, err := recordRevision(ctx, s.Client, updatedTask.ID, signals.Assignee); err != nil {
+			slog.ErrorContext(ctx, "failed to record revision", "error", err)
 		}
 	}
 
@@ -447,9 +778,15 @@ func (s *Server) TaskUpdateHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	thresholds, err := loadStaleThresholds(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+
 	// Render updated card
 	var htmlBuilder strings.Builder
-	err = fragments.TaskCard(updatedTask, updatedTask.Column).Render(ctx, &htmlBuilder)
+	err = fragments.TaskCard(updatedTask, updatedTask.Column, tagRegistry, thresholds).Render(ctx, &htmlBuilder)
 	if err != nil {
 		_ = sse.ConsoleError(err)
 		return
@@ -458,12 +795,18 @@ func (s *Server) TaskUpdateHandler(w http.ResponseWriter, r *http.Request) {
 	// Broadcast event to other clients
 	s.Broadcaster.BroadcastBoard(updatedTask.ID, "task_updated", updatedTask.Column, "")
 
+	var toast templ.Component
+	if len(changes) > 0 {
+		toast = s.recordUndo(ctx, r, fmt.Sprintf("Edited %q", updatedTask.Title), updatedTask.ID, before)
+	}
+
 	_ = sse.PatchElements(`<div id="edit-error" class="text-error text-sm hidden"></div>`)
 	_ = sse.PatchElements(htmlBuilder.String())
 	_ = sse.PatchElements(`<div id="modal-container"></div>`)
+	patchToast(ctx, sse, toast)
 }
 
-// TaskDeleteHandler deletes a task via SSE.
+// TaskDeleteHandler moves a task to the trash via SSE.
 func (s *Server) TaskDeleteHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	idStr := r.PathValue("id")
@@ -475,19 +818,35 @@ func (s *Server) TaskDeleteHandler(w http.ResponseWriter, r *http.Request) {
 
 	sse := datastar.NewSSE(w, r)
 
-	// Delete related records
-	_, _ = s.Client.TaskHistory.Delete().Where(taskhistory.HasTaskWith(task.IDEQ(id))).Exec(ctx)
-	_, _ = s.Client.TaskTag.Delete().Where(tasktag.HasTaskWith(task.IDEQ(id))).Exec(ctx)
-	err = s.Client.Task.DeleteOneID(id).Exec(ctx)
+	before, err := captureState(ctx, s.Client, id)
 	if err != nil {
 		_ = sse.ConsoleError(err)
 		return
 	}
 
+	// Move the task to the trash; history, tags and revisions are kept
+	deletedTask, err := s.Client.Task.UpdateOneID(id).
+		Where(task.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := recompactColumnPositions(ctx, s.Client, deletedTask.Column); err != nil {
+		slog.ErrorContext(ctx, "failed to recompact column", "column", deletedTask.Column, "error", err)
+	}
+
+	details := fmt.Sprintf("moved to trash from %s", deletedTask.Column)
+	if _, err := s.recordHistory(ctx, id, "deleted", details, actorFromRequest(r), nil); err != nil {
+		slog.ErrorContext(ctx, "failed to create history for delete", "error", err)
+	}
+
 	// Broadcast event to other clients
 	s.Broadcaster.BroadcastBoard(id, "task_deleted", "", "")
 
 	_ = sse.RemoveElement("#task-card-" + strconv.Itoa(id))
+	patchToast(ctx, sse, s.recordUndo(ctx, r, fmt.Sprintf("Deleted %q", deletedTask.Title), id, before))
 }
 
 // TaskColumnUpdateHandler updates a task's column via drag-and-drop PATCH request.
@@ -507,6 +866,7 @@ func (s *Server) TaskColumnUpdateHandler(w http.ResponseWriter, r *http.Request)
 	type ColumnUpdate struct {
 		Column   string `json:"column"`
 		Position int    `json:"position"`
+		Override bool   `json:"override"` // confirmed past a soft WIP limit
 	}
 	var update ColumnUpdate
 	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
@@ -514,71 +874,27 @@ func (s *Server) TaskColumnUpdateHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Get existing task
-	existingTask, err := s.Client.Task.Query().
-		Where(task.IDEQ(id)).
-		WithTags().
-		WithHistory().
-		Only(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to find task for column update", "error", err)
+	// Don't send direct SSE response - let the broadcast handle ALL updates
+	// The nonce check will prevent echo-back to the originating client
+	// Other clients will receive and apply the broadcast
+	updatedTask, before, err := s.moveTask(ctx, r, id, update.Column, update.Position, update.Override, clientNonce)
+	var rejected *moveRejection
+	switch {
+	case errors.As(err, &rejected):
+		writeMoveRejected(ctx, w, rejected)
+		return
+	case ent.IsNotFound(err):
 		http.Error(w, "Task not found", http.StatusNotFound)
 		return
-	}
-
-	oldColumn := existingTask.Column
-	newColumn := sanitizeColumn(update.Column)
-
-	// Update task column and reorder positions
-	if err := reorderTasksOnColumnChange(ctx, s.Client, id, oldColumn, newColumn, update.Position); err != nil {
-		slog.ErrorContext(ctx, "failed to reorder tasks", "error", err)
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to move task", "task_id", id, "error", err)
 		http.Error(w, "Failed to update task", http.StatusInternalServerError)
 		return
 	}
 
-	// Create history entry
-	details := fmt.Sprintf("moved from %s to %s", oldColumn, newColumn)
-	historyEntry, err := s.Client.TaskHistory.Create().
-		SetTaskID(id).
-		SetAction("moved").
-		SetDetails(details).
-		SetActor(existingTask.Assignee).
-		Save(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to create history for column update", "error", err)
-	} else {
-		// Broadcast activity update
-		s.Broadcaster.BroadcastActivity(historyEntry.ID)
-	}
-
-	// Reload task with edges
-	updatedTask, err := s.Client.Task.Query().
-		Where(task.IDEQ(id)).
-		WithTags().
-		WithHistory().
-		Only(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "failed to reload task", "error", err)
-		http.Error(w, "Failed to reload task", http.StatusInternalServerError)
-		return
-	}
-
-	// Don't send direct SSE response - let the broadcast handle ALL updates
-	// The nonce check will prevent echo-back to the originating client
-	// Other clients will receive and apply the broadcast
-
-	// Broadcast event to all clients (originator will ignore due to nonce match)
-	s.Broadcaster.BroadcastBoard(updatedTask.ID, "task_moved", newColumn, clientNonce)
-
-	slog.InfoContext(ctx, "task column updated via drag-drop", 
-		"task_id", id, 
-		"from", oldColumn, 
-		"to", newColumn,
-		"position", update.Position,
-		"nonce", clientNonce)
-
-	// Return success
-	w.WriteHeader(http.StatusOK)
+	// Return success with the undo toast
+	label := fmt.Sprintf("Moved %q to %s", updatedTask.Title, updatedTask.Column)
+	writeToast(ctx, w, s.recordUndo(ctx, r, label, id, before))
 }
 
 // TaskPositionUpdateHandler updates a task's position within the same column.
@@ -617,6 +933,12 @@ func (s *Server) TaskPositionUpdateHandler(w http.ResponseWriter, r *http.Reques
 
 	column := sanitizeColumn(update.Column)
 
+	before, err := captureState(ctx, s.Client, id)
+	if err != nil {
+		http.Error(w, "Task not found", http.StatusNotFound)
+		return
+	}
+
 	// Reorder tasks within the same column
 	if err := reorderTasksInColumn(ctx, s.Client, id, column, update.Position); err != nil {
 		slog.ErrorContext(ctx, "failed to reorder tasks in column", "error", err)
@@ -649,8 +971,9 @@ func (s *Server) TaskPositionUpdateHandler(w http.ResponseWriter, r *http.Reques
 		"new_position", update.Position,
 		"nonce", clientNonce)
 
-	// Return success
-	w.WriteHeader(http.StatusOK)
+	// Return success with the undo toast
+	label := fmt.Sprintf("Reordered %q", existingTask.Title)
+	writeToast(ctx, w, s.recordUndo(ctx, r, label, id, before))
 }
 
 // Stub handlers for move, assign, tag operations
@@ -663,7 +986,7 @@ func (s *Server) TaskRemoveTagHandler(w http.ResponseWriter, r *http.Request) {}
 func reorderTasksOnColumnChange(ctx context.Context, client *ent.Client, taskID int, fromColumn, toColumn string, newPosition int) error {
 	// Get all tasks in the destination column, ordered by position
 	destTasks, err := client.Task.Query().
-		Where(task.ColumnEQ(toColumn), task.IDNEQ(taskID)).
+		Where(onBoard(), task.ColumnEQ(toColumn), task.IDNEQ(taskID)).
 		Order(ent.Asc(task.FieldPosition)).
 		All(ctx)
 	if err != nil {
@@ -717,7 +1040,7 @@ func reorderTasksInColumn(ctx context.Context, client *ent.Client, taskID int, c
 
 	// Get all tasks in the column, ordered by position
 	tasks, err := client.Task.Query().
-		Where(task.ColumnEQ(column)).
+		Where(onBoard(), task.ColumnEQ(column)).
 		Order(ent.Asc(task.FieldPosition)).
 		All(ctx)
 	if err != nil {
@@ -771,7 +1094,7 @@ func reorderTasksInColumn(ctx context.Context, client *ent.Client, taskID int, c
 // recompactColumnPositions ensures positions in a column are sequential starting from 0.
 func recompactColumnPositions(ctx context.Context, client *ent.Client, column string) error {
 	tasks, err := client.Task.Query().
-		Where(task.ColumnEQ(column)).
+		Where(onBoard(), task.ColumnEQ(column)).
 		Order(ent.Asc(task.FieldPosition)).
 		All(ctx)
 	if err != nil {
@@ -795,21 +1118,26 @@ func recompactColumnPositions(ctx context.Context, client *ent.Client, column st
 func renderColumnUpdate(ctx context.Context, sse *datastar.ServerSentEventGenerator, client *ent.Client, column string) error {
 	// Get all tasks in the column
 	tasks, err := client.Task.Query().
-		Where(task.ColumnEQ(column)).
+		Where(onBoard(), task.ColumnEQ(column)).
 		WithTags().
-		WithHistory(func(q *ent.TaskHistoryQuery) {
-			q.Order(ent.Desc(taskhistory.FieldCreatedAt))
-		}).
 		Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
 		return err
 	}
+	tagRegistry, err := loadTagRegistry(ctx, client)
+	if err != nil {
+		return err
+	}
+	thresholds, err := loadStaleThresholds(ctx, client)
+	if err != nil {
+		return err
+	}
 
 	// Render all task cards
 	var htmlBuilder strings.Builder
 	for _, t := range tasks {
-		if err := fragments.TaskCard(t, column).Render(ctx, &htmlBuilder); err != nil {
+		if err := fragments.TaskCard(t, column, tagRegistry, thresholds).Render(ctx, &htmlBuilder); err != nil {
 			return err
 		}
 	}
@@ -835,7 +1163,7 @@ func sanitizeColumn(value string) string {
 func getNextPosition(ctx context.Context, client *ent.Client, column string) (int, error) {
 	// Count tasks in column first
 	count, err := client.Task.Query().
-		Where(task.ColumnEQ(column)).
+		Where(onBoard(), task.ColumnEQ(column)).
 		Count(ctx)
 	if err != nil {
 		return 0, err
@@ -848,7 +1176,7 @@ func getNextPosition(ctx context.Context, client *ent.Client, column string) (in
 	
 	// Get max position
 	max, err := client.Task.Query().
-		Where(task.ColumnEQ(column)).
+		Where(onBoard(), task.ColumnEQ(column)).
 		Aggregate(ent.Max(task.FieldPosition)).
 		Int(ctx)
 	if err != nil {
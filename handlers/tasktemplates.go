@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktemplate"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// loadTaskTemplates returns the board's task templates by name.
+func loadTaskTemplates(ctx context.Context, client *ent.Client) ([]*ent.TaskTemplate, error) {
+	return client.TaskTemplate.Query().
+		Order(ent.Asc(tasktemplate.FieldName)).
+		All(ctx)
+}
+
+// expandTitlePattern fills in a template title's date placeholders:
+// {date} (2026-01-31), {week} (2026-W05), {month} (2026-01) and {year}.
+func expandTitlePattern(pattern string, now time.Time) string {
+	year, week := now.ISOWeek()
+	return strings.NewReplacer(
+		"{date}", now.Format("2006-01-02"),
+		"{week}", fmt.Sprintf("%d-W%02d", year, week),
+		"{month}", now.Format("2006-01"),
+		"{year}", now.Format("2006"),
+	).Replace(pattern)
+}
+
+// applyTaskTemplate fills the parts of in left empty from tpl. Template tags
+// come before the submitted ones.
+func applyTaskTemplate(in taskInput, tpl *ent.TaskTemplate) taskInput {
+	if strings.TrimSpace(in.Title) == "" {
+		in.Title = strings.TrimSpace(expandTitlePattern(tpl.Title, time.Now()))
+	}
+	if in.Description == "" {
+		in.Description = tpl.Description
+	}
+	if in.Column == "" {
+		in.Column = tpl.Column
+	}
+	if in.Assignee == "" {
+		in.Assignee = tpl.Assignee
+	}
+	in.Tags = append(parseTags(strings.Join(tpl.Tags, ",")), in.Tags...)
+	in.Details = fmt.Sprintf("created in %s from template %q", sanitizeColumn(in.Column), tpl.Name)
+	return in
+}
+
+// duplicateTask copies a task's fields, tags and custom field values into a
+// new card at the bottom of the same column.
+func (s *Server) duplicateTask(ctx context.Context, r *http.Request, id int, overrideWIP bool) (*ent.Task, error) {
+	source, err := s.Client.Task.Query().
+		Where(task.IDEQ(id), task.DeletedAtIsNil()).
+		WithTags().
+		WithFieldValues().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.createTask(ctx, r, taskInput{
+		Title:       source.Title,
+		Description: source.Description,
+		Column:      source.Column,
+		Assignee:    source.Assignee,
+		Priority:    string(source.Priority),
+		Due:         formatDue(source.DueAt),
+		Tags:        parseTags(strings.Join(taskSnapshot(source).Tags, ",")),
+		Fields:      taskFieldValues(source),
+		WIPOverride: overrideWIP,
+		Details:     fmt.Sprintf("cloned from #%d", source.ID),
+	})
+}
+
+// TaskDuplicateHandler clones a task from its details modal.
+func (s *Server) TaskDuplicateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	clone, err := s.duplicateTask(ctx, r, id, false)
+	var rejected *createRejection
+	switch {
+	case errors.As(err, &rejected):
+		patchToast(ctx, sse, fragments.UndoToast(rejected.reason, ""))
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to duplicate task", "task_id", id, "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	// The card itself reaches the board through the task_created broadcast
+	_ = sse.PatchElements(`<div id="modal-container"></div>`)
+	patchToast(ctx, sse, s.recordCreateUndo(ctx, r, clone))
+}
+
+// TaskTemplatesPageHandler lists task templates with a form to add or
+// replace one.
+func (s *Server) TaskTemplatesPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	taskTemplates, err := loadTaskTemplates(ctx, s.Client)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get task templates", "error", err)
+		http.Error(w, "Failed to load templates", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.TaskTemplatesContent(taskTemplates, boardColumns, defaultAssignees)
+	page := templates.Layout("Templates - Bot Task Tracker", pages.TaskTemplatesMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// TaskTemplateSaveHandler adds a template, or replaces the one with the same
+// name.
+func (s *Server) TaskTemplateSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type TemplateSignals struct {
+		Name        string `json:"tpl_name"`
+		Title       string `json:"tpl_title"`
+		Description string `json:"tpl_description"`
+		Tags        string `json:"tpl_tags"`
+		Column      string `json:"tpl_column"`
+		Assignee    string `json:"tpl_assignee"`
+	}
+	signals := &TemplateSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+	patchError := func(msg string) {
+		_ = sse.PatchElements(`<div id="tpl-error" class="alert alert-error text-sm">` + html.EscapeString(msg) + `</div>`)
+	}
+
+	name := strings.TrimSpace(signals.Name)
+	if name == "" {
+		patchError("Give the template a name")
+		return
+	}
+	tags := parseTags(signals.Tags)
+	reg, err := loadTagRegistry(ctx, s.Client)
+	if err != nil {
+		_ = sse.ConsoleError(err)
+		return
+	}
+	if err := validateTags(reg, tags); err != nil {
+		patchError(strings.ReplaceAll(err.Error(), "\n", "; "))
+		return
+	}
+	pairs := make([]string, len(tags))
+	for i, tag := range tags {
+		pairs[i] = tag.Key + ":" + tag.Value
+	}
+
+	existing, err := s.Client.TaskTemplate.Query().
+		Where(tasktemplate.NameEQ(name)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = s.Client.TaskTemplate.Create().
+			SetName(name).
+			SetTitle(signals.Title).
+			SetDescription(signals.Description).
+			SetTags(pairs).
+			SetColumn(sanitizeColumn(signals.Column)).
+			SetAssignee(signals.Assignee).
+			Exec(ctx)
+	case err == nil:
+		err = existing.Update().
+			SetTitle(signals.Title).
+			SetDescription(signals.Description).
+			SetTags(pairs).
+			SetColumn(sanitizeColumn(signals.Column)).
+			SetAssignee(signals.Assignee).
+			Exec(ctx)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save task template", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/templates")
+}
+
+// TaskTemplateDeleteHandler removes a template. Tasks created from it are
+// unaffected.
+func (s *Server) TaskTemplateDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.Client.TaskTemplate.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete task template", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/templates")
+}
+
+// apiTaskTemplate is the JSON representation of a task template.
+type apiTaskTemplate struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Column      string   `json:"column"`
+	Assignee    string   `json:"assignee"`
+}
+
+// APITaskTemplateListHandler lists the task templates.
+func (s *Server) APITaskTemplateListHandler(w http.ResponseWriter, r *http.Request) {
+	taskTemplates, err := loadTaskTemplates(r.Context(), s.Client)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list task templates", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to list templates")
+		return
+	}
+	out := make([]apiTaskTemplate, len(taskTemplates))
+	for i, t := range taskTemplates {
+		out[i] = apiTaskTemplate{
+			ID:          t.ID,
+			Name:        t.Name,
+			Title:       t.Title,
+			Description: t.Description,
+			Tags:        t.Tags,
+			Column:      t.Column,
+			Assignee:    t.Assignee,
+		}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// APITaskCreateHandler creates a task:
+//
+//	{"title": "...", "column": "backlog", "tags": ["type:bug"], "template": "Bug report"}
+//
+// Quick-add text ("quick": "Fix login @john #bug >review") and then a
+// template, by name, fill in whatever the request leaves empty. Invalid input
+// is 422, a WIP limit block 409; override confirms a soft limit.
+func (s *Server) APITaskCreateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req struct {
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		Column      string            `json:"column"`
+		Assignee    string            `json:"assignee"`
+		Priority    string            `json:"priority"`
+		Due         string            `json:"due"`
+		Tags        []string          `json:"tags"`
+		Fields      map[string]string `json:"fields"`
+		Template    string            `json:"template"`
+		Quick       string            `json:"quick"`
+		Override    bool              `json:"override"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	in := taskInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Column:      req.Column,
+		Assignee:    req.Assignee,
+		Priority:    req.Priority,
+		Due:         req.Due,
+		Tags:        parseTags(strings.Join(req.Tags, ",")),
+		Fields:      req.Fields,
+		WIPOverride: req.Override,
+	}
+	if req.Quick != "" {
+		res, err := s.parseQuickAdd(ctx, req.Quick)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, "failed to parse quick-add text")
+			return
+		}
+		in = applyQuickAdd(in, res)
+	}
+	if req.Template != "" {
+		tpl, err := s.Client.TaskTemplate.Query().Where(tasktemplate.NameEQ(req.Template)).Only(ctx)
+		if ent.IsNotFound(err) {
+			writeJSONError(w, http.StatusUnprocessableEntity, "unknown template")
+			return
+		}
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, "failed to load template")
+			return
+		}
+		in = applyTaskTemplate(in, tpl)
+	}
+
+	s.writeAPICreated(w, r, func() (*ent.Task, error) { return s.createTask(ctx, r, in) })
+}
+
+// APITaskDuplicateHandler clones a task; ?override=true confirms a soft WIP
+// limit.
+func (s *Server) APITaskDuplicateHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid task ID")
+		return
+	}
+	override := r.URL.Query().Get("override") == "true"
+
+	s.writeAPICreated(w, r, func() (*ent.Task, error) { return s.duplicateTask(ctx, r, id, override) })
+}
+
+// writeAPICreated runs create and writes the new task, or the reason it was
+// rejected.
+func (s *Server) writeAPICreated(w http.ResponseWriter, r *http.Request, create func() (*ent.Task, error)) {
+	ctx := r.Context()
+	created, err := create()
+	var rejected *createRejection
+	switch {
+	case errors.As(err, &rejected):
+		status := http.StatusUnprocessableEntity
+		if rejected.breach != nil {
+			status = http.StatusConflict
+		}
+		writeJSONError(w, status, rejected.reason)
+		return
+	case ent.IsNotFound(err):
+		writeJSONError(w, http.StatusNotFound, "task not found")
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to create task", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to create task")
+		return
+	}
+
+	s.recordCreateUndo(ctx, r, created)
+	writeJSON(w, http.StatusCreated, toAPITask(created))
+}
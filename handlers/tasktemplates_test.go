@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+)
+
+// historyDetails lists a task's history entries, oldest first.
+func (ts *testServer) historyDetails(t *testing.T, id int) []string {
+	t.Helper()
+	entries := ts.Client.TaskHistory.Query().
+		Where(taskhistory.HasTaskWith(task.IDEQ(id))).
+		Order(taskhistory.ByID()).
+		AllX(context.Background())
+	details := make([]string, len(entries))
+	for i, e := range entries {
+		details[i] = e.Details
+	}
+	return details
+}
+
+func TestDuplicateTask(t *testing.T) {
+	ts := newTestServer(t)
+	source := ts.createTask(t, map[string]any{
+		"title":       "Rotate keys",
+		"description": "Quarterly",
+		"column":      "in_progress",
+		"assignee":    "peter",
+		"tags":        []string{"type:bug"},
+	})
+
+	rec := ts.do(t, http.MethodPost, fmt.Sprintf("/api/tasks/%d/duplicate", source.ID), nil)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("duplicate: %d %s", rec.Code, rec.Body)
+	}
+	var clone apiTask
+	if err := json.Unmarshal(rec.Body.Bytes(), &clone); err != nil {
+		t.Fatal(err)
+	}
+	if clone.ID == source.ID || clone.Title != source.Title || clone.Description != source.Description ||
+		clone.Column != source.Column || clone.Assignee != source.Assignee || !slices.Equal(clone.Tags, source.Tags) {
+		t.Errorf("clone = %+v, want a copy of %+v", clone, source)
+	}
+
+	want := fmt.Sprintf("cloned from #%d", source.ID)
+	if got := ts.historyDetails(t, clone.ID); !slices.Contains(got, want) {
+		t.Errorf("clone history = %q, want an entry %q", got, want)
+	}
+
+	rec = ts.do(t, http.MethodPost, "/api/tasks/999/duplicate", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("duplicate of a missing task: %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestCreateFromTemplate(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Client.TaskTemplate.Create().
+		SetName("release").
+		SetTitle("Release {date}").
+		SetTags([]string{"type:feature"}).
+		SetColumn("review").
+		ExecX(context.Background())
+
+	created := ts.createTask(t, map[string]any{"template": "release", "tags": []string{"type:bug"}})
+	if want := "Release " + time.Now().Format("2006-01-02"); created.Title != want {
+		t.Errorf("title = %q, want %q", created.Title, want)
+	}
+	if created.Column != "review" || !slices.Equal(created.Tags, []string{"type:feature", "type:bug"}) {
+		t.Errorf("column %q, tags %q: want the template's followed by the submitted ones", created.Column, created.Tags)
+	}
+	want := `created in review from template "release"`
+	if got := ts.historyDetails(t, created.ID); !slices.Contains(got, want) {
+		t.Errorf("history = %q, want an entry %q", got, want)
+	}
+}
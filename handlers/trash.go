@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/predicate"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskfieldvalue"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskrevision"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// onBoard matches tasks that are shown on the board, i.e. neither in the
+// trash nor archived.
+func onBoard() predicate.Task {
+	return task.And(task.DeletedAtIsNil(), task.ArchivedAtIsNil())
+}
+
+// TrashPageHandler lists deleted tasks with restore and purge actions.
+func (s *Server) TrashPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tasks, err := s.Client.Task.Query().
+		Where(task.DeletedAtNotNil()).
+		WithTags().
+		Order(ent.Desc(task.FieldDeletedAt)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get trash", "error", err)
+		http.Error(w, "Failed to load trash", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.TrashContent(tasks, s.Config.TrashRetention)
+	page := templates.Layout("Trash - Bot Task Tracker", pages.TrashMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// TaskRestoreHandler takes a task out of the trash and puts it back at its
+// original column and position.
+func (s *Server) TaskRestoreHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	t, err := s.Client.Task.Query().
+		Where(task.IDEQ(id), task.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to find task in trash", "error", err)
+		_ = datastar.NewSSE(w, r).ConsoleError(err)
+		return
+	}
+
+	// Coming back counts against the column's WIP limits
+	subject := workflowSubject(r, t.Description, t.Assignee, nil)
+	breach, breached, err := s.checkMove(ctx, id, "", t.Column, t.Assignee, subject, false)
+	var rejected *moveRejection
+	if errors.As(err, &rejected) {
+		writeMoveRejected(ctx, w, rejected)
+		return
+	}
+	sse := datastar.NewSSE(w, r)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check restore", "task_id", id, "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	if err := s.Client.Task.UpdateOneID(id).ClearDeletedAt().Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to restore task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	// Re-insert at the old position, shifting the tasks that took its place
+	if err := reorderTasksOnColumnChange(ctx, s.Client, id, t.Column, t.Column, t.Position); err != nil {
+		slog.ErrorContext(ctx, "failed to reorder restored task", "error", err)
+	}
+
+	details := fmt.Sprintf("restored from trash to %s", t.Column)
+	if _, err := s.recordHistory(ctx, id, "restored", details, actorFromRequest(r), nil); err != nil {
+		slog.ErrorContext(ctx, "failed to create history for restore", "error", err)
+	}
+	if breached {
+		s.recordWIPBreach(ctx, id, breach, wipOutcome(breach), actorFromRequest(r))
+	}
+
+	s.Broadcaster.BroadcastBoard(id, "column_refresh", t.Column, "")
+
+	_ = sse.RemoveElement("#trash-row-" + strconv.Itoa(id))
+}
+
+// TaskPurgeHandler permanently deletes a task that is already in the trash.
+func (s *Server) TaskPurgeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.purgeTask(ctx, id, actorFromRequest(r)); err != nil {
+		slog.ErrorContext(ctx, "failed to purge task", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.RemoveElement("#trash-row-" + strconv.Itoa(id))
+}
+
+// purgeTask permanently deletes a trashed task with its tags, custom field
+// values and revisions.
+// History entries are kept: their task reference is cleared and a final entry
+// records the purge together with the task's title.
+// Everything happens in one transaction, so a failed purge leaves the task in
+// the trash as it was.
+func (s *Server) purgeTask(ctx context.Context, id int, actor string) error {
+	t, err := s.Client.Task.Query().
+		Where(task.IDEQ(id), task.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	var entry *ent.TaskHistory
+	err = withTx(ctx, s.Client, func(tx *ent.Tx) error {
+		client := tx.Client()
+		var err error
+		entry, err = client.TaskHistory.Create().
+			SetTaskID(id).
+			SetAction("purged").
+			SetDetails(t.Title).
+			SetActor(actor).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := client.TaskTag.Delete().Where(tasktag.HasTaskWith(task.IDEQ(id))).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.TaskFieldValue.Delete().Where(taskfieldvalue.HasTaskWith(task.IDEQ(id))).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.TaskRevision.Delete().Where(taskrevision.HasTaskWith(task.IDEQ(id))).Exec(ctx); err != nil {
+			return err
+		}
+		return client.Task.DeleteOneID(id).Exec(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Broadcaster.BroadcastActivity(entry.ID)
+	return nil
+}
+
+// purgeExpiredTrash permanently deletes tasks that have been in the trash
+// longer than the configured retention period.
+func (s *Server) purgeExpiredTrash(ctx context.Context) error {
+	cutoff := time.Now().Add(-s.Config.TrashRetention)
+	ids, err := s.Client.Task.Query().
+		Where(task.DeletedAtLT(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := s.purgeTask(ctx, id, ""); err != nil {
+			return err
+		}
+	}
+	if len(ids) > 0 {
+		slog.InfoContext(ctx, "purged expired trash", "count", len(ids))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/hook"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+)
+
+func (ts *testServer) trash(t *testing.T, id int) {
+	t.Helper()
+	if rec := ts.do(t, http.MethodDelete, fmt.Sprintf("/datastar/tasks/%d", id), nil); rec.Code != http.StatusOK {
+		t.Fatalf("delete #%d: %d %s", id, rec.Code, rec.Body)
+	}
+}
+
+func TestPurgeKeepsHistory(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	task := ts.createTask(t, map[string]any{"title": "Doomed", "tags": []string{"type:bug"}})
+	ts.trash(t, task.ID)
+
+	if err := ts.purgeTask(ctx, task.ID, "peter"); err != nil {
+		t.Fatal(err)
+	}
+	if n := ts.Client.Task.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d tasks left", n)
+	}
+	if n := ts.Client.TaskTag.Query().CountX(ctx); n != 0 {
+		t.Errorf("%d tags left", n)
+	}
+	purged := ts.Client.TaskHistory.Query().Where(taskhistory.ActionEQ("purged")).OnlyX(ctx)
+	if purged.Details != "Doomed" || purged.Actor != "peter" {
+		t.Errorf("purge entry = %q by %q", purged.Details, purged.Actor)
+	}
+}
+
+func TestPurgeIsAllOrNothing(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	task := ts.createTask(t, map[string]any{"title": "Survivor", "tags": []string{"type:bug"}})
+	ts.trash(t, task.ID)
+
+	failed := errors.New("disk full")
+	ts.Client.TaskRevision.Use(hook.On(func(ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) { return nil, failed })
+	}, ent.OpDelete))
+
+	if err := ts.purgeTask(ctx, task.ID, "peter"); !errors.Is(err, failed) {
+		t.Fatalf("purge: %v, want %v", err, failed)
+	}
+	got := ts.Client.Task.GetX(ctx, task.ID)
+	if got.DeletedAt == nil {
+		t.Error("task left the trash")
+	}
+	if n := ts.Client.TaskTag.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d tags, want the task's tag kept", n)
+	}
+	if ts.Client.TaskHistory.Query().Where(taskhistory.ActionEQ("purged")).ExistX(ctx) {
+		t.Error("a failed purge was recorded")
+	}
+}
@@ -0,0 +1,454 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/a-h/templ"
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/tasktag"
+	"github.com/j0hnsmith/botTaskTracker/history"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/wip"
+)
+
+// maxUndoDepth is how many actions each actor can step back through.
+const maxUndoDepth = 50
+
+// Where a task lives; anything but the board hides it from columns.
+const (
+	statusBoard   = "board"
+	statusTrash   = "trash"
+	statusArchive = "archive"
+)
+
+// taskState is everything needed to put a task back exactly as it was.
+type taskState struct {
+	Status      string
+	Title       string
+	Description string
+	Column      string
+	Position    int
+	Assignee    string
+	Priority    string
+	Due         string            // "2006-01-02" or empty
+	Tags        []string          // "key:value" pairs
+	Fields      map[string]string // custom field values by key
+	UpdatedAt   time.Time
+}
+
+// snapshot returns the user-editable fields for diffing.
+func (st taskState) snapshot() history.Snapshot {
+	return history.Snapshot{
+		Title:       st.Title,
+		Description: st.Description,
+		Column:      st.Column,
+		Assignee:    st.Assignee,
+		Priority:    st.Priority,
+		Due:         st.Due,
+		Tags:        st.Tags,
+		Fields:      st.Fields,
+	}
+}
+
+// matches reports whether a task still looks the way st captured it. Position
+// is ignored because reordering a column shifts its neighbours too.
+func (st taskState) matches(current taskState) bool {
+	if current.UpdatedAt.Equal(st.UpdatedAt) {
+		return true
+	}
+	return current.Status == st.Status && len(history.Diff(st.snapshot(), current.snapshot())) == 0
+}
+
+// boardCommand is an applied user action. Undo applies Before, redo applies After.
+type boardCommand struct {
+	Label  string // e.g. `Moved "Fix login" to review`
+	TaskID int
+	Before taskState
+	After  taskState
+}
+
+// errUndoConflict is returned when a task changed after the action being
+// undone or redone.
+var errUndoConflict = errors.New("the task has changed since")
+
+// undoKey picks the undo stack for a request: the actor's, or for anonymous
+// users their browser tab's, so nobody undoes someone else's change. It is
+// empty when the request can't be told apart from others.
+func undoKey(r *http.Request) string {
+	if actor := actorFromRequest(r); actor != "" {
+		return "actor:" + actor
+	}
+	if id := clientIDFromRequest(r); id != "" {
+		return "client:" + id
+	}
+	return ""
+}
+
+// UndoStacks keeps per-actor undo and redo stacks in memory.
+type UndoStacks struct {
+	mu   sync.Mutex
+	undo map[string][]boardCommand
+	redo map[string][]boardCommand
+}
+
+// NewUndoStacks creates empty undo/redo stacks
+func NewUndoStacks() *UndoStacks {
+	return &UndoStacks{
+		undo: make(map[string][]boardCommand),
+		redo: make(map[string][]boardCommand),
+	}
+}
+
+// Push records a new action. Any redo history for the actor is discarded.
+func (u *UndoStacks) Push(actor string, cmd boardCommand) {
+	if actor == "" {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	stack := append(u.undo[actor], cmd)
+	if len(stack) > maxUndoDepth {
+		stack = stack[len(stack)-maxUndoDepth:]
+	}
+	u.undo[actor] = stack
+	delete(u.redo, actor)
+}
+
+// PopUndo removes the actor's most recent action and moves it to the redo stack.
+func (u *UndoStacks) PopUndo(actor string) (boardCommand, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return move(u.undo, u.redo, actor)
+}
+
+// PopRedo removes the actor's most recently undone action and moves it back
+// to the undo stack.
+func (u *UndoStacks) PopRedo(actor string) (boardCommand, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return move(u.redo, u.undo, actor)
+}
+
+// Discard drops the command a PopUndo (undo true) or PopRedo just moved, when
+// it turned out not to apply.
+func (u *UndoStacks) Discard(actor string, undo bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	stacks := u.redo
+	if !undo {
+		stacks = u.undo
+	}
+	if stack := stacks[actor]; len(stack) > 0 {
+		stacks[actor] = stack[:len(stack)-1]
+	}
+}
+
+// CanRedo reports whether the actor has undone actions to redo.
+func (u *UndoStacks) CanRedo(actor string) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.redo[actor]) > 0
+}
+
+func move(from, to map[string][]boardCommand, actor string) (boardCommand, bool) {
+	stack := from[actor]
+	if len(stack) == 0 {
+		return boardCommand{}, false
+	}
+	cmd := stack[len(stack)-1]
+	from[actor] = stack[:len(stack)-1]
+	to[actor] = append(to[actor], cmd)
+	return cmd, true
+}
+
+// captureState loads the current state of a task, wherever it lives.
+func captureState(ctx context.Context, client *ent.Client, id int) (taskState, error) {
+	t, err := client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithTags().
+		WithFieldValues().
+		Only(ctx)
+	if err != nil {
+		return taskState{}, err
+	}
+	return stateOf(t), nil
+}
+
+// stateOf captures a task loaded with its tags and custom field values.
+func stateOf(t *ent.Task) taskState {
+	status := statusBoard
+	if t.DeletedAt != nil {
+		status = statusTrash
+	} else if t.ArchivedAt != nil {
+		status = statusArchive
+	}
+	snap := taskSnapshot(t)
+	return taskState{
+		Status:      status,
+		Title:       snap.Title,
+		Description: snap.Description,
+		Column:      snap.Column,
+		Position:    t.Position,
+		Assignee:    snap.Assignee,
+		Priority:    snap.Priority,
+		Due:         snap.Due,
+		Tags:        snap.Tags,
+		Fields:      snap.Fields,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+// recordUndo captures a task's state after an action and pushes the command
+// for the requesting actor. It returns the toast offering to undo it, or nil
+// when the request has no undo stack.
+func (s *Server) recordUndo(ctx context.Context, r *http.Request, label string, taskID int, before taskState) templ.Component {
+	key := undoKey(r)
+	if key == "" {
+		return nil
+	}
+	after, err := captureState(ctx, s.Client, taskID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to capture state for undo", "task_id", taskID, "error", err)
+		return nil
+	}
+
+	s.Undo.Push(key, boardCommand{
+		Label:  label,
+		TaskID: taskID,
+		Before: before,
+		After:  after,
+	})
+	return fragments.UndoToast(label, "undo")
+}
+
+// patchToast shows a toast on the requesting client.
+func patchToast(ctx context.Context, sse *datastar.ServerSentEventGenerator, toast templ.Component) {
+	if toast == nil {
+		return
+	}
+	var htmlBuilder strings.Builder
+	if err := toast.Render(ctx, &htmlBuilder); err != nil {
+		slog.ErrorContext(ctx, "failed to render toast", "error", err)
+		return
+	}
+	_ = sse.PatchElements(htmlBuilder.String(),
+		datastar.WithModeInner(),
+		datastar.WithSelector("#toast-container"))
+}
+
+// writeToast renders a toast as the body of a plain (non-SSE) response, used
+// by the drag-and-drop endpoints.
+func writeToast(ctx context.Context, w http.ResponseWriter, toast templ.Component) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if toast == nil {
+		return
+	}
+	if err := toast.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "failed to render toast", "error", err)
+	}
+}
+
+// errTaskGone is returned when undoing an action on a purged task.
+var errTaskGone = errors.New("task no longer exists")
+
+// applyTaskState puts a task into a previously captured state and broadcasts
+// the affected columns to every client. It refuses with errUndoConflict when
+// the task no longer looks like expected, i.e. someone changed it since, and
+// with a *moveRejection when the workflow or a WIP limit forbids the column
+// it would go back to.
+func (s *Server) applyTaskState(ctx context.Context, r *http.Request, id int, expected, st taskState, action, details string) error {
+	current, err := s.Client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithTags().
+		WithFieldValues().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return errTaskGone
+	}
+	if err != nil {
+		return err
+	}
+	if !expected.matches(stateOf(current)) {
+		return errUndoConflict
+	}
+	actor := actorFromRequest(r)
+	var (
+		breach   wip.Breach
+		breached bool
+	)
+	if st.Status == statusBoard {
+		from := current.Column
+		if current.DeletedAt != nil || current.ArchivedAt != nil {
+			from = "" // back onto the board into the column it left
+		}
+		subject := workflowSubject(r, st.Description, st.Assignee, st.Tags)
+		if breach, breached, err = s.checkMove(ctx, id, from, st.Column, st.Assignee, subject, false); err != nil {
+			return err
+		}
+	}
+	changes := history.Diff(taskSnapshot(current), st.snapshot())
+
+	due, err := parseDue(st.Due)
+	if err != nil {
+		return err
+	}
+	priority, _ := parsePriority(st.Priority)
+	update := setDue(s.Client.Task.UpdateOneID(id).
+		SetTitle(st.Title).
+		SetDescription(st.Description).
+		SetAssignee(st.Assignee).
+		SetPriority(priority), due)
+	switch st.Status {
+	case statusTrash:
+		update = update.SetDeletedAt(time.Now()).ClearArchivedAt()
+	case statusArchive:
+		update = update.SetArchivedAt(time.Now()).ClearDeletedAt()
+	default:
+		update = update.ClearDeletedAt().ClearArchivedAt()
+	}
+	if st.Status != statusBoard {
+		update = update.SetColumn(st.Column).SetPosition(st.Position)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return err
+	}
+
+	// Board tasks go back to their exact slot; hidden ones leave a gap to close
+	if st.Status == statusBoard {
+		err = reorderTasksOnColumnChange(ctx, s.Client, id, current.Column, st.Column, st.Position)
+	} else {
+		err = recompactColumnPositions(ctx, s.Client, current.Column)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := replaceTags(ctx, s.Client, id, st.Tags); err != nil {
+		return err
+	}
+	if err := replaceFieldValues(ctx, s.Client, id, st.Fields); err != nil {
+		return err
+	}
+
+	if _, err := s.recordHistory(ctx, id, action, details, actor, changes); err != nil {
+		slog.ErrorContext(ctx, "failed to create history", "action", action, "error", err)
+	}
+	if breached {
+		s.recordWIPBreach(ctx, id, breach, wipOutcome(breach), actor)
+	}
+	if _, err := recordRevision(ctx, s.Client, id, actor); err != nil {
+		slog.ErrorContext(ctx, "failed to record revision", "error", err)
+	}
+
+	s.Broadcaster.BroadcastBoard(id, "column_refresh", current.Column, "")
+	if st.Column != current.Column {
+		s.Broadcaster.BroadcastBoard(id, "column_refresh", st.Column, "")
+	}
+	return nil
+}
+
+// replaceTags swaps a task's tag set for the given "key:value" pairs.
+func replaceTags(ctx context.Context, client *ent.Client, taskID int, pairs []string) error {
+	existing, err := client.TaskTag.Query().Where(tasktag.HasTaskWith(task.IDEQ(taskID))).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only touch tags that actually change so automations see real additions
+	want := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		want[pair] = true
+	}
+	for _, tag := range existing {
+		pair := tag.Key + ":" + tag.Value
+		if want[pair] {
+			delete(want, pair)
+			continue
+		}
+		if err := client.TaskTag.DeleteOne(tag).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, pair := range pairs {
+		if !want[pair] {
+			continue
+		}
+		delete(want, pair)
+		key, value, _ := strings.Cut(pair, ":")
+		if _, err := client.TaskTag.Create().
+			SetTaskID(taskID).
+			SetKey(key).
+			SetValue(value).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UndoHandler reverts the requesting actor's most recent board action.
+func (s *Server) UndoHandler(w http.ResponseWriter, r *http.Request) {
+	s.stepHistory(w, r, true)
+}
+
+// RedoHandler re-applies the requesting actor's most recently undone action.
+func (s *Server) RedoHandler(w http.ResponseWriter, r *http.Request) {
+	s.stepHistory(w, r, false)
+}
+
+func (s *Server) stepHistory(w http.ResponseWriter, r *http.Request, undo bool) {
+	ctx := r.Context()
+	key := undoKey(r)
+	sse := datastar.NewSSE(w, r)
+
+	verb, action := "undo", "undone"
+	pop := s.Undo.PopUndo
+	if !undo {
+		verb, action = "redo", "redone"
+		pop = s.Undo.PopRedo
+	}
+
+	cmd, ok := pop(key)
+	if !ok {
+		patchToast(ctx, sse, fragments.UndoToast("Nothing to "+verb, ""))
+		return
+	}
+
+	expected, target := cmd.Before, cmd.After
+	if undo {
+		expected, target = cmd.After, cmd.Before
+	}
+
+	details := fmt.Sprintf("%s: %s", action, cmd.Label)
+	err := s.applyTaskState(ctx, r, cmd.TaskID, expected, target, action, details)
+	var rejected *moveRejection
+	if errors.Is(err, errUndoConflict) || errors.Is(err, errTaskGone) || errors.As(err, &rejected) {
+		// Don't offer it again; the toast says why it was refused
+		s.Undo.Discard(key, undo)
+		patchToast(ctx, sse, fragments.UndoToast("Couldn't "+verb+" "+cmd.Label+": "+err.Error(), ""))
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to apply "+verb, "task_id", cmd.TaskID, "error", err)
+		patchToast(ctx, sse, fragments.UndoToast("Couldn't "+verb+": "+err.Error(), ""))
+		return
+	}
+
+	if undo {
+		patchToast(ctx, sse, fragments.UndoToast("Undone: "+cmd.Label, "redo"))
+	} else {
+		patchToast(ctx, sse, fragments.UndoToast("Redone: "+cmd.Label, "undo"))
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func (ts *testServer) column(t *testing.T, id int) string {
+	t.Helper()
+	task, err := ts.Client.Task.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return task.Column
+}
+
+func (ts *testServer) move(t *testing.T, id int, column string, position int, headers ...string) {
+	t.Helper()
+	path := fmt.Sprintf("/datastar/tasks/%d/column", id)
+	rec := ts.do(t, http.MethodPatch, path, map[string]any{"column": column, "position": position}, headers...)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("move #%d to %s: %d %s", id, column, rec.Code, rec.Body)
+	}
+}
+
+func TestUndoKeepsAnonymousTabsApart(t *testing.T) {
+	ts := newTestServer(t)
+	task := ts.createTask(t, map[string]any{"title": "Anonymous", "column": "backlog"})
+
+	ts.move(t, task.ID, "in_progress", 0, "X-Client-Nonce", "tab-a")
+
+	rec := ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Client-Nonce", "tab-b")
+	if !strings.Contains(rec.Body.String(), "Nothing to undo") {
+		t.Errorf("undo from another tab: %s", rec.Body)
+	}
+	if got := ts.column(t, task.ID); got != "in_progress" {
+		t.Errorf("after undo from another tab, column = %q", got)
+	}
+
+	ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Client-Nonce", "tab-a")
+	if got := ts.column(t, task.ID); got != "backlog" {
+		t.Errorf("after undo from the same tab, column = %q, want backlog", got)
+	}
+}
+
+func TestUndoRefusesAfterConcurrentEdit(t *testing.T) {
+	ts := newTestServer(t)
+	task := ts.createTask(t, map[string]any{"title": "Contested", "column": "backlog"})
+
+	ts.move(t, task.ID, "in_progress", 0, "X-Actor", "alice")
+	if _, err := ts.Client.Task.UpdateOneID(task.ID).SetTitle("Edited by bob").Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Actor", "alice")
+	if !strings.Contains(rec.Body.String(), "the task has changed since") {
+		t.Errorf("undo after another edit: %s", rec.Body)
+	}
+	if got := ts.column(t, task.ID); got != "in_progress" {
+		t.Errorf("after refused undo, column = %q", got)
+	}
+
+	rec = ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Actor", "alice")
+	if !strings.Contains(rec.Body.String(), "Nothing to undo") {
+		t.Errorf("refused undo was offered again: %s", rec.Body)
+	}
+}
+
+func TestUndoIgnoresReorderedNeighbours(t *testing.T) {
+	ts := newTestServer(t)
+	a := ts.createTask(t, map[string]any{"title": "A", "column": "backlog"})
+	b := ts.createTask(t, map[string]any{"title": "B", "column": "backlog"})
+
+	ts.move(t, a.ID, "in_progress", 0, "X-Actor", "alice")
+	ts.move(t, b.ID, "in_progress", 0, "X-Actor", "bob") // shifts A down
+
+	ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Actor", "alice")
+	if got := ts.column(t, a.ID); got != "backlog" {
+		t.Errorf("after undo, column = %q, want backlog", got)
+	}
+}
+
+func TestUndoDropsPurgedTask(t *testing.T) {
+	ts := newTestServer(t)
+	task := ts.createTask(t, map[string]any{"title": "Purged", "column": "backlog"})
+
+	ts.move(t, task.ID, "in_progress", 0, "X-Actor", "alice")
+	ts.trash(t, task.ID)
+	if err := ts.purgeTask(context.Background(), task.ID, "bob"); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Actor", "alice")
+	if !strings.Contains(rec.Body.String(), "task no longer exists") {
+		t.Errorf("undo on a purged task: %s", rec.Body)
+	}
+	for _, verb := range []string{"undo", "redo"} {
+		rec = ts.do(t, http.MethodPost, "/datastar/"+verb, nil, "X-Actor", "alice")
+		if !strings.Contains(rec.Body.String(), "Nothing to "+verb) {
+			t.Errorf("%s offered the purged task's move again: %s", verb, rec.Body)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"context"
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+	"github.com/j0hnsmith/botTaskTracker/wip"
+)
+
+// boardColumns are the board's columns in display order.
+var boardColumns = []string{"backlog", "in_progress", "review", "done"}
+
+// loadWIPLimits returns every configured limit.
+func loadWIPLimits(ctx context.Context, client *ent.Client) ([]wip.Limit, error) {
+	rows, err := client.WipLimit.Query().
+		Order(ent.Asc(wiplimit.FieldColumn), ent.Asc(wiplimit.FieldAssignee)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limits := make([]wip.Limit, len(rows))
+	for i, row := range rows {
+		limits[i] = wip.Limit{
+			Column:   row.Column,
+			Assignee: row.Assignee,
+			Max:      row.MaxTasks,
+			Mode:     wip.Mode(row.Mode),
+		}
+	}
+	return limits, nil
+}
+
+// columnCounts counts the board tasks in every column, ignoring filters.
+func columnCounts(ctx context.Context, client *ent.Client) (map[string]int, error) {
+	var rows []struct {
+		Column string `json:"column"`
+		Count  int    `json:"count"`
+	}
+	err := client.Task.Query().
+		Where(onBoard()).
+		GroupBy(task.FieldColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Column] = row.Count
+	}
+	return counts, nil
+}
+
+// checkWIP returns the most severe limit a task would exceed by entering
+// column with assignee. taskID is the task being moved, or 0 for a new task.
+func checkWIP(ctx context.Context, client *ent.Client, column, assignee string, taskID int) (wip.Breach, bool, error) {
+	limits, err := loadWIPLimits(ctx, client)
+	if err != nil || len(limits) == 0 {
+		return wip.Breach{}, false, err
+	}
+
+	others := client.Task.Query().Where(onBoard(), task.ColumnEQ(column), task.IDNEQ(taskID))
+	columnCount, err := others.Clone().Count(ctx)
+	if err != nil {
+		return wip.Breach{}, false, err
+	}
+	assigneeCount := 0
+	if assignee != "" {
+		assigneeCount, err = others.Where(task.AssigneeEQ(assignee)).Count(ctx)
+		if err != nil {
+			return wip.Breach{}, false, err
+		}
+	}
+
+	breach, ok := wip.Check(limits, column, assignee, columnCount+1, assigneeCount+1)
+	return breach, ok, nil
+}
+
+// recordWIPBreach adds a history entry for a limit that was exceeded (warned
+// or overridden) or that stopped a change (blocked).
+func (s *Server) recordWIPBreach(ctx context.Context, taskID int, breach wip.Breach, outcome, actor string) {
+	details := breach.Error() + " (" + outcome + ")"
+	if _, err := s.recordHistory(ctx, taskID, "wip_exceeded", details, actor, nil); err != nil {
+		slog.ErrorContext(ctx, "failed to record WIP breach", "task_id", taskID, "error", err)
+	}
+}
+
+// wipOutcome describes how a breach that didn't block was handled.
+func wipOutcome(breach wip.Breach) string {
+	if breach.Limit.Mode == wip.SoftBlock {
+		return "overridden"
+	}
+	return "warned"
+}
+
+// wipErrorHTML explains a blocked save in the add/edit forms. Soft blocks
+// offer to save anyway, which resubmits the form with the override signal.
+func wipErrorHTML(id string, breach wip.Breach) string {
+	msg := html.EscapeString(breach.Error())
+	if breach.Limit.Mode == wip.HardBlock {
+		return `<div id="` + id + `" class="alert alert-error text-sm">` + msg + `</div>`
+	}
+	return `<div id="` + id + `" class="alert alert-warning text-sm"><span>` + msg + `</span>` +
+		`<button type="button" class="btn btn-xs" data-on:click="$wip_override = true; el.closest('form').requestSubmit()">Save anyway</button></div>`
+}
+
+// patchWIPBadges re-renders the column header counts after tasks change
+// columns.
+func patchWIPBadges(ctx context.Context, sse *datastar.ServerSentEventGenerator, client *ent.Client) error {
+	limits, err := loadWIPLimits(ctx, client)
+	if err != nil {
+		return err
+	}
+	counts, err := columnCounts(ctx, client)
+	if err != nil {
+		return err
+	}
+	var htmlBuilder strings.Builder
+	for _, column := range boardColumns {
+		if err := fragments.WIPBadge(column, counts[column], limits).Render(ctx, &htmlBuilder); err != nil {
+			return err
+		}
+	}
+	return sse.PatchElements(htmlBuilder.String())
+}
+
+// WIPBadgesHandler returns every column header count as plain HTML, so the
+// drag-and-drop script can refresh them after its own moves.
+func (s *Server) WIPBadgesHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limits, err := loadWIPLimits(ctx, s.Client)
+	if err != nil {
+		http.Error(w, "Failed to load WIP limits", http.StatusInternalServerError)
+		return
+	}
+	counts, err := columnCounts(ctx, s.Client)
+	if err != nil {
+		http.Error(w, "Failed to count tasks", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	for _, column := range boardColumns {
+		if err := fragments.WIPBadge(column, counts[column], limits).Render(ctx, w); err != nil {
+			slog.ErrorContext(ctx, "failed to render WIP badge", "column", column, "error", err)
+			return
+		}
+	}
+}
+
+// WIPLimitsPageHandler lists the WIP limits with a form to add more.
+func (s *Server) WIPLimitsPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limits, err := s.Client.WipLimit.Query().
+		Order(ent.Asc(wiplimit.FieldColumn), ent.Asc(wiplimit.FieldAssignee)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get WIP limits", "error", err)
+		http.Error(w, "Failed to load WIP limits", http.StatusInternalServerError)
+		return
+	}
+	counts, err := columnCounts(ctx, s.Client)
+	if err != nil {
+		http.Error(w, "Failed to count tasks", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.WIPLimitsContent(limits, counts, boardColumns, wip.Modes)
+	page := templates.Layout("WIP limits - Bot Task Tracker", pages.WIPLimitsMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// WIPLimitSaveHandler creates a limit or updates the one for the same column
+// and assignee.
+func (s *Server) WIPLimitSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type WIPLimitSignals struct {
+		Column   string `json:"wip_column"`
+		Assignee string `json:"wip_assignee"`
+		Max      string `json:"wip_max"`
+		Mode     string `json:"wip_mode"`
+	}
+	signals := &WIPLimitSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+	patchError := func(msg string) {
+		_ = sse.PatchElements(`<div id="wip-error" class="alert alert-error text-sm">` + html.EscapeString(msg) + `</div>`)
+	}
+
+	column := sanitizeColumn(signals.Column)
+	assignee := strings.TrimSpace(signals.Assignee)
+	max, err := strconv.Atoi(strings.TrimSpace(signals.Max))
+	if err != nil || max < 1 {
+		patchError("Limit must be a whole number of at least 1")
+		return
+	}
+	mode := wiplimit.Mode(signals.Mode)
+	if err := wiplimit.ModeValidator(mode); err != nil {
+		patchError("Unknown mode")
+		return
+	}
+
+	existing, err := s.Client.WipLimit.Query().
+		Where(wiplimit.ColumnEQ(column), wiplimit.AssigneeEQ(assignee)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = s.Client.WipLimit.Create().
+			SetColumn(column).
+			SetAssignee(assignee).
+			SetMaxTasks(max).
+			SetMode(mode).
+			Exec(ctx)
+	case err == nil:
+		err = existing.Update().
+			SetMaxTasks(max).
+			SetMode(mode).
+			Exec(ctx)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save WIP limit", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/limits")
+}
+
+// WIPLimitDeleteHandler removes a limit.
+func (s *Server) WIPLimitDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid limit ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.Client.WipLimit.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete WIP limit", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.RemoveElement("#wip-limit-row-" + strconv.Itoa(id))
+}
@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/starfederation/datastar-go/datastar"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/task"
+	"github.com/j0hnsmith/botTaskTracker/ent/transition"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/fragments"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+	"github.com/j0hnsmith/botTaskTracker/wip"
+	"github.com/j0hnsmith/botTaskTracker/workflow"
+)
+
+// loadWorkflow returns the board's transition rules.
+func loadWorkflow(ctx context.Context, client *ent.Client) ([]workflow.Rule, error) {
+	rows, err := client.Transition.Query().
+		Order(ent.Asc(transition.FieldFromColumn), ent.Asc(transition.FieldToColumn)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]workflow.Rule, 0, len(rows))
+	for _, row := range rows {
+		rule := workflow.Rule{From: row.FromColumn, To: row.ToColumn}
+		for _, raw := range row.Guards {
+			g, err := workflow.ParseGuard(raw)
+			if err != nil {
+				// Stored guards are validated on save; skip anything unreadable
+				slog.WarnContext(ctx, "ignoring invalid guard", "transition", row.ID, "guard", raw)
+				continue
+			}
+			rule.Guards = append(rule.Guards, g)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// workflowSubject describes a task about to move and who is moving it.
+func workflowSubject(r *http.Request, description, assignee string, tags []string) workflow.Subject {
+	return workflow.Subject{
+		Description:  description,
+		Assignee:     assignee,
+		Tags:         tags,
+		Actor:        actorFromRequest(r),
+		ActorIsHuman: actorIsHuman(r),
+	}
+}
+
+// checkWorkflow reports why a task may not move from one column to another.
+func checkWorkflow(ctx context.Context, client *ent.Client, from, to string, subject workflow.Subject) error {
+	rules, err := loadWorkflow(ctx, client)
+	if err != nil {
+		return err
+	}
+	return workflow.Check(rules, from, to, subject)
+}
+
+// moveRejection is returned by moveTask when the workflow or a WIP limit
+// stops a move.
+type moveRejection struct {
+	reason string
+	breach *wip.Breach // set when a WIP limit blocked the move
+}
+
+func (e *moveRejection) Error() string {
+	return e.reason
+}
+
+// checkMove runs the workflow and WIP checks for a task changing column from
+// one column to another, returning a *moveRejection when either stops it.
+// An empty from means the task is coming back onto the board into the
+// column it left, so only WIP limits apply. A breach that doesn't block is
+// returned for the caller to record once the move is done.
+func (s *Server) checkMove(ctx context.Context, id int, from, to, assignee string, subject workflow.Subject, overrideWIP bool) (wip.Breach, bool, error) {
+	if from == to {
+		return wip.Breach{}, false, nil
+	}
+	if from != "" {
+		err := checkWorkflow(ctx, s.Client, from, to, subject)
+		var rejection *workflow.Rejection
+		if errors.As(err, &rejection) {
+			return wip.Breach{}, false, &moveRejection{reason: rejection.Reason}
+		}
+		if err != nil {
+			return wip.Breach{}, false, err
+		}
+	}
+
+	breach, breached, err := checkWIP(ctx, s.Client, to, assignee, id)
+	if err != nil {
+		return wip.Breach{}, false, err
+	}
+	if breached && breach.Blocks(overrideWIP) {
+		// Only hard blocks are final; a soft block is retried once confirmed
+		if breach.Limit.Mode == wip.HardBlock {
+			s.recordWIPBreach(ctx, id, breach, "blocked", subject.Actor)
+		}
+		return wip.Breach{}, false, &moveRejection{reason: breach.Error(), breach: &breach}
+	}
+	return breach, breached, nil
+}
+
+// moveTask moves a task to a column and position after checking the workflow
+// and WIP limits. It records history and a revision and broadcasts the move,
+// tagged with nonce so the originating tab ignores it. The returned state is
+// the task before the move, for undo.
+func (s *Server) moveTask(ctx context.Context, r *http.Request, id int, column string, position int, overrideWIP bool, nonce string) (*ent.Task, taskState, error) {
+	existingTask, err := s.Client.Task.Query().
+		Where(task.IDEQ(id), onBoard()).
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return nil, taskState{}, err
+	}
+
+	oldColumn := existingTask.Column
+	newColumn := sanitizeColumn(column)
+
+	subject := workflowSubject(r, existingTask.Description, existingTask.Assignee, taskSnapshot(existingTask).Tags)
+	breach, breached, err := s.checkMove(ctx, id, oldColumn, newColumn, existingTask.Assignee, subject, overrideWIP)
+	if err != nil {
+		return nil, taskState{}, err
+	}
+
+	if err := recordBaselineRevision(ctx, s.Client, id); err != nil {
+		slog.ErrorContext(ctx, "failed to record baseline revision", "error", err)
+	}
+	before, err := captureState(ctx, s.Client, id)
+	if err != nil {
+		return nil, taskState{}, err
+	}
+
+	// Update task column and reorder positions
+	if err := reorderTasksOnColumnChange(ctx, s.Client, id, oldColumn, newColumn, position); err != nil {
+		return nil, taskState{}, err
+	}
+
+	// Create history entry
+	details := fmt.Sprintf("moved from %s to %s", oldColumn, newColumn)
+	historyEntry, err := s.Client.TaskHistory.Create().
+		SetTaskID(id).
+		SetAction("moved").
+		SetDetails(details).
+		SetActor(existingTask.Assignee).
+		Save(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create history for column update", "error", err)
+	} else {
+		// Broadcast activity update
+		s.Broadcaster.BroadcastActivity(historyEntry.ID)
+	}
+
+	if breached {
+		s.recordWIPBreach(ctx, id, breach, wipOutcome(breach), actorFromRequest(r))
+	}
+
+	// Snapshot the task in its new column
+	if oldColumn != newColumn {
+		if _, err := recordRevision(ctx, s.Client, id, existingTask.Assignee); err != nil {
+			slog.ErrorContext(ctx, "failed to record revision", "error", err)
+		}
+	}
+
+	// Reload task with edges
+	updatedTask, err := s.Client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithTags().
+		WithFieldValues().
+		WithHistory().
+		Only(ctx)
+	if err != nil {
+		return nil, taskState{}, err
+	}
+
+	// Broadcast event to all clients (originator will ignore due to nonce match)
+	s.Broadcaster.BroadcastBoard(updatedTask.ID, "task_moved", newColumn, nonce)
+
+	slog.InfoContext(ctx, "task column updated",
+		"task_id", id,
+		"from", oldColumn,
+		"to", newColumn,
+		"position", position,
+		"nonce", nonce)
+
+	return updatedTask, before, nil
+}
+
+// writeMoveRejected rejects a drag-and-drop move with the reason as a toast.
+// For WIP limits the mode header tells the script whether to ask for
+// confirmation and retry; otherwise the card snaps back.
+func writeMoveRejected(ctx context.Context, w http.ResponseWriter, rejected *moveRejection) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if rejected.breach != nil {
+		w.Header().Set("X-WIP-Mode", string(rejected.breach.Limit.Mode))
+	}
+	w.WriteHeader(http.StatusConflict)
+	if err := fragments.UndoToast(rejected.reason, "").Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "failed to render toast", "error", err)
+	}
+}
+
+// APITaskMoveHandler moves a task between columns, enforcing the same
+// workflow rules and WIP limits as drag and drop:
+//
+//	{"column": "review", "position": 0, "override": false}
+//
+// Position defaults to the end of the column. Workflow rejections are 422,
+// WIP limit blocks 409; override confirms a move past a soft limit.
+func (s *Server) APITaskMoveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid task ID")
+		return
+	}
+
+	var req struct {
+		Column   string `json:"column"`
+		Position *int   `json:"position"`
+		Override bool   `json:"override"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if sanitizeColumn(req.Column) != strings.ToLower(strings.TrimSpace(req.Column)) {
+		writeJSONError(w, http.StatusBadRequest, "unknown column")
+		return
+	}
+
+	position := 0
+	if req.Position != nil {
+		position = *req.Position
+	} else {
+		position, err = getNextPosition(ctx, s.Client, sanitizeColumn(req.Column))
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, "failed to move task")
+			return
+		}
+	}
+
+	moved, before, err := s.moveTask(ctx, r, id, req.Column, position, req.Override, "")
+	var rejected *moveRejection
+	switch {
+	case errors.As(err, &rejected):
+		status := http.StatusUnprocessableEntity
+		if rejected.breach != nil {
+			status = http.StatusConflict
+		}
+		writeJSONError(w, status, rejected.reason)
+		return
+	case ent.IsNotFound(err):
+		writeJSONError(w, http.StatusNotFound, "task not found")
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "failed to move task", "task_id", id, "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to move task")
+		return
+	}
+
+	s.recordUndo(ctx, r, fmt.Sprintf("Moved %q to %s", moved.Title, moved.Column), id, before)
+	writeJSON(w, http.StatusOK, toAPITask(moved))
+}
+
+// WorkflowPageHandler shows the transition rules with a form to add more.
+func (s *Server) WorkflowPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	transitions, err := s.Client.Transition.Query().
+		Order(ent.Asc(transition.FieldFromColumn), ent.Asc(transition.FieldToColumn)).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get transitions", "error", err)
+		http.Error(w, "Failed to load workflow", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.WorkflowContent(transitions, boardColumns)
+	page := templates.Layout("Workflow - Bot Task Tracker", pages.WorkflowMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// TransitionSaveHandler allows a move, or replaces the guards of an allowed one.
+func (s *Server) TransitionSaveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	type TransitionSignals struct {
+		From   string `json:"transition_from"`
+		To     string `json:"transition_to"`
+		Guards string `json:"transition_guards"`
+	}
+	signals := &TransitionSignals{}
+	if err := datastar.ReadSignals(r, signals); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+	patchError := func(msg string) {
+		_ = sse.PatchElements(`<div id="transition-error" class="alert alert-error text-sm">` + html.EscapeString(msg) + `</div>`)
+	}
+
+	from := workflow.Any
+	if signals.From != workflow.Any {
+		from = sanitizeColumn(signals.From)
+	}
+	to := sanitizeColumn(signals.To)
+	if from == to {
+		patchError("A task staying in its column is always allowed")
+		return
+	}
+	var guards []string
+	for _, raw := range strings.Split(signals.Guards, ",") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		g, err := workflow.ParseGuard(raw)
+		if err != nil {
+			patchError(err.Error())
+			return
+		}
+		guards = append(guards, g.String())
+	}
+
+	existing, err := s.Client.Transition.Query().
+		Where(transition.FromColumnEQ(from), transition.ToColumnEQ(to)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = s.Client.Transition.Create().
+			SetFromColumn(from).
+			SetToColumn(to).
+			SetGuards(guards).
+			Exec(ctx)
+	case err == nil:
+		err = existing.Update().SetGuards(guards).Exec(ctx)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save transition", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/workflow")
+}
+
+// TransitionDeleteHandler disallows a move again. Removing the last rule
+// allows every move.
+func (s *Server) TransitionDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid transition ID", http.StatusBadRequest)
+		return
+	}
+
+	sse := datastar.NewSSE(w, r)
+
+	if err := s.Client.Transition.DeleteOneID(id).Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to delete transition", "error", err)
+		_ = sse.ConsoleError(err)
+		return
+	}
+
+	_ = sse.Redirect("/workflow")
+}
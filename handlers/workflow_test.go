@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrule"
+	"github.com/j0hnsmith/botTaskTracker/ent/automationrun"
+	"github.com/j0hnsmith/botTaskTracker/ent/wiplimit"
+)
+
+func TestHumansGuardFailsClosed(t *testing.T) {
+	ts := newTestServer(t)
+	ts.Client.Transition.Create().
+		SetFromColumn("backlog").SetToColumn("in_progress").SetGuards([]string{"humans"}).
+		ExecX(context.Background())
+
+	cases := []struct {
+		name    string
+		headers []string
+		want    int
+	}{
+		{"bot", []string{"X-Actor", "deploy-bot"}, http.StatusConflict},
+		{"bot with a cookie", []string{"X-Actor", "deploy-bot", "Cookie", actorCookie + "=peter"}, http.StatusConflict},
+		{"unknown caller", nil, http.StatusConflict},
+		{"person", []string{"Cookie", actorCookie + "=peter"}, http.StatusOK},
+	}
+	for _, c := range cases {
+		task := ts.createTask(t, map[string]any{"title": c.name, "column": "backlog"})
+		path := fmt.Sprintf("/datastar/tasks/%d/column", task.ID)
+		rec := ts.do(t, http.MethodPatch, path, map[string]any{"column": "in_progress"}, c.headers...)
+		if rec.Code != c.want {
+			t.Errorf("%s: status %d, want %d: %s", c.name, rec.Code, c.want, rec.Body)
+		}
+	}
+}
+
+// onlyForward allows backlog to in_progress and nothing else.
+func onlyForward(t *testing.T, ts *testServer) {
+	t.Helper()
+	ts.Client.Transition.Create().SetFromColumn("backlog").SetToColumn("in_progress").ExecX(context.Background())
+}
+
+func TestRevisionRestoreChecksWorkflow(t *testing.T) {
+	ts := newTestServer(t)
+	task := ts.createTask(t, map[string]any{"title": "Forward only", "column": "backlog"})
+	onlyForward(t, ts)
+	ts.move(t, task.ID, "in_progress", 0)
+
+	rec := ts.do(t, http.MethodPost, fmt.Sprintf("/datastar/tasks/%d/revisions/1/restore", task.ID), nil)
+	if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "not allowed") {
+		t.Errorf("restore into backlog: %d %s", rec.Code, rec.Body)
+	}
+	if got := ts.column(t, task.ID); got != "in_progress" {
+		t.Errorf("column = %q, want in_progress", got)
+	}
+}
+
+func TestUndoChecksWorkflow(t *testing.T) {
+	ts := newTestServer(t)
+	task := ts.createTask(t, map[string]any{"title": "Forward only", "column": "backlog"})
+	onlyForward(t, ts)
+	ts.move(t, task.ID, "in_progress", 0, "X-Actor", "alice")
+
+	rec := ts.do(t, http.MethodPost, "/datastar/undo", nil, "X-Actor", "alice")
+	if !strings.Contains(rec.Body.String(), "not allowed") {
+		t.Errorf("undo into backlog: %s", rec.Body)
+	}
+	if got := ts.column(t, task.ID); got != "in_progress" {
+		t.Errorf("column = %q, want in_progress", got)
+	}
+}
+
+func TestTrashRestoreChecksWIP(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	trashed := ts.createTask(t, map[string]any{"title": "Trashed", "column": "review"})
+	if rec := ts.do(t, http.MethodDelete, fmt.Sprintf("/datastar/tasks/%d", trashed.ID), nil); rec.Code != http.StatusOK {
+		t.Fatalf("delete: %d %s", rec.Code, rec.Body)
+	}
+	ts.createTask(t, map[string]any{"title": "Took its place", "column": "review"})
+	ts.Client.WipLimit.Create().SetColumn("review").SetMaxTasks(1).SetMode(wiplimit.ModeHard).ExecX(ctx)
+
+	rec := ts.do(t, http.MethodPost, fmt.Sprintf("/datastar/tasks/%d/restore", trashed.ID), nil)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("restore into a full column: %d %s", rec.Code, rec.Body)
+	}
+	if got := ts.Client.Task.GetX(ctx, trashed.ID); got.DeletedAt == nil {
+		t.Error("task left the trash")
+	}
+}
+
+func TestAutomationMoveChecksWorkflow(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+	onlyForward(t, ts)
+	ts.Client.AutomationRule.Create().
+		SetName("Ship it").
+		SetTrigger(automationrule.TriggerCreated).
+		SetConditions([]string{}).
+		SetActions([]string{"move done"}).
+		ExecX(ctx)
+
+	task := ts.createTask(t, map[string]any{"title": "Skips review", "column": "backlog"})
+	if err := ts.handleAutomationEvent(ctx, <-ts.Automations.events); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := ts.column(t, task.ID); got != "backlog" {
+		t.Errorf("column = %q, want backlog", got)
+	}
+	run := ts.Client.AutomationRun.Query().OnlyX(ctx)
+	if run.Status != automationrun.StatusFailed || !strings.Contains(run.Message, "not allowed") {
+		t.Errorf("run = %s %q, want a failed run saying the move is not allowed", run.Status, run.Message)
+	}
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/ent"
+	"github.com/j0hnsmith/botTaskTracker/ent/taskhistory"
+	"github.com/j0hnsmith/botTaskTracker/metrics"
+	"github.com/j0hnsmith/botTaskTracker/templates"
+	"github.com/j0hnsmith/botTaskTracker/templates/pages"
+)
+
+// lastActivity returns when each actor last changed a task. Actors who never
+// have are left out.
+func lastActivity(ctx context.Context, client *ent.Client, actors []string) (map[string]time.Time, error) {
+	last := make(map[string]time.Time, len(actors))
+	for _, actor := range actors {
+		entry, err := client.TaskHistory.Query().
+			Where(taskhistory.ActorEQ(actor)).
+			Order(ent.Desc(taskhistory.FieldCreatedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		last[actor] = entry.CreatedAt
+	}
+	return last, nil
+}
+
+// workloads reports on every assignee, or only filter.Assignee when set.
+// Completions are counted between filter.Since and filter.Until.
+func (s *Server) workloads(ctx context.Context, filter analyticsFilter) ([]metrics.Workload, error) {
+	tasks, err := loadFlowTasks(ctx, s.Client, "", filter.Tag, false)
+	if err != nil {
+		return nil, err
+	}
+	assignees := metrics.Assignees(defaultAssignees, tasks)
+	if filter.Assignee != "" {
+		assignees = []string{filter.Assignee}
+	}
+	last, err := lastActivity(ctx, s.Client, assignees)
+	if err != nil {
+		return nil, err
+	}
+	return metrics.Workloads(assignees, tasks, last, metrics.WorkloadOptions{
+		Options: metrics.Options{
+			Start: startColumn,
+			Done:  doneColumn,
+			Since: filter.Since,
+			Until: filter.Until,
+		},
+		Now:       time.Now(),
+		IdleAfter: s.Config.IdleAlertAfter,
+	}), nil
+}
+
+// WorkloadPageHandler shows each assignee's WIP, weekly completions, cycle
+// time, reopens and last activity, flagging idle ones holding work.
+func (s *Server) WorkloadPageHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseAnalyticsFilter(r.URL.Query(), time.Now())
+
+	workloads, err := s.workloads(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute workloads", "error", err)
+		http.Error(w, "Failed to load workload", http.StatusInternalServerError)
+		return
+	}
+
+	bodyContent := pages.WorkloadContent(workloads, filter.values(), boardColumns, defaultAssignees, s.Config.IdleAlertAfter)
+	page := templates.Layout("Workload - Bot Task Tracker", pages.WorkloadMetaTags(), bodyContent)
+	if err := page.Render(ctx, w); err != nil {
+		slog.ErrorContext(ctx, "render template", "error", err)
+		http.Error(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+}
+
+// apiWorkload is one assignee's workload; durations are in hours.
+type apiWorkload struct {
+	Assignee   string         `json:"assignee"`
+	WIP        map[string]int `json:"wip"`
+	Completed  []apiWeek      `json:"completed"`
+	CycleTime  *float64       `json:"avg_cycle_time_hours"` // null without completed, started tasks
+	Reopened   int            `json:"reopened"`
+	LastActive *time.Time     `json:"last_active_at"` // null when never active
+	IdleHours  *float64       `json:"idle_hours"`
+	Alert      bool           `json:"idle_alert"`
+}
+
+// APIWorkloadHandler returns the workload page's numbers, with the same
+// assignee, tag, since and until filters.
+func (s *Server) APIWorkloadHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	filter := parseAnalyticsFilter(r.URL.Query(), time.Now())
+
+	workloads, err := s.workloads(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to compute workloads", "error", err)
+		writeJSONError(w, http.StatusInternalServerError, "failed to compute workloads")
+		return
+	}
+
+	out := make([]apiWorkload, len(workloads))
+	alerts := []string{}
+	for i, wl := range workloads {
+		wip := make(map[string]int, len(boardColumns))
+		for _, column := range boardColumns {
+			wip[column] = wl.WIP[column]
+		}
+		out[i] = apiWorkload{
+			Assignee:  wl.Assignee,
+			WIP:       wip,
+			Completed: toAPIWeeks(wl.Completed),
+			Reopened:  wl.Reopened,
+			Alert:     wl.Alert,
+		}
+		if wl.Cycles > 0 {
+			cycle := hours(wl.CycleTime)
+			out[i].CycleTime = &cycle
+		}
+		if !wl.LastActive.IsZero() {
+			last, idle := wl.LastActive, hours(wl.Idle)
+			out[i].LastActive, out[i].IdleHours = &last, &idle
+		}
+		if wl.Alert {
+			alerts = append(alerts, wl.Assignee)
+		}
+	}
+
+	q := filter.values()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"since":            q.Get("since"),
+		"until":            q.Get("until"),
+		"idle_alert_hours": hours(s.Config.IdleAlertAfter),
+		"alerts":           alerts,
+		"assignees":        out,
+	})
+}
+
+// warnIdleAssignees logs a warning for every assignee holding in-progress
+// tasks without having changed anything for the configured period.
+func (s *Server) warnIdleAssignees(ctx context.Context) error {
+	if s.Config.IdleAlertAfter == 0 {
+		return nil
+	}
+	workloads, err := s.workloads(ctx, parseAnalyticsFilter(nil, time.Now()))
+	if err != nil {
+		return err
+	}
+	for _, wl := range workloads {
+		if !wl.Alert {
+			continue
+		}
+		slog.WarnContext(ctx, "assignee idle while holding in-progress tasks",
+			"assignee", wl.Assignee, "in_progress", wl.WIP[startColumn], "last_active", wl.LastActive)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Package history describes the structured change sets stored on TaskHistory
+// entries and renders them as readable diffs.
+package history
+
+import (
+	"sort"
+	"strings"
+)
+
+// Change is a single field edit recorded on a TaskHistory entry.
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// Snapshot holds the user-editable fields of a task that changes are computed from.
+type Snapshot struct {
+	Title       string
+	Description string
+	Column      string
+	Assignee    string
+	Priority    string
+	Due         string            // "2006-01-02" or empty
+	Tags        []string          // "key:value" pairs
+	Fields      map[string]string // custom field values by key
+}
+
+// Diff returns the changes between two snapshots in a stable field order.
+// Tag order is ignored.
+func Diff(before, after Snapshot) []Change {
+	var changes []Change
+	add := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, Change{Field: field, Old: old, New: new})
+		}
+	}
+	add("title", before.Title, after.Title)
+	add("description", before.Description, after.Description)
+	add("column", before.Column, after.Column)
+	add("assignee", before.Assignee, after.Assignee)
+	add("priority", before.Priority, after.Priority)
+	add("due", before.Due, after.Due)
+	add("tags", JoinTags(before.Tags), JoinTags(after.Tags))
+
+	keys := make(map[string]bool)
+	for k := range before.Fields {
+		keys[k] = true
+	}
+	for k := range after.Fields {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		add(k, before.Fields[k], after.Fields[k])
+	}
+	return changes
+}
+
+// JoinTags formats "key:value" pairs as a sorted, comma separated list.
+func JoinTags(tags []string) string {
+	sorted := append([]string(nil), tags...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+// Summary describes which fields changed, e.g. "updated title and tags".
+func Summary(changes []Change) string {
+	if len(changes) == 0 {
+		return "updated task"
+	}
+	fields := make([]string, len(changes))
+	for i, c := range changes {
+		fields[i] = c.Field
+	}
+	if len(fields) == 1 {
+		return "updated " + fields[0]
+	}
+	return "updated " + strings.Join(fields[:len(fields)-1], ", ") + " and " + fields[len(fields)-1]
+}
@@ -0,0 +1,126 @@
+package history
+
+import "strings"
+
+// Op is the kind of a word diff segment.
+type Op int
+
+const (
+	Equal Op = iota
+	Insert
+	Delete
+)
+
+// Segment is a run of text that was kept, inserted or deleted.
+type Segment struct {
+	Op   Op
+	Text string
+}
+
+// maxDiffCells bounds the LCS table WordDiff builds. When the changed middle
+// of two texts needs more, it is shown deleted and inserted whole.
+const maxDiffCells = 1 << 18
+
+// WordDiff compares two texts word by word and returns the merged segments.
+// Runs of whitespace are compared as tokens of their own.
+func WordDiff(old, new string) []Segment {
+	a, b := splitWords(old), splitWords(new)
+
+	var segments []Segment
+	push := func(op Op, text string) {
+		if n := len(segments); n > 0 && segments[n-1].Op == op {
+			segments[n-1].Text += text
+			return
+		}
+		segments = append(segments, Segment{Op: op, Text: text})
+	}
+
+	// Only the middle between the common ends needs comparing
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		push(Equal, a[prefix])
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	tail := a[len(a)-suffix:]
+	a, b = a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	if len(a)*len(b) <= maxDiffCells {
+		diffMiddle(a, b, push)
+	} else {
+		for _, word := range a {
+			push(Delete, word)
+		}
+		for _, word := range b {
+			push(Insert, word)
+		}
+	}
+
+	for _, word := range tail {
+		push(Equal, word)
+	}
+	return segments
+}
+
+// diffMiddle pushes the segments turning a into b, from their longest common
+// subsequence.
+func diffMiddle(a, b []string, push func(Op, string)) {
+	// Longest common subsequence table, filled from the end
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			push(Equal, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			push(Delete, a[i])
+			i++
+		default:
+			push(Insert, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		push(Delete, a[i])
+	}
+	for ; j < len(b); j++ {
+		push(Insert, b[j])
+	}
+}
+
+// splitWords splits text into alternating word and whitespace tokens.
+func splitWords(text string) []string {
+	var words []string
+	start := 0
+	inSpace := false
+	for i, r := range text {
+		isSpace := strings.ContainsRune(" \t\n\r", r)
+		if i > start && isSpace != inSpace {
+			words = append(words, text[start:i])
+			start = i
+		}
+		inSpace = isSpace
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
@@ -0,0 +1,62 @@
+package history
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWordDiff(t *testing.T) {
+	got := WordDiff("fix the login redirect", "fix the signup redirect now")
+	want := []Segment{
+		{Op: Equal, Text: "fix the "},
+		{Op: Delete, Text: "login"},
+		{Op: Insert, Text: "signup"},
+		{Op: Equal, Text: " redirect"},
+		{Op: Insert, Text: " now"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d segments %+v, want %+v", len(got), got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("segment %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDiffIgnoresTagOrder(t *testing.T) {
+	before := Snapshot{Title: "a", Tags: []string{"type:bug", "priority:high"}}
+	after := Snapshot{Title: "b", Tags: []string{"priority:high", "type:bug"}}
+
+	changes := Diff(before, after)
+	if len(changes) != 1 || changes[0].Field != "title" {
+		t.Fatalf("Diff() = %+v, want only a title change", changes)
+	}
+	if got := Summary(changes); got != "updated title" {
+		t.Errorf("Summary() = %q", got)
+	}
+}
+
+func TestWordDiffLongTexts(t *testing.T) {
+	words := func(word string, n int) string { return strings.TrimSpace(strings.Repeat(word+" ", n)) }
+	intro, outro := words("intro", 2000), words("outro", 2000)
+
+	// Shared ends are kept even when the middle is too big to compare
+	old := intro + " " + words("old", 1000) + " " + outro
+	new := intro + " " + words("new", 1000) + " " + outro
+	want := []Segment{
+		{Op: Equal, Text: intro + " "},
+		{Op: Delete, Text: words("old", 1000)},
+		{Op: Insert, Text: words("new", 1000)},
+		{Op: Equal, Text: " " + outro},
+	}
+	got := WordDiff(old, new)
+	if len(got) != len(want) {
+		t.Fatalf("got %d segments, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("segment %d = %v %.20q…, want %v %.20q…", i, got[i].Op, got[i].Text, want[i].Op, want[i].Text)
+		}
+	}
+}
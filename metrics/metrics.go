@@ -0,0 +1,290 @@
+// Package metrics turns task history into flow metrics: time spent in each
+// column, lead time, cycle time and weekly throughput.
+package metrics
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/history"
+)
+
+// Move is a task entering a column.
+type Move struct {
+	Column string
+	At     time.Time
+}
+
+// Timeline is the columns a task has been in, oldest first. The first move is
+// the column it was created in.
+type Timeline []Move
+
+// Entry is the part of a history entry that can move a task.
+type Entry struct {
+	At      time.Time
+	Action  string
+	Details string
+	Changes []history.Change
+}
+
+// ParseMove reads the "moved from X to Y" details of a move entry.
+func ParseMove(details string) (from, to string, ok bool) {
+	rest, ok := strings.CutPrefix(details, "moved from ")
+	if !ok {
+		return "", "", false
+	}
+	from, to, ok = strings.Cut(rest, " to ")
+	if !ok || from == "" || to == "" || strings.Contains(to, " ") {
+		return "", "", false
+	}
+	return from, to, true
+}
+
+// columnChange finds the column an entry moved a task between. Structured
+// changes win; older move entries only say it in their details.
+func columnChange(e Entry) (from, to string, ok bool) {
+	for _, c := range e.Changes {
+		if c.Field == "column" {
+			return c.Old, c.New, c.Old != "" && c.New != ""
+		}
+	}
+	if e.Action == "moved" {
+		return ParseMove(e.Details)
+	}
+	return "", "", false
+}
+
+// Build reconstructs a task's timeline from its history entries, oldest
+// first. current is the task's column now; it is where the task started when
+// the history never moved it.
+func Build(created time.Time, current string, entries []Entry) Timeline {
+	var (
+		start string
+		moves Timeline
+	)
+	for _, e := range entries {
+		from, to, ok := columnChange(e)
+		if !ok || from == to {
+			continue
+		}
+		if start == "" {
+			start = from
+		}
+		if n := len(moves); n > 0 && moves[n-1].Column == to {
+			continue
+		}
+		if len(moves) == 0 && to == start {
+			continue
+		}
+		moves = append(moves, Move{Column: to, At: e.At})
+	}
+	if start == "" {
+		start = current
+	}
+	return append(Timeline{{Column: start, At: created}}, moves...)
+}
+
+// ColumnAt returns the column the task was in at t, or false before it was
+// created.
+func (tl Timeline) ColumnAt(t time.Time) (string, bool) {
+	column, ok := "", false
+	for _, m := range tl {
+		if m.At.After(t) {
+			break
+		}
+		column, ok = m.Column, true
+	}
+	return column, ok
+}
+
+// TimeInColumns adds up how long the task spent in each column up to until.
+func (tl Timeline) TimeInColumns(until time.Time) map[string]time.Duration {
+	spent := make(map[string]time.Duration)
+	for i, m := range tl {
+		end := until
+		if i+1 < len(tl) && tl[i+1].At.Before(until) {
+			end = tl[i+1].At
+		}
+		if end.After(m.At) {
+			spent[m.Column] += end.Sub(m.At)
+		}
+	}
+	return spent
+}
+
+// Completed returns when the task last entered done, if it is still there.
+func (tl Timeline) Completed(done string) (time.Time, bool) {
+	if len(tl) == 0 || tl[len(tl)-1].Column != done {
+		return time.Time{}, false
+	}
+	return tl[len(tl)-1].At, true
+}
+
+// LeadTime is the time from creation until the task was completed.
+func (tl Timeline) LeadTime(done string) (time.Duration, bool) {
+	completed, ok := tl.Completed(done)
+	if !ok {
+		return 0, false
+	}
+	return completed.Sub(tl[0].At), true
+}
+
+// CycleTime is the time from the task first entering start until it was
+// completed. Tasks that skipped start have no cycle time.
+func (tl Timeline) CycleTime(start, done string) (time.Duration, bool) {
+	completed, ok := tl.Completed(done)
+	if !ok {
+		return 0, false
+	}
+	for _, m := range tl {
+		if m.Column == start {
+			return completed.Sub(m.At), true
+		}
+	}
+	return 0, false
+}
+
+// Summary describes a set of durations by their percentiles.
+type Summary struct {
+	Count int
+	P50   time.Duration
+	P85   time.Duration
+	P95   time.Duration
+}
+
+// Summarize computes the percentiles of ds.
+func Summarize(ds []time.Duration) Summary {
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return Summary{
+		Count: len(sorted),
+		P50:   Percentile(sorted, 50),
+		P85:   Percentile(sorted, 85),
+		P95:   Percentile(sorted, 95),
+	}
+}
+
+// Percentile returns the nearest-rank p-th percentile of sorted durations, or
+// zero when there are none.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	rank = max(1, min(rank, len(sorted)))
+	return sorted[rank-1]
+}
+
+// Week is the number of tasks completed in the week starting Start.
+type Week struct {
+	Start time.Time
+	Count int
+}
+
+// WeekStart returns midnight on the Monday of t's week.
+func WeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// Throughput counts completions per week for every week overlapping
+// [since, until), including weeks with none.
+func Throughput(completed []time.Time, since, until time.Time) []Week {
+	var weeks []Week
+	for start := WeekStart(since); start.Before(until); start = start.AddDate(0, 0, 7) {
+		weeks = append(weeks, Week{Start: start})
+	}
+	for _, t := range completed {
+		if t.Before(since) || !t.Before(until) {
+			continue
+		}
+		for i := range weeks {
+			if !t.Before(weeks[i].Start) && t.Before(weeks[i].Start.AddDate(0, 0, 7)) {
+				weeks[i].Count++
+				break
+			}
+		}
+	}
+	return weeks
+}
+
+// Task is a task and its timeline.
+type Task struct {
+	ID       int
+	Title    string
+	Assignee string
+	Timeline Timeline
+	States   []State // archived and trashed periods, oldest first
+}
+
+// Options says which columns start and finish work and which completions
+// count.
+type Options struct {
+	Start string // cycle time starts when a task first enters this column
+	Done  string
+	Since time.Time
+	Until time.Time
+}
+
+// Flow is the timing of one completed task.
+type Flow struct {
+	Task
+	Completed time.Time
+	Lead      time.Duration
+	Cycle     time.Duration
+	Started   bool // false when the task skipped the start column
+	InColumn  map[string]time.Duration
+}
+
+// Report summarises the tasks completed in a date range.
+type Report struct {
+	Tasks      []Flow // most recently completed first
+	Lead       Summary
+	Cycle      Summary
+	InColumn   map[string]Summary
+	Throughput []Week
+}
+
+// Analyze reports on the tasks completed between opts.Since and opts.Until.
+func Analyze(tasks []Task, opts Options) Report {
+	var (
+		report    Report
+		lead      []time.Duration
+		cycle     []time.Duration
+		completed []time.Time
+		inColumn  = make(map[string][]time.Duration)
+	)
+	for _, t := range tasks {
+		done, ok := t.Timeline.Completed(opts.Done)
+		if !ok || done.Before(opts.Since) || !done.Before(opts.Until) {
+			continue
+		}
+		f := Flow{Task: t, Completed: done, InColumn: t.Timeline.TimeInColumns(done)}
+		f.Lead, _ = t.Timeline.LeadTime(opts.Done)
+		f.Cycle, f.Started = t.Timeline.CycleTime(opts.Start, opts.Done)
+
+		report.Tasks = append(report.Tasks, f)
+		completed = append(completed, done)
+		lead = append(lead, f.Lead)
+		if f.Started {
+			cycle = append(cycle, f.Cycle)
+		}
+		for column, d := range f.InColumn {
+			inColumn[column] = append(inColumn[column], d)
+		}
+	}
+
+	sort.SliceStable(report.Tasks, func(i, j int) bool {
+		return report.Tasks[i].Completed.After(report.Tasks[j].Completed)
+	})
+	report.Lead = Summarize(lead)
+	report.Cycle = Summarize(cycle)
+	report.InColumn = make(map[string]Summary, len(inColumn))
+	for column, ds := range inColumn {
+		report.InColumn[column] = Summarize(ds)
+	}
+	report.Throughput = Throughput(completed, opts.Since, opts.Until)
+	return report
+}
@@ -0,0 +1,148 @@
+package metrics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/history"
+)
+
+var t0 = time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC) // a Monday
+
+func at(hours int) time.Time { return t0.Add(time.Duration(hours) * time.Hour) }
+
+func TestParseMove(t *testing.T) {
+	cases := map[string][3]string{
+		"moved from backlog to in_progress": {"backlog", "in_progress", "ok"},
+		"moved from review to done":         {"review", "done", "ok"},
+		"moved to trash from review":        {},
+		"moved from backlog":                {},
+		"created in backlog":                {},
+	}
+	for details, want := range cases {
+		from, to, ok := ParseMove(details)
+		if from != want[0] || to != want[1] || ok != (want[2] == "ok") {
+			t.Errorf("ParseMove(%q) = %q, %q, %v", details, from, to, ok)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	entries := []Entry{
+		{At: at(1), Action: "updated", Details: "updated title"},
+		{At: at(2), Action: "moved", Details: "moved from backlog to in_progress"},
+		{At: at(3), Action: "moved", Details: "moved from in_progress to in_progress"},
+		{At: at(5), Action: "updated", Changes: []history.Change{{Field: "column", Old: "in_progress", New: "review"}}},
+		{At: at(8), Action: "undone", Changes: []history.Change{{Field: "column", Old: "review", New: "done"}}},
+	}
+	got := Build(t0, "done", entries)
+	want := Timeline{
+		{Column: "backlog", At: t0},
+		{Column: "in_progress", At: at(2)},
+		{Column: "review", At: at(5)},
+		{Column: "done", At: at(8)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Build = %+v, want %+v", got, want)
+	}
+
+	if got := Build(t0, "review", nil); !reflect.DeepEqual(got, Timeline{{Column: "review", At: t0}}) {
+		t.Errorf("Build without moves = %+v", got)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	tl := Timeline{
+		{Column: "backlog", At: t0},
+		{Column: "in_progress", At: at(10)},
+		{Column: "review", At: at(30)},
+		{Column: "in_progress", At: at(34)},
+		{Column: "done", At: at(40)},
+	}
+
+	if c, ok := tl.ColumnAt(at(31)); !ok || c != "review" {
+		t.Errorf("ColumnAt(31h) = %q, %v", c, ok)
+	}
+	if _, ok := tl.ColumnAt(t0.Add(-time.Hour)); ok {
+		t.Error("ColumnAt before creation should be false")
+	}
+
+	spent := tl.TimeInColumns(at(40))
+	want := map[string]time.Duration{"backlog": 10 * time.Hour, "in_progress": 26 * time.Hour, "review": 4 * time.Hour}
+	if !reflect.DeepEqual(spent, want) {
+		t.Errorf("TimeInColumns = %v, want %v", spent, want)
+	}
+
+	if d, ok := tl.LeadTime("done"); !ok || d != 40*time.Hour {
+		t.Errorf("LeadTime = %v, %v", d, ok)
+	}
+	if d, ok := tl.CycleTime("in_progress", "done"); !ok || d != 30*time.Hour {
+		t.Errorf("CycleTime = %v, %v", d, ok)
+	}
+
+	reopened := append(tl[:len(tl):len(tl)], Move{Column: "review", At: at(50)})
+	if _, ok := reopened.LeadTime("done"); ok {
+		t.Error("a task moved back out of done is not complete")
+	}
+	skipped := Timeline{{Column: "backlog", At: t0}, {Column: "done", At: at(1)}}
+	if _, ok := skipped.CycleTime("in_progress", "done"); ok {
+		t.Error("a task that skipped in_progress has no cycle time")
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	var ds []time.Duration
+	for i := 20; i >= 1; i-- {
+		ds = append(ds, time.Duration(i)*time.Hour)
+	}
+	got := Summarize(ds)
+	want := Summary{Count: 20, P50: 10 * time.Hour, P85: 17 * time.Hour, P95: 19 * time.Hour}
+	if got != want {
+		t.Errorf("Summarize = %+v, want %+v", got, want)
+	}
+	if got := Summarize(nil); got != (Summary{}) {
+		t.Errorf("Summarize(nil) = %+v", got)
+	}
+}
+
+func TestThroughput(t *testing.T) {
+	since := t0.AddDate(0, 0, 2) // Wednesday
+	until := WeekStart(t0).AddDate(0, 0, 21)
+	completed := []time.Time{t0, since, at(24 * 8), at(24 * 9), at(24 * 20)}
+	got := Throughput(completed, since, until)
+	want := []Week{
+		{Start: WeekStart(t0), Count: 1},
+		{Start: WeekStart(t0).AddDate(0, 0, 7), Count: 2},
+		{Start: WeekStart(t0).AddDate(0, 0, 14), Count: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Throughput = %+v, want %+v", got, want)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Timeline: Timeline{{"backlog", t0}, {"in_progress", at(2)}, {"done", at(10)}}},
+		{ID: 2, Timeline: Timeline{{"backlog", t0}, {"done", at(20)}}},
+		{ID: 3, Timeline: Timeline{{"backlog", t0}, {"in_progress", at(1)}}},
+		{ID: 4, Timeline: Timeline{{"backlog", t0}, {"done", at(24 * 30)}}},
+	}
+	r := Analyze(tasks, Options{Start: "in_progress", Done: "done", Since: t0, Until: WeekStart(t0).AddDate(0, 0, 7)})
+
+	if len(r.Tasks) != 2 || r.Tasks[0].ID != 2 || r.Tasks[1].ID != 1 {
+		t.Fatalf("Tasks = %+v", r.Tasks)
+	}
+	if r.Lead.Count != 2 || r.Lead.P95 != 20*time.Hour {
+		t.Errorf("Lead = %+v", r.Lead)
+	}
+	if r.Cycle.Count != 1 || r.Cycle.P50 != 8*time.Hour {
+		t.Errorf("Cycle = %+v", r.Cycle)
+	}
+	if s := r.InColumn["backlog"]; s.Count != 2 || s.P50 != 2*time.Hour {
+		t.Errorf("InColumn[backlog] = %+v", s)
+	}
+	if len(r.Throughput) != 1 || r.Throughput[0].Count != 2 {
+		t.Errorf("Throughput = %+v", r.Throughput)
+	}
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// State is whether a task was archived or in the trash from At on.
+type State struct {
+	At       time.Time
+	Archived bool
+	Deleted  bool
+}
+
+// BuildStates reads when a task was archived, trashed and brought back from
+// its history entries, oldest first. Undo and redo entries are read from the
+// label of the action they reverse, e.g. `undone: Deleted "Fix login"`.
+func BuildStates(entries []Entry) []State {
+	var (
+		states []State
+		cur    State
+	)
+	for _, e := range entries {
+		next := cur
+		switch e.Action {
+		case "archived":
+			next.Archived = true
+		case "unarchived":
+			next.Archived = false
+		case "deleted":
+			next.Deleted = true
+		case "restored":
+			if strings.HasPrefix(e.Details, "restored from trash") {
+				next.Deleted = false
+			}
+		case "undone", "redone":
+			undo := e.Action == "undone"
+			_, label, _ := strings.Cut(e.Details, ": ")
+			switch {
+			case strings.HasPrefix(label, "Archived "):
+				next.Archived = !undo
+			case strings.HasPrefix(label, "Deleted "):
+				next.Deleted = !undo
+			case strings.HasPrefix(label, "Created "):
+				// Undoing a create moves the task to the trash
+				next.Deleted = undo
+			}
+		}
+		if next != cur {
+			next.At = e.At
+			states = append(states, next)
+			cur = next
+		}
+	}
+	return states
+}
+
+// At returns the task's column and state at t; ok is false before the task
+// was created.
+func (t Task) At(at time.Time) (column string, state State, ok bool) {
+	column, ok = t.Timeline.ColumnAt(at)
+	if !ok {
+		return "", State{}, false
+	}
+	for _, s := range t.States {
+		if s.At.After(at) {
+			break
+		}
+		state = s
+	}
+	return column, state, true
+}
+
+// BoardAt returns the tasks that were on the board at t, by column, in ID
+// order. Archived and trashed tasks are left out, as the board did then.
+func BoardAt(tasks []Task, at time.Time) map[string][]Task {
+	board := make(map[string][]Task)
+	for _, t := range tasks {
+		column, state, ok := t.At(at)
+		if !ok || state.Archived || state.Deleted {
+			continue
+		}
+		board[column] = append(board[column], t)
+	}
+	for _, column := range board {
+		sort.Slice(column, func(i, j int) bool { return column[i].ID < column[j].ID })
+	}
+	return board
+}
+
+// Day is the number of tasks in each column at the end of a day.
+type Day struct {
+	Date   time.Time
+	Counts map[string]int
+}
+
+// CumulativeFlow counts the tasks in each column at the end of every day from
+// since up to until. Archived tasks still count in the column they finished
+// in, so done only ever grows; trashed tasks don't count.
+func CumulativeFlow(tasks []Task, since, until time.Time) []Day {
+	var days []Day
+	start := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, since.Location())
+	for day := start; day.Before(until); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		counts := make(map[string]int)
+		for _, t := range tasks {
+			column, state, ok := t.At(end)
+			if ok && !state.Deleted {
+				counts[column]++
+			}
+		}
+		days = append(days, Day{Date: day, Counts: counts})
+	}
+	return days
+}
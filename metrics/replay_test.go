@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildStates(t *testing.T) {
+	entries := []Entry{
+		{At: at(1), Action: "moved", Details: "moved from backlog to done"},
+		{At: at(2), Action: "archived", Details: "archived from done"},
+		{At: at(3), Action: "undone", Details: `undone: Archived "Fix login"`},
+		{At: at(4), Action: "deleted", Details: "moved to trash from done"},
+		{At: at(5), Action: "restored", Details: "restored revision 2"},
+		{At: at(6), Action: "restored", Details: "restored from trash to done"},
+		{At: at(7), Action: "redone", Details: `redone: Deleted "Fix login"`},
+	}
+	got := BuildStates(entries)
+	want := []State{
+		{At: at(2), Archived: true},
+		{At: at(3)},
+		{At: at(4), Deleted: true},
+		{At: at(6)},
+		{At: at(7), Deleted: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildStates = %+v, want %+v", got, want)
+	}
+}
+
+func TestBoardAt(t *testing.T) {
+	tasks := []Task{
+		{ID: 2, Timeline: Timeline{{"backlog", t0}, {"review", at(5)}}},
+		{ID: 1, Timeline: Timeline{{"backlog", t0}}},
+		{ID: 3, Timeline: Timeline{{"done", t0}}, States: []State{{At: at(2), Archived: true}}},
+		{ID: 4, Timeline: Timeline{{"backlog", at(10)}}},
+	}
+
+	board := BoardAt(tasks, at(1))
+	if ids(board["backlog"]) != "1,2" || ids(board["done"]) != "3" || len(board["review"]) != 0 {
+		t.Errorf("BoardAt(1h) = %v", board)
+	}
+	board = BoardAt(tasks, at(6))
+	if ids(board["backlog"]) != "1" || ids(board["review"]) != "2" || len(board["done"]) != 0 {
+		t.Errorf("BoardAt(6h) = %v", board)
+	}
+}
+
+func ids(tasks []Task) string {
+	s := make([]string, len(tasks))
+	for i, t := range tasks {
+		s[i] = strconv.Itoa(t.ID)
+	}
+	return strings.Join(s, ",")
+}
+
+func TestCumulativeFlow(t *testing.T) {
+	day := 24 * time.Hour
+	tasks := []Task{
+		{ID: 1, Timeline: Timeline{{"backlog", t0}, {"done", t0.Add(day)}}, States: []State{{At: t0.Add(2 * day), Archived: true}}},
+		{ID: 2, Timeline: Timeline{{"backlog", t0.Add(day)}}, States: []State{{At: t0.Add(2 * day), Deleted: true}}},
+	}
+	got := CumulativeFlow(tasks, t0, WeekStart(t0).Add(3*day))
+	want := []Day{
+		{Date: WeekStart(t0), Counts: map[string]int{"backlog": 1}},
+		{Date: WeekStart(t0).Add(day), Counts: map[string]int{"done": 1, "backlog": 1}},
+		{Date: WeekStart(t0).Add(2 * day), Counts: map[string]int{"done": 1}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CumulativeFlow = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"sort"
+	"time"
+)
+
+// Workload is one assignee's current load and recent output.
+type Workload struct {
+	Assignee   string
+	WIP        map[string]int // tasks on the board in each column now
+	Completed  []Week
+	CycleTime  time.Duration // mean over the tasks completed in range
+	Cycles     int           // completed tasks with a cycle time
+	Reopened   int           // times one of their tasks left done again
+	LastActive time.Time     // zero when they have never changed anything
+	Idle       time.Duration // since LastActive; zero when never active
+	Alert      bool          // idle too long while holding tasks in the start column
+}
+
+// Total is the number of tasks the assignee holds on the board.
+func (w Workload) Total() int {
+	total := 0
+	for _, n := range w.WIP {
+		total += n
+	}
+	return total
+}
+
+// WorkloadOptions extends Options with when to raise an idle alert.
+type WorkloadOptions struct {
+	Options
+	Now       time.Time
+	IdleAfter time.Duration // zero disables alerts
+}
+
+// Reopens counts the times a timeline moved out of done.
+func (tl Timeline) Reopens(done string) int {
+	n := 0
+	for i := 1; i < len(tl); i++ {
+		if tl[i-1].Column == done && tl[i].Column != done {
+			n++
+		}
+	}
+	return n
+}
+
+// Workloads reports on each assignee, in order. Tasks are counted for their
+// current assignee; lastActive is when each assignee last made a change.
+func Workloads(assignees []string, tasks []Task, lastActive map[string]time.Time, opts WorkloadOptions) []Workload {
+	byAssignee := make(map[string][]Task)
+	for _, t := range tasks {
+		byAssignee[t.Assignee] = append(byAssignee[t.Assignee], t)
+	}
+
+	workloads := make([]Workload, len(assignees))
+	for i, assignee := range assignees {
+		w := Workload{Assignee: assignee, WIP: make(map[string]int), LastActive: lastActive[assignee]}
+		var (
+			completed []time.Time
+			cycle     time.Duration
+		)
+		for _, t := range byAssignee[assignee] {
+			w.Reopened += t.Timeline.Reopens(opts.Done)
+			if column, state, ok := t.At(opts.Now); ok && !state.Archived && !state.Deleted {
+				w.WIP[column]++
+			}
+			done, ok := t.Timeline.Completed(opts.Done)
+			if !ok || done.Before(opts.Since) || !done.Before(opts.Until) {
+				continue
+			}
+			completed = append(completed, done)
+			if d, ok := t.Timeline.CycleTime(opts.Start, opts.Done); ok {
+				cycle += d
+				w.Cycles++
+			}
+		}
+		w.Completed = Throughput(completed, opts.Since, opts.Until)
+		if w.Cycles > 0 {
+			w.CycleTime = cycle / time.Duration(w.Cycles)
+		}
+		if !w.LastActive.IsZero() {
+			w.Idle = opts.Now.Sub(w.LastActive)
+		}
+		idle := w.LastActive.IsZero() || w.Idle > opts.IdleAfter
+		w.Alert = opts.IdleAfter > 0 && w.WIP[opts.Start] > 0 && idle
+		workloads[i] = w
+	}
+	return workloads
+}
+
+// Assignees merges the known assignees with everyone holding a task, sorted
+// and without the unassigned "".
+func Assignees(known []string, tasks []Task) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, name := range known {
+		add(name)
+	}
+	for _, t := range tasks {
+		add(t.Assignee)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReopens(t *testing.T) {
+	tl := Timeline{{"backlog", t0}, {"done", at(1)}, {"review", at(2)}, {"done", at(3)}, {"in_progress", at(4)}}
+	if n := tl.Reopens("done"); n != 2 {
+		t.Errorf("Reopens = %d, want 2", n)
+	}
+}
+
+func TestWorkloads(t *testing.T) {
+	tasks := []Task{
+		{ID: 1, Assignee: "bot", Timeline: Timeline{{"backlog", t0}, {"in_progress", at(2)}, {"done", at(6)}}},
+		{ID: 2, Assignee: "bot", Timeline: Timeline{{"backlog", t0}, {"in_progress", at(1)}, {"done", at(3)}, {"in_progress", at(5)}}},
+		{ID: 3, Assignee: "bot", Timeline: Timeline{{"backlog", t0}}, States: []State{{At: at(1), Deleted: true}}},
+		{ID: 4, Assignee: "peter", Timeline: Timeline{{"review", t0}}},
+	}
+	opts := WorkloadOptions{
+		Options:   Options{Start: "in_progress", Done: "done", Since: t0, Until: WeekStart(t0).AddDate(0, 0, 7)},
+		Now:       at(48),
+		IdleAfter: 24 * time.Hour,
+	}
+	last := map[string]time.Time{"bot": at(6), "peter": at(40)}
+
+	got := Workloads([]string{"bot", "john", "peter"}, tasks, last, opts)
+	if len(got) != 3 {
+		t.Fatalf("Workloads = %+v", got)
+	}
+
+	bot := got[0]
+	if !reflect.DeepEqual(bot.WIP, map[string]int{"done": 1, "in_progress": 1}) {
+		t.Errorf("bot WIP = %v", bot.WIP)
+	}
+	if bot.Cycles != 1 || bot.CycleTime != 4*time.Hour || bot.Completed[0].Count != 1 {
+		t.Errorf("bot cycle = %v over %d, completed %+v", bot.CycleTime, bot.Cycles, bot.Completed)
+	}
+	if bot.Reopened != 1 || bot.Idle != 42*time.Hour || !bot.Alert {
+		t.Errorf("bot reopened %d, idle %v, alert %v", bot.Reopened, bot.Idle, bot.Alert)
+	}
+
+	if john := got[1]; john.Total() != 0 || john.Alert || !john.LastActive.IsZero() {
+		t.Errorf("john = %+v", john)
+	}
+	if peter := got[2]; peter.WIP["review"] != 1 || peter.Alert {
+		t.Errorf("peter = %+v", peter)
+	}
+
+	opts.IdleAfter = 0
+	if got := Workloads([]string{"bot"}, tasks, last, opts); got[0].Alert {
+		t.Error("a zero IdleAfter disables alerts")
+	}
+}
+
+func TestAssignees(t *testing.T) {
+	tasks := []Task{{Assignee: "zed"}, {Assignee: ""}, {Assignee: "john"}}
+	if got := Assignees([]string{"peter", "john"}, tasks); !reflect.DeepEqual(got, []string{"john", "peter", "zed"}) {
+		t.Errorf("Assignees = %v", got)
+	}
+}
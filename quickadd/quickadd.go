@@ -0,0 +1,211 @@
+// Package quickadd parses one-line task descriptions such as
+//
+//	Fix login redirect @john #bug priority:high >review due:friday
+//
+// into a title and task attributes. Words that aren't recognised stay in the
+// title, so plain text always works.
+package quickadd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Options describes the board the text is parsed against.
+type Options struct {
+	// Columns are the board's columns; ">rev" matches a unique prefix.
+	Columns []string
+	// Priority normalises a priority word, e.g. "critical" to "urgent".
+	Priority func(string) (string, bool)
+	// TagKeys are keys that may be written as key:value without a '#'.
+	TagKeys map[string]bool
+	// DefaultTagKey is the key for a bare "#word" tag.
+	DefaultTagKey string
+	// Now anchors relative due dates such as "tomorrow" and "friday".
+	Now time.Time
+}
+
+// Result is the parsed text.
+type Result struct {
+	Title    string
+	Assignee string
+	Column   string
+	Priority string
+	Due      *time.Time
+	Tags     []string // "key:value" pairs
+	// Ignored lists words that look like syntax but didn't match anything;
+	// they are kept in the title.
+	Ignored []string
+}
+
+// Matched reports whether anything besides the title was found.
+func (r Result) Matched() bool {
+	return r.Assignee != "" || r.Column != "" || r.Priority != "" || r.Due != nil || len(r.Tags) > 0
+}
+
+// Parse splits text into a title and attributes. A later token wins over an
+// earlier one of the same kind; tags accumulate. A leading backslash keeps a
+// word literal, e.g. "\@home".
+func Parse(text string, opts Options) Result {
+	var (
+		r     Result
+		title []string
+	)
+	for _, word := range strings.Fields(text) {
+		if escaped, ok := strings.CutPrefix(word, `\`); ok && escaped != "" {
+			title = append(title, escaped)
+			continue
+		}
+		if !r.apply(word, opts) {
+			title = append(title, word)
+		}
+	}
+	r.Title = strings.Join(title, " ")
+	return r
+}
+
+// apply records word as an attribute, returning false to keep it in the title.
+func (r *Result) apply(word string, opts Options) bool {
+	ignore := func() bool {
+		r.Ignored = append(r.Ignored, word)
+		return false
+	}
+
+	switch {
+	case len(word) > 1 && word[0] == '@':
+		r.Assignee = strings.ToLower(word[1:])
+		return true
+
+	case len(word) > 1 && word[0] == '#':
+		key, value, ok := strings.Cut(word[1:], ":")
+		if !ok {
+			if opts.DefaultTagKey == "" {
+				return ignore()
+			}
+			key, value = opts.DefaultTagKey, key
+		}
+		if key == "" || value == "" {
+			return ignore()
+		}
+		r.addTag(key + ":" + value)
+		return true
+
+	case len(word) > 1 && word[0] == '>':
+		column, ok := matchColumn(word[1:], opts.Columns)
+		if !ok {
+			return ignore()
+		}
+		r.Column = column
+		return true
+	}
+
+	key, value, ok := strings.Cut(word, ":")
+	if !ok || value == "" {
+		return false
+	}
+	switch strings.ToLower(key) {
+	case "priority", "p":
+		if opts.Priority == nil {
+			return ignore()
+		}
+		priority, ok := opts.Priority(value)
+		if !ok {
+			return ignore()
+		}
+		r.Priority = priority
+		return true
+	case "due":
+		due, err := ParseDate(value, opts.Now)
+		if err != nil {
+			return ignore()
+		}
+		r.Due = &due
+		return true
+	}
+	if opts.TagKeys[key] {
+		r.addTag(key + ":" + value)
+		return true
+	}
+	return false
+}
+
+func (r *Result) addTag(pair string) {
+	for _, t := range r.Tags {
+		if t == pair {
+			return
+		}
+	}
+	r.Tags = append(r.Tags, pair)
+}
+
+// matchColumn finds the column named by s, ignoring case, underscores and
+// dashes, or the only column it is a prefix of.
+func matchColumn(s string, columns []string) (string, bool) {
+	norm := func(v string) string {
+		return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(v))
+	}
+	want := norm(s)
+	var found []string
+	for _, c := range columns {
+		if norm(c) == want {
+			return c, true
+		}
+		if strings.HasPrefix(norm(c), want) {
+			found = append(found, c)
+		}
+	}
+	if len(found) == 1 {
+		return found[0], true
+	}
+	return "", false
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseDate reads a due date relative to now: today, tomorrow, a weekday
+// ("fri" or "friday", the next one after today), +3d or +2w, or 2026-01-31.
+// The result is local midnight of the day.
+func ParseDate(s string, now time.Time) (time.Time, error) {
+	s = strings.ToLower(s)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch s {
+	case "today":
+		return today, nil
+	case "tomorrow", "tmr":
+		return today.AddDate(0, 0, 1), nil
+	}
+	if len(s) >= 3 {
+		if wd, ok := weekdays[s[:3]]; ok && strings.HasPrefix(fullWeekday(wd), s) {
+			days := (int(wd) - int(today.Weekday()) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			return today.AddDate(0, 0, days), nil
+		}
+	}
+	if rest, ok := strings.CutPrefix(s, "+"); ok && len(rest) >= 2 {
+		n, err := strconv.Atoi(rest[:len(rest)-1])
+		if err == nil && n >= 0 {
+			switch rest[len(rest)-1] {
+			case 'd':
+				return today.AddDate(0, 0, n), nil
+			case 'w':
+				return today.AddDate(0, 0, 7*n), nil
+			}
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("unknown date %q", s)
+}
+
+func fullWeekday(wd time.Weekday) string {
+	return strings.ToLower(wd.String())
+}
@@ -0,0 +1,94 @@
+package quickadd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Monday 19 October 2026
+var now = time.Date(2026, 10, 19, 15, 30, 0, 0, time.Local)
+
+func opts() Options {
+	return Options{
+		Columns: []string{"backlog", "in_progress", "review", "done"},
+		Priority: func(s string) (string, bool) {
+			switch strings.ToLower(s) {
+			case "high", "urgent", "low":
+				return strings.ToLower(s), true
+			case "critical":
+				return "urgent", true
+			}
+			return "", false
+		},
+		TagKeys:       map[string]bool{"project": true},
+		DefaultTagKey: "type",
+		Now:           now,
+	}
+}
+
+func day(y int, m time.Month, d int) *time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	return &t
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		text string
+		want Result
+	}{
+		{
+			"Fix login redirect @john #bug priority:high >review due:friday",
+			Result{Title: "Fix login redirect", Assignee: "john", Column: "review", Priority: "high", Due: day(2026, 10, 23), Tags: []string{"type:bug"}},
+		},
+		{
+			"Plain title with no syntax",
+			Result{Title: "Plain title with no syntax"},
+		},
+		{
+			"Ship it >in p:critical project:payments #area:checkout due:+1w",
+			Result{Title: "Ship it", Column: "in_progress", Priority: "urgent", Due: day(2026, 10, 26), Tags: []string{"project:payments", "area:checkout"}},
+		},
+		{
+			"Meet at 10:30 >nowhere due:someday priority:meh",
+			Result{Title: "Meet at 10:30 >nowhere due:someday priority:meh", Ignored: []string{">nowhere", "due:someday", "priority:meh"}},
+		},
+		{
+			`Email \@support about #1 due:monday`,
+			Result{Title: "Email @support about", Due: day(2026, 10, 26), Tags: []string{"type:1"}},
+		},
+	}
+	for _, c := range cases {
+		got := Parse(c.text, opts())
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Parse(%q)\n got %+v\nwant %+v", c.text, got, c.want)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	cases := map[string]*time.Time{
+		"today":      day(2026, 10, 19),
+		"tomorrow":   day(2026, 10, 20),
+		"mon":        day(2026, 10, 26),
+		"Wednesday":  day(2026, 10, 21),
+		"+3d":        day(2026, 10, 22),
+		"2026-12-01": day(2026, 12, 1),
+		"fr":         nil,
+		"+3m":        nil,
+		"friyay":     nil,
+	}
+	for s, want := range cases {
+		got, err := ParseDate(s, now)
+		if want == nil {
+			if err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", s, got)
+			}
+			continue
+		}
+		if err != nil || !got.Equal(*want) {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", s, got, err, *want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/j0hnsmith/botTaskTracker/handlers"
+)
+
+// runReport prints a standup or weekly report and exits, for
+// `botTaskTracker report [-period weekly] [-format html] [-since ...] [-until ...]`.
+func runReport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	period := fs.String("period", "standup", "standup or weekly")
+	format := fs.String("format", "markdown", "markdown, html or json")
+	since := fs.String("since", "", "start of the window, YYYY-MM-DD or YYYY-MM-DDTHH:MM (default from the period)")
+	until := fs.String("until", "", "end of the window, inclusive for a date (default now)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Keep logs off stdout so the report can be piped
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})))
+
+	server, err := handlers.NewServer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() {
+		if cerr := server.Close(); cerr != nil {
+			slog.Error("failed to close server", "error", cerr)
+		}
+	}()
+	return server.WriteReport(ctx, os.Stdout, *period, *since, *until, *format)
+}
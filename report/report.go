@@ -0,0 +1,267 @@
+// Package report summarises what happened on the board in a time window, per
+// assignee, for standup notes and weekly summaries.
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/metrics"
+	"github.com/j0hnsmith/botTaskTracker/stale"
+)
+
+// Period is a kind of report with its own default window.
+type Period string
+
+const (
+	Standup Period = "standup" // since the same time on the previous working day
+	Weekly  Period = "weekly"  // the last seven days
+)
+
+// Periods lists the valid periods, for forms and error messages.
+var Periods = []Period{Standup, Weekly}
+
+// ParsePeriod reads a period name; empty means Standup.
+func ParsePeriod(s string) (Period, error) {
+	switch p := Period(strings.ToLower(strings.TrimSpace(s))); p {
+	case "":
+		return Standup, nil
+	case Standup, Weekly:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown report period %q: use standup or weekly", s)
+}
+
+// Title names the report.
+func (p Period) Title() string {
+	if p == Weekly {
+		return "Weekly summary"
+	}
+	return "Standup"
+}
+
+// Window returns the period's default window ending at now. A standup on
+// Monday covers the weekend back to Friday.
+func (p Period) Window(now time.Time) (since, until time.Time) {
+	if p == Weekly {
+		return now.AddDate(0, 0, -7), now
+	}
+	since = now.AddDate(0, 0, -1)
+	for since.Weekday() == time.Saturday || since.Weekday() == time.Sunday {
+		since = since.AddDate(0, 0, -1)
+	}
+	return since, now
+}
+
+// Block is a reason a task was blocked. At is zero for a standing reason,
+// such as a tag, rather than an event.
+type Block struct {
+	At     time.Time
+	Reason string
+}
+
+// Task is a task's history plus what the report can't derive from it.
+type Task struct {
+	metrics.Task
+	Blocked []Block
+	Age     stale.Age // how long it has been in its column, for open tasks
+}
+
+// Item is a task listed in a section.
+type Item struct {
+	ID    int
+	Title string
+	At    time.Time // when it happened; zero for standing states
+	Note  string
+}
+
+// Group is one assignee's sections.
+type Group struct {
+	Assignee  string // "" for unassigned tasks
+	Completed []Item
+	Review    []Item // moved into review
+	Created   []Item
+	Blocked   []Item
+	Stale     []Item
+}
+
+// Section is a named list of items in a group, in display order.
+type Section struct {
+	Title string
+	Items []Item
+}
+
+// Sections returns the group's non-empty sections.
+func (g Group) Sections() []Section {
+	var sections []Section
+	for _, s := range []Section{
+		{"Completed", g.Completed},
+		{"Moved into review", g.Review},
+		{"Newly created", g.Created},
+		{"Blocked", g.Blocked},
+		{"Stale", g.Stale},
+	} {
+		if len(s.Items) > 0 {
+			sections = append(sections, s)
+		}
+	}
+	return sections
+}
+
+// Name is the assignee, or "Unassigned".
+func (g Group) Name() string {
+	if g.Assignee == "" {
+		return "Unassigned"
+	}
+	return g.Assignee
+}
+
+// Report is a summary of the window [Since, Until).
+type Report struct {
+	Title  string
+	Since  time.Time
+	Until  time.Time
+	Groups []Group // by assignee, unassigned last
+}
+
+// Options says what to report on.
+type Options struct {
+	Title  string
+	Since  time.Time
+	Until  time.Time // exclusive
+	Done   string    // column that completes a task
+	Review string    // column that puts a task up for review
+}
+
+// Build sorts tasks into sections by what happened to them in the window.
+// Stale tasks are listed by how long they have been in their column now.
+func Build(tasks []Task, opts Options) Report {
+	in := func(t time.Time) bool { return !t.Before(opts.Since) && t.Before(opts.Until) }
+	groups := make(map[string]*Group)
+	group := func(assignee string) *Group {
+		if groups[assignee] == nil {
+			groups[assignee] = &Group{Assignee: assignee}
+		}
+		return groups[assignee]
+	}
+
+	for _, t := range tasks {
+		item := Item{ID: t.ID, Title: t.Title}
+		if len(t.Timeline) > 0 && in(t.Timeline[0].At) {
+			g := group(t.Assignee)
+			g.Created = append(g.Created, withAt(item, t.Timeline[0].At, "in "+t.Timeline[0].Column))
+		}
+		if at, ok := lastEntered(t.Timeline, opts.Review, in); ok {
+			g := group(t.Assignee)
+			g.Review = append(g.Review, withAt(item, at, ""))
+		}
+		if at, ok := lastEntered(t.Timeline, opts.Done, in); ok {
+			g := group(t.Assignee)
+			g.Completed = append(g.Completed, withAt(item, at, ""))
+		}
+		var reasons []string
+		var at time.Time
+		for _, b := range t.Blocked {
+			if !b.At.IsZero() && !in(b.At) {
+				continue
+			}
+			reasons = append(reasons, b.Reason)
+			if b.At.After(at) {
+				at = b.At
+			}
+		}
+		if len(reasons) > 0 {
+			g := group(t.Assignee)
+			g.Blocked = append(g.Blocked, withAt(item, at, strings.Join(reasons, "; ")))
+		}
+		if t.Age.Stale() {
+			g := group(t.Assignee)
+			g.Stale = append(g.Stale, withAt(item, time.Time{}, t.Age.Label()))
+		}
+	}
+
+	r := Report{Title: opts.Title, Since: opts.Since, Until: opts.Until}
+	for _, g := range groups {
+		for _, items := range [][]Item{g.Completed, g.Review, g.Created, g.Blocked} {
+			sortItems(items)
+		}
+		sort.SliceStable(g.Stale, func(i, j int) bool { return g.Stale[i].ID < g.Stale[j].ID })
+		r.Groups = append(r.Groups, *g)
+	}
+	sort.Slice(r.Groups, func(i, j int) bool {
+		a, b := r.Groups[i].Assignee, r.Groups[j].Assignee
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return a < b
+	})
+	return r
+}
+
+func withAt(item Item, at time.Time, note string) Item {
+	item.At, item.Note = at, note
+	return item
+}
+
+// lastEntered returns the last time the timeline entered column within the
+// window. The creation column doesn't count as entering it.
+func lastEntered(tl metrics.Timeline, column string, in func(time.Time) bool) (time.Time, bool) {
+	for i := len(tl) - 1; i >= 1; i-- {
+		if tl[i].Column == column && in(tl[i].At) {
+			return tl[i].At, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func sortItems(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].At.Equal(items[j].At) {
+			return items[i].At.Before(items[j].At)
+		}
+		return items[i].ID < items[j].ID
+	})
+}
+
+// Empty reports whether nothing happened in the window.
+func (r Report) Empty() bool {
+	return len(r.Groups) == 0
+}
+
+// Heading is the report's title with its window.
+func (r Report) Heading() string {
+	return r.Title + ": " + r.Since.Format("Mon 2 Jan 15:04") + " – " + r.Until.Format("Mon 2 Jan 15:04")
+}
+
+// Markdown renders the report for pasting into chat or a standup note.
+func (r Report) Markdown() string {
+	var b strings.Builder
+	b.WriteString("# " + r.Heading() + "\n")
+	if r.Empty() {
+		b.WriteString("\nNothing to report.\n")
+		return b.String()
+	}
+	for _, g := range r.Groups {
+		b.WriteString("\n## " + g.Name() + "\n")
+		for _, s := range g.Sections() {
+			b.WriteString("\n**" + s.Title + "**\n\n")
+			for _, item := range s.Items {
+				b.WriteString(fmt.Sprintf("- #%d %s", item.ID, markdownEscape(item.Title)))
+				if item.Note != "" {
+					b.WriteString(" (" + markdownEscape(item.Note) + ")")
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+
+// markdownEscape keeps task titles from being read as formatting.
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
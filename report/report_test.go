@@ -0,0 +1,111 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/j0hnsmith/botTaskTracker/metrics"
+	"github.com/j0hnsmith/botTaskTracker/stale"
+)
+
+var monday = time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+func task(id int, title, assignee string, moves ...metrics.Move) Task {
+	return Task{Task: metrics.Task{ID: id, Title: title, Assignee: assignee, Timeline: moves}}
+}
+
+func TestWindow(t *testing.T) {
+	since, until := Standup.Window(monday)
+	if want := monday.AddDate(0, 0, -3); !since.Equal(want) || !until.Equal(monday) {
+		t.Errorf("Monday standup = %v – %v, want from %v", since, until, want)
+	}
+	since, _ = Standup.Window(monday.AddDate(0, 0, 2))
+	if want := monday.AddDate(0, 0, 1); !since.Equal(want) {
+		t.Errorf("Wednesday standup from %v, want %v", since, want)
+	}
+	since, _ = Weekly.Window(monday)
+	if want := monday.AddDate(0, 0, -7); !since.Equal(want) {
+		t.Errorf("weekly from %v, want %v", since, want)
+	}
+	if _, err := ParsePeriod("monthly"); err == nil {
+		t.Error("ParsePeriod(monthly) succeeded")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	since, until := monday.AddDate(0, 0, -1), monday
+	before := since.Add(-time.Hour)
+	during := since.Add(time.Hour)
+
+	blocked := task(4, "Deploy", "bob", metrics.Move{Column: "in_progress", At: before})
+	blocked.Blocked = []Block{
+		{At: during, Reason: "WIP limit reached"},
+		{At: before, Reason: "old block"},
+		{Reason: "tagged blocked:infra"},
+	}
+	old := task(5, "Docs", "", metrics.Move{Column: "review", At: before})
+	old.Age = stale.Thresholds{"review": 2 * stale.Day}.Age("review", monday.AddDate(0, 0, -3), monday)
+
+	r := Build([]Task{
+		task(1, "Login", "alice",
+			metrics.Move{Column: "backlog", At: before},
+			metrics.Move{Column: "review", At: during},
+			metrics.Move{Column: "done", At: during.Add(time.Hour)}),
+		task(2, "New thing", "alice", metrics.Move{Column: "backlog", At: during}),
+		task(3, "Untouched", "alice", metrics.Move{Column: "backlog", At: before}),
+		task(6, "Created in review", "bob", metrics.Move{Column: "review", At: during}),
+		blocked,
+		old,
+	}, Options{Title: "Standup", Since: since, Until: until, Done: "done", Review: "review"})
+
+	if len(r.Groups) != 3 || r.Groups[0].Assignee != "alice" || r.Groups[1].Assignee != "bob" || r.Groups[2].Name() != "Unassigned" {
+		t.Fatalf("groups = %+v", r.Groups)
+	}
+	alice, bob, none := r.Groups[0], r.Groups[1], r.Groups[2]
+	if len(alice.Completed) != 1 || len(alice.Review) != 1 || len(alice.Created) != 1 || alice.Created[0].ID != 2 {
+		t.Errorf("alice = %+v", alice)
+	}
+	if len(bob.Review) != 0 || len(bob.Created) != 1 {
+		t.Errorf("creating in review isn't a move into it: %+v", bob)
+	}
+	if len(bob.Blocked) != 1 || bob.Blocked[0].Note != "WIP limit reached; tagged blocked:infra" {
+		t.Errorf("bob blocked = %+v", bob.Blocked)
+	}
+	if len(none.Stale) != 1 || none.Stale[0].Note != "3d in review" {
+		t.Errorf("stale = %+v", none.Stale)
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	r := Report{
+		Title: "Standup",
+		Since: monday.AddDate(0, 0, -1),
+		Until: monday,
+		Groups: []Group{{
+			Assignee:  "alice",
+			Completed: []Item{{ID: 1, Title: "Fix *login*"}},
+			Stale:     []Item{{ID: 2, Title: "Docs", Note: "3d in review"}},
+		}},
+	}
+	want := `# Standup: Sun 18 Oct 09:00 – Mon 19 Oct 09:00
+
+## alice
+
+**Completed**
+
+- #1 Fix \*login\*
+
+**Stale**
+
+- #2 Docs (3d in review)
+`
+	if got := r.Markdown(); got != want {
+		t.Errorf("Markdown =\n%s\nwant\n%s", got, want)
+	}
+
+	r.Groups = nil
+	if got := r.Markdown(); !strings.HasSuffix(got, "\nNothing to report.\n") {
+		t.Errorf("empty Markdown = %q", got)
+	}
+}
@@ -0,0 +1,195 @@
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// rrule supports the parts of RFC 5545 recurrence rules that fit a task
+// board: FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, BYDAY, BYMONTHDAY,
+// BYHOUR, BYMINUTE and UNTIL. The time of day defaults to start's.
+type rrule struct {
+	freq       string
+	interval   int
+	byDay      map[time.Weekday]bool
+	byMonthDay map[int]bool
+	hours      []int
+	minutes    []int
+	until      time.Time
+	start      time.Time
+}
+
+func parseRRule(rule string, start time.Time) (*rrule, error) {
+	r := &rrule{interval: 1, start: start.Truncate(time.Minute)}
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("RRULE part %q should be KEY=VALUE", part)
+		}
+		var err error
+		switch key {
+		case "FREQ":
+			if value != "DAILY" && value != "WEEKLY" && value != "MONTHLY" {
+				return nil, fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY")
+			}
+			r.freq = value
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(value); err != nil || r.interval < 1 {
+				return nil, fmt.Errorf("INTERVAL must be a positive number")
+			}
+		case "BYDAY":
+			r.byDay = make(map[time.Weekday]bool)
+			for _, day := range strings.Split(value, ",") {
+				wd, ok := rruleWeekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("BYDAY %q should be one of MO, TU, WE, TH, FR, SA, SU", day)
+				}
+				r.byDay[wd] = true
+			}
+		case "BYMONTHDAY":
+			days, err := rruleInts(key, value, 1, 31)
+			if err != nil {
+				return nil, err
+			}
+			r.byMonthDay = make(map[int]bool)
+			for _, d := range days {
+				r.byMonthDay[d] = true
+			}
+		case "BYHOUR":
+			if r.hours, err = rruleInts(key, value, 0, 23); err != nil {
+				return nil, err
+			}
+		case "BYMINUTE":
+			if r.minutes, err = rruleInts(key, value, 0, 59); err != nil {
+				return nil, err
+			}
+		case "UNTIL":
+			if r.until, err = parseRRuleTime(value); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("RRULE part %s isn't supported", key)
+		}
+	}
+	if r.freq == "" {
+		return nil, fmt.Errorf("RRULE needs a FREQ")
+	}
+	if r.hours == nil {
+		r.hours = []int{r.start.Hour()}
+	}
+	if r.minutes == nil {
+		r.minutes = []int{r.start.Minute()}
+	}
+	return r, nil
+}
+
+func rruleInts(key, value string, min, max int) ([]int, error) {
+	var out []int
+	for _, s := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(s)
+		if err != nil || v < min || v > max {
+			return nil, fmt.Errorf("%s values must be between %d and %d", key, min, max)
+		}
+		out = append(out, v)
+	}
+	sort.Ints(out)
+	return out, nil
+}
+
+func parseRRuleTime(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		loc := time.Local
+		if strings.HasSuffix(layout, "Z") {
+			loc = time.UTC
+		}
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			if layout == "20060102" {
+				t = t.AddDate(0, 0, 1).Add(-time.Second) // the whole day is included
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("UNTIL %q should look like 20260131 or 20260131T090000Z", value)
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// dayMatches reports whether occurrences fall on day: it must be in an
+// INTERVAL-th period counted from start and pass the BY filters.
+func (r *rrule) dayMatches(day time.Time) bool {
+	first := midnight(r.start)
+	switch r.freq {
+	case "DAILY":
+		days := int(day.Sub(first).Hours()+12) / 24
+		if days%r.interval != 0 {
+			return false
+		}
+		return r.byDay == nil || r.byDay[day.Weekday()]
+	case "WEEKLY":
+		// Weeks start on Monday, as RFC 5545's default WKST
+		weekStart := func(t time.Time) time.Time {
+			return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+		}
+		weeks := int(weekStart(day).Sub(weekStart(first)).Hours()+12) / (24 * 7)
+		if weeks%r.interval != 0 {
+			return false
+		}
+		if r.byDay == nil {
+			return day.Weekday() == r.start.Weekday()
+		}
+		return r.byDay[day.Weekday()]
+	default: // MONTHLY
+		months := (day.Year()-first.Year())*12 + int(day.Month()-first.Month())
+		if months%r.interval != 0 {
+			return false
+		}
+		switch {
+		case r.byMonthDay != nil:
+			return r.byMonthDay[day.Day()] && (r.byDay == nil || r.byDay[day.Weekday()])
+		case r.byDay != nil:
+			return r.byDay[day.Weekday()]
+		default:
+			return day.Day() == r.start.Day()
+		}
+	}
+}
+
+func (r *rrule) Next(after time.Time) time.Time {
+	from := after
+	if from.Before(r.start) {
+		from = r.start.Add(-time.Minute) // the start itself can be an occurrence
+	}
+	day := midnight(from.In(r.start.Location()))
+	limit := from.Add(horizon)
+	for day.Before(limit) {
+		if r.dayMatches(day) {
+			for _, h := range r.hours {
+				for _, m := range r.minutes {
+					t := time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, day.Location())
+					if !t.After(after) || t.Before(r.start) {
+						continue
+					}
+					if !r.until.IsZero() && t.After(r.until) {
+						return time.Time{}
+					}
+					return t
+				}
+			}
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, day.Location())
+	}
+	return time.Time{}
+}
@@ -0,0 +1,58 @@
+// Package schedule parses recurrence expressions, either five-field cron
+// ("0 9 * * MON") or an iCalendar RRULE ("RRULE:FREQ=WEEKLY;BYDAY=MO;BYHOUR=9"),
+// and computes their upcoming occurrences in local time.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// horizon bounds the search for the next occurrence so impossible
+// expressions such as "0 0 31 2 *" terminate.
+const horizon = 5 * 366 * 24 * time.Hour
+
+// Schedule yields the occurrences of a recurrence.
+type Schedule interface {
+	// Next returns the first occurrence strictly after t, or the zero time
+	// if there is none.
+	Next(t time.Time) time.Time
+}
+
+// Parse reads a cron expression or an RRULE. start anchors an RRULE's
+// INTERVAL and default time of day; cron ignores it.
+func Parse(expr string, start time.Time) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("schedule is empty")
+	}
+	upper := strings.ToUpper(expr)
+	if strings.HasPrefix(upper, "RRULE:") || strings.HasPrefix(upper, "FREQ=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"), start)
+	}
+	return parseCron(expr)
+}
+
+// Upcoming returns up to n occurrences after t.
+func Upcoming(s Schedule, t time.Time, n int) []time.Time {
+	var out []time.Time
+	for len(out) < n {
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// Describe returns a short description of the expression's kind, for
+// display next to it.
+func Describe(expr string) string {
+	upper := strings.ToUpper(strings.TrimSpace(expr))
+	if strings.HasPrefix(upper, "RRULE:") || strings.HasPrefix(upper, "FREQ=") {
+		return "RRULE"
+	}
+	return "cron"
+}
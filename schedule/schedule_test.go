@@ -0,0 +1,71 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func at(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestUpcoming(t *testing.T) {
+	// 2026-10-19 is a Monday
+	start := at("2026-10-01 08:30")
+	cases := []struct {
+		expr string
+		from string
+		want []string
+	}{
+		{"0 9 * * MON", "2026-10-19 09:00", []string{"2026-10-26 09:00", "2026-11-02 09:00"}},
+		{"*/30 22-23 * * *", "2026-10-19 22:10", []string{"2026-10-19 22:30", "2026-10-19 23:00"}},
+		{"0 0 1,15 * *", "2026-10-19 00:00", []string{"2026-11-01 00:00", "2026-11-15 00:00"}},
+		{"@monthly", "2026-12-05 00:00", []string{"2027-01-01 00:00", "2027-02-01 00:00"}},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;BYHOUR=9;BYMINUTE=0", "2026-10-19 10:00", []string{"2026-10-26 09:00", "2026-10-30 09:00"}},
+		{"FREQ=DAILY", "2026-10-19 09:00", []string{"2026-10-20 08:30", "2026-10-21 08:30"}},
+		{"RRULE:FREQ=MONTHLY;BYMONTHDAY=31", "2026-10-19 09:00", []string{"2026-10-31 08:30", "2026-12-31 08:30"}},
+		{"RRULE:FREQ=DAILY;UNTIL=20261020", "2026-10-19 09:00", []string{"2026-10-20 08:30"}},
+		{"FREQ=DAILY", "2026-09-01 00:00", []string{"2026-10-01 08:30", "2026-10-02 08:30"}},
+	}
+	for _, c := range cases {
+		s, err := Parse(c.expr, start)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.expr, err)
+		}
+		got := Upcoming(s, at(c.from), 2)
+		if len(got) != len(c.want) {
+			t.Errorf("%q from %s = %v, want %v", c.expr, c.from, got, c.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].Equal(at(c.want[i])) {
+				t.Errorf("%q from %s [%d] = %s, want %s", c.expr, c.from, i, got[i].Format("2006-01-02 15:04"), c.want[i])
+			}
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"", "0 9 * *", "61 * * * *", "0 9 * * FUNDAY", "5-1 * * * *",
+		"RRULE:FREQ=YEARLY", "RRULE:INTERVAL=2", "RRULE:FREQ=DAILY;BYHOUR=25", "RRULE:FREQ=DAILY;COUNT=3",
+	} {
+		if _, err := Parse(expr, time.Now()); err == nil {
+			t.Errorf("Parse(%q) accepted", expr)
+		}
+	}
+}
+
+func TestImpossibleCron(t *testing.T) {
+	s, err := Parse("0 0 31 2 *", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := s.Next(time.Now()); !next.IsZero() {
+		t.Errorf("Feb 31 = %v, want none", next)
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"embed"
+	"fmt"
 	"log"
 	"log/slog"
 	"net/http"
@@ -15,6 +16,14 @@ import (
 var staticFS embed.FS
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		if err := runReport(context.Background(), os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "report: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	}))
@@ -32,8 +41,11 @@ func main() {
 		}
 	}()
 
+	// Background jobs (trash purge, archiving, automations, recurring tasks)
+	server.StartJobs(ctx)
+
 	mux := server.Routes(staticFS)
-	handler := handlers.LoggingMiddleware(mux)
+	handler := handlers.LoggingMiddleware(server.AutomationMiddleware(mux))
 
 	addr := ":7002"
 	slog.Info("starting server", "addr", addr)
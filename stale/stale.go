@@ -0,0 +1,66 @@
+// Package stale decides when a task has sat in its column for too long.
+package stale
+
+import (
+	"fmt"
+	"time"
+)
+
+// Day is the unit thresholds are configured in.
+const Day = 24 * time.Hour
+
+// Thresholds maps a column to how long a task may stay in it before it is
+// stale. Columns without an entry never go stale.
+type Thresholds map[string]time.Duration
+
+// Age is how long a task has been in its column, against the column's
+// threshold.
+type Age struct {
+	Column    string
+	InColumn  time.Duration
+	Threshold time.Duration // zero when the column has none
+}
+
+// Age measures a task that entered column at entered.
+func (th Thresholds) Age(column string, entered, now time.Time) Age {
+	return Age{Column: column, InColumn: now.Sub(entered), Threshold: th[column]}
+}
+
+// Cutoffs returns, for every column with a threshold, the time before which a
+// task must have entered it to be stale now.
+func (th Thresholds) Cutoffs(now time.Time) map[string]time.Time {
+	cutoffs := make(map[string]time.Time, len(th))
+	for column, threshold := range th {
+		if threshold > 0 {
+			cutoffs[column] = now.Add(-threshold)
+		}
+	}
+	return cutoffs
+}
+
+// Stale reports whether the task has reached its column's threshold.
+func (a Age) Stale() bool {
+	return a.Threshold > 0 && a.InColumn >= a.Threshold
+}
+
+// Level grades how stale a task is: 0 fresh, 1 stale, 2 at twice the
+// threshold or more. Cards fade further with each level.
+func (a Age) Level() int {
+	switch {
+	case !a.Stale():
+		return 0
+	case a.InColumn >= 2*a.Threshold:
+		return 2
+	}
+	return 1
+}
+
+// Days is the number of whole days the task has been in its column.
+func (a Age) Days() int {
+	return int(a.InColumn / Day)
+}
+
+// Label describes the age for a card badge, e.g. "9d in review".
+func (a Age) Label() string {
+	return fmt.Sprintf("%dd in %s", a.Days(), a.Column)
+}
@@ -0,0 +1,43 @@
+package stale
+
+import (
+	"testing"
+	"time"
+)
+
+var now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func TestAge(t *testing.T) {
+	th := Thresholds{"review": 7 * Day}
+	cases := []struct {
+		column string
+		days   float64
+		stale  bool
+		level  int
+	}{
+		{"review", 3, false, 0},
+		{"review", 7, true, 1},
+		{"review", 13.5, true, 1},
+		{"review", 14, true, 2},
+		{"backlog", 100, false, 0},
+	}
+	for _, c := range cases {
+		entered := now.Add(-time.Duration(c.days * float64(Day)))
+		age := th.Age(c.column, entered, now)
+		if age.Stale() != c.stale || age.Level() != c.level {
+			t.Errorf("%s after %vd: stale %v level %d, want %v %d", c.column, c.days, age.Stale(), age.Level(), c.stale, c.level)
+		}
+	}
+
+	if label := th.Age("review", now.Add(-9*Day-time.Hour), now).Label(); label != "9d in review" {
+		t.Errorf("Label = %q", label)
+	}
+}
+
+func TestCutoffs(t *testing.T) {
+	th := Thresholds{"review": 7 * Day, "done": 0}
+	cutoffs := th.Cutoffs(now)
+	if len(cutoffs) != 1 || !cutoffs["review"].Equal(now.Add(-7*Day)) {
+		t.Errorf("Cutoffs = %v", cutoffs)
+	}
+}
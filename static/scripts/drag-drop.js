@@ -113,8 +113,36 @@ async function refreshColumn(columnKey) {
   }
 }
 
-// Send PATCH request to update task column
-async function updateTaskColumn(taskId, newColumn, newPosition, oldColumn) {
+// Show the undo toast returned by a drag-drop request
+function showToast(html) {
+  const container = document.getElementById('toast-container');
+  if (container && html) {
+    container.innerHTML = html;
+  }
+}
+
+// Refresh the column header counts after our own moves; broadcasts of them
+// are ignored by this tab
+async function refreshWipBadges() {
+  try {
+    const response = await fetch('/wip/badges');
+    if (!response.ok) return;
+    const tempDiv = document.createElement('div');
+    tempDiv.innerHTML = await response.text();
+    for (const badge of Array.from(tempDiv.children)) {
+      const oldBadge = document.getElementById(badge.id);
+      if (oldBadge) {
+        oldBadge.replaceWith(badge);
+      }
+    }
+  } catch (error) {
+    console.error('Error refreshing WIP badges:', error);
+  }
+}
+
+// Send PATCH request to update task column. override confirms a move past a
+// soft WIP limit.
+async function updateTaskColumn(taskId, newColumn, newPosition, oldColumn, override = false) {
   try {
     const response = await fetch(`/datastar/tasks/${taskId}/column`, {
       method: 'PATCH',
@@ -124,10 +152,30 @@ async function updateTaskColumn(taskId, newColumn, newPosition, oldColumn) {
       },
       body: JSON.stringify({ 
         column: newColumn,
-        position: newPosition 
+        position: newPosition,
+        override: override
       })
     });
     
+    // Blocked by a WIP limit: ask to confirm a soft block, otherwise put the card back
+    if (response.status === 409) {
+      const mode = response.headers.get('X-WIP-Mode');
+      const html = await response.text();
+      if (mode === 'soft' && !override) {
+        const message = new DOMParser().parseFromString(html, 'text/html').body.textContent.trim();
+        if (confirm(message + '\n\nMove it anyway?')) {
+          return updateTaskColumn(taskId, newColumn, newPosition, oldColumn, true);
+        }
+      } else {
+        showToast(html);
+      }
+      await Promise.all([
+        refreshColumn(oldColumn),
+        refreshColumn(newColumn)
+      ]);
+      return;
+    }
+    
     if (!response.ok) {
       console.error('Failed to update task column:', response.statusText);
       // Don't refresh - let user see the error
@@ -136,6 +184,8 @@ async function updateTaskColumn(taskId, newColumn, newPosition, oldColumn) {
     
     // Success - don't refresh, card is already in place visually
     console.log('Column update successful');
+    showToast(await response.text());
+    refreshWipBadges();
     
   } catch (error) {
     console.error('Error updating task column:', error);
@@ -170,6 +220,7 @@ async function updateTaskPosition(taskId, column, newPosition) {
     
     // Success - don't refresh, card is already in place visually
     console.log('Position update successful');
+    showToast(await response.text());
     
   } catch (error) {
     console.error('Error updating task position:', error);
@@ -0,0 +1,57 @@
+// Presence heartbeats for botTaskTracker
+// Tells the server which task modal this tab has open so other clients can
+// show viewer/editor avatars on cards and in the details modal.
+
+const presenceState = { taskId: 0, editing: false };
+
+function sendPresence() {
+  if (!window.clientNonce) return;
+  fetch('/presence', {
+    method: 'POST',
+    headers: {
+      'Content-Type': 'application/json',
+      'X-Client-Nonce': window.clientNonce
+    },
+    body: JSON.stringify({
+      task_id: presenceState.taskId,
+      editing: presenceState.editing
+    })
+  }).catch(err => console.error('[Presence] Heartbeat failed:', err));
+}
+
+// The server marks a task as open when it renders the details or edit modal,
+// so we only need to track which one is showing and report when it closes.
+const presenceModals = {
+  'task-details-modal': false,
+  'edit-task-modal': true
+};
+
+function modalTaskId(dialog) {
+  const el = dialog.querySelector('[data-task-id]');
+  if (el) return parseInt(el.dataset.taskId, 10) || 0;
+  return 0;
+}
+
+// dialog "close" doesn't bubble, so listen in the capture phase
+document.addEventListener('close', (evt) => {
+  const dialog = evt.target;
+  if (!(dialog instanceof HTMLDialogElement) || !(dialog.id in presenceModals)) return;
+  if (presenceState.taskId === 0) return;
+  presenceState.taskId = 0;
+  presenceState.editing = false;
+  sendPresence();
+}, true);
+
+// Track modals as they are shown
+new MutationObserver(() => {
+  for (const id in presenceModals) {
+    const dialog = document.getElementById(id);
+    if (dialog && dialog.open) {
+      presenceState.taskId = modalTaskId(dialog);
+      presenceState.editing = presenceModals[id];
+    }
+  }
+}).observe(document.documentElement, { subtree: true, attributes: true, attributeFilter: ['open'] });
+
+// Heartbeat keeps our entry alive on the server
+setInterval(sendPresence, 15000);
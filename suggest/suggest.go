@@ -0,0 +1,100 @@
+// Package suggest ranks autocomplete candidates by how often and how recently
+// they were used.
+package suggest
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// halfLife is how long it takes for the recency boost of a use to halve.
+const halfLife = 14 * 24 * time.Hour
+
+// Candidate is a value that can be suggested, e.g. a tag key or an assignee.
+type Candidate struct {
+	Value    string    `json:"value"`
+	Count    int       `json:"count"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// Tally collects candidates from individual uses.
+type Tally map[string]*Candidate
+
+// Add records one use of value at t.
+func (t Tally) Add(value string, at time.Time) {
+	c := t.entry(value)
+	c.Count++
+	if at.After(c.LastUsed) {
+		c.LastUsed = at
+	}
+}
+
+// AddKnown makes value a candidate even if it has never been used, e.g. a
+// registered tag key no task has yet.
+func (t Tally) AddKnown(value string) {
+	t.entry(value)
+}
+
+func (t Tally) entry(value string) *Candidate {
+	c, ok := t[value]
+	if !ok {
+		c = &Candidate{Value: value}
+		t[value] = c
+	}
+	return c
+}
+
+// Score weighs the number of uses by how recent the last one was: a value used
+// today counts double, one last used a half-life ago counts one and a half.
+func (c Candidate) Score(now time.Time) float64 {
+	if c.Count == 0 {
+		return 0
+	}
+	age := now.Sub(c.LastUsed)
+	if age < 0 {
+		age = 0
+	}
+	return float64(c.Count) * (1 + math.Exp2(-float64(age)/float64(halfLife)))
+}
+
+// Rank returns up to limit candidates containing query, case-insensitively.
+// Prefix matches come before other matches, then higher scores, then
+// alphabetical order. A limit of 0 or less means no limit.
+func (t Tally) Rank(query string, now time.Time, limit int) []Candidate {
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	type ranked struct {
+		Candidate
+		prefix bool
+		score  float64
+	}
+	var matches []ranked
+	for _, c := range t {
+		value := strings.ToLower(c.Value)
+		if !strings.Contains(value, query) {
+			continue
+		}
+		matches = append(matches, ranked{*c, strings.HasPrefix(value, query), c.Score(now)})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.prefix != b.prefix {
+			return a.prefix
+		}
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		return a.Value < b.Value
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	result := make([]Candidate, len(matches))
+	for i, m := range matches {
+		result[i] = m.Candidate
+	}
+	return result
+}
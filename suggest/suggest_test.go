@@ -0,0 +1,57 @@
+package suggest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRank(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tally := Tally{}
+	for i := 0; i < 5; i++ {
+		tally.Add("backend", now.AddDate(0, -6, 0))
+	}
+	for i := 0; i < 4; i++ {
+		tally.Add("frontend", now.Add(-time.Hour))
+	}
+	tally.Add("bugfix", now)
+	tally.AddKnown("billing")
+
+	got := values(tally.Rank("", now, 0))
+	want := []string{"frontend", "backend", "bugfix", "billing"}
+	if !equal(got, want) {
+		t.Errorf("Rank(\"\") = %v, want %v", got, want)
+	}
+
+	// prefix matches beat more frequent substring matches
+	got = values(tally.Rank("B", now, 0))
+	want = []string{"backend", "bugfix", "billing"}
+	if !equal(got, want) {
+		t.Errorf("Rank(\"B\") = %v, want %v", got, want)
+	}
+
+	got = values(tally.Rank("end", now, 1))
+	if !equal(got, []string{"frontend"}) {
+		t.Errorf("Rank(\"end\", limit 1) = %v", got)
+	}
+}
+
+func values(cs []Candidate) []string {
+	out := make([]string, len(cs))
+	for i, c := range cs {
+		out[i] = c.Value
+	}
+	return out
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,158 @@
+package tagregistry
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Retag actions.
+const (
+	ActionRename = "rename"
+	ActionMerge  = "merge"
+	ActionDelete = "delete"
+)
+
+// Pattern matches task tags. Without a value it matches every value of Key.
+type Pattern struct {
+	Key      string
+	Value    string
+	AnyValue bool
+}
+
+// ParsePattern parses "key" (any value) or "key:value". "key:" matches only
+// the empty value.
+func ParsePattern(s string) (Pattern, error) {
+	s = strings.TrimSpace(s)
+	key, value, hasValue := strings.Cut(s, ":")
+	if key == "" {
+		return Pattern{}, fmt.Errorf("invalid tag %q", s)
+	}
+	return Pattern{Key: key, Value: value, AnyValue: !hasValue}, nil
+}
+
+func (p Pattern) String() string {
+	if p.AnyValue {
+		return p.Key
+	}
+	return p.Key + ":" + p.Value
+}
+
+func (p Pattern) matches(t Tag) bool {
+	return t.Key == p.Key && (p.AnyValue || t.Value == p.Value)
+}
+
+// Retag renames, merges or deletes tags across tasks. Rename and merge replace
+// every tag matching a source with the target; a target without a value keeps
+// the value of the tag it replaces.
+type Retag struct {
+	Action  string
+	Sources []Pattern
+	Target  Pattern
+}
+
+// ParseRetag builds a retag from user input: a list of source tags and, for
+// rename and merge, a target.
+func ParseRetag(action string, sources []string, target string) (Retag, error) {
+	rt := Retag{Action: action}
+	for _, s := range sources {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		p, err := ParsePattern(s)
+		if err != nil {
+			return Retag{}, err
+		}
+		rt.Sources = append(rt.Sources, p)
+	}
+	if len(rt.Sources) == 0 {
+		return Retag{}, errors.New("no tags selected")
+	}
+
+	switch action {
+	case ActionDelete:
+		return rt, nil
+	case ActionRename:
+		if len(rt.Sources) != 1 {
+			return Retag{}, errors.New("rename takes exactly one tag, use merge for several")
+		}
+	case ActionMerge:
+	default:
+		return Retag{}, fmt.Errorf("unknown action %q", action)
+	}
+
+	p, err := ParsePattern(target)
+	if err != nil {
+		return Retag{}, fmt.Errorf("invalid target: %w", err)
+	}
+	if !keyPattern.MatchString(p.Key) {
+		return Retag{}, fmt.Errorf("target key %q must start with a letter and contain only letters, digits, - and _", p.Key)
+	}
+	rt.Target = p
+	return rt, nil
+}
+
+// Describe summarises the retag for history entries.
+func (rt Retag) Describe() string {
+	sources := make([]string, len(rt.Sources))
+	for i, p := range rt.Sources {
+		sources[i] = p.String()
+	}
+	switch rt.Action {
+	case ActionDelete:
+		return "removed tag " + strings.Join(sources, ", ")
+	case ActionRename:
+		return "renamed tag " + sources[0] + " to " + rt.Target.String()
+	}
+	return "merged tags " + strings.Join(sources, ", ") + " into " + rt.Target.String()
+}
+
+// Apply returns the tags after the retag and whether any tag matched.
+// Duplicates, such as those created by a merge, are collapsed.
+func (rt Retag) Apply(tags []Tag) ([]Tag, bool) {
+	out := make([]Tag, 0, len(tags))
+	seen := make(map[Tag]bool, len(tags))
+	changed := false
+	for _, t := range tags {
+		if rt.matchesAny(t) {
+			changed = true
+			if rt.Action == ActionDelete {
+				continue
+			}
+			t.Key = rt.Target.Key
+			if !rt.Target.AnyValue {
+				t.Value = rt.Target.Value
+			}
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out, changed
+}
+
+func (rt Retag) matchesAny(t Tag) bool {
+	for _, p := range rt.Sources {
+		if p.matches(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Usage counts how many tags carry a key:value pair.
+type Usage struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// TaskChange is the effect of a retag on one task.
+type TaskChange struct {
+	TaskID int      `json:"task_id"`
+	Title  string   `json:"title"`
+	Before []string `json:"before"`
+	After  []string `json:"after"`
+}
@@ -0,0 +1,56 @@
+package tagregistry
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRetagApply(t *testing.T) {
+	tags := []Tag{{"type", "Bug"}, {"bug", "true"}, {"type", "bug"}, {"project", "api"}}
+
+	cases := []struct {
+		action  string
+		sources []string
+		target  string
+		want    []Tag
+	}{
+		{ActionMerge, []string{"type:Bug", "bug:true"}, "type:bug", []Tag{{"type", "bug"}, {"project", "api"}}},
+		{ActionRename, []string{"project"}, "area", []Tag{{"type", "Bug"}, {"bug", "true"}, {"type", "bug"}, {"area", "api"}}},
+		{ActionRename, []string{"project:api"}, "project:backend", []Tag{{"type", "Bug"}, {"bug", "true"}, {"type", "bug"}, {"project", "backend"}}},
+		{ActionDelete, []string{"type"}, "", []Tag{{"bug", "true"}, {"project", "api"}}},
+	}
+	for _, c := range cases {
+		rt, err := ParseRetag(c.action, c.sources, c.target)
+		if err != nil {
+			t.Fatalf("ParseRetag(%s %v %q): %v", c.action, c.sources, c.target, err)
+		}
+		got, changed := rt.Apply(tags)
+		if !changed || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v (changed %v), want %v", rt.Describe(), got, changed, c.want)
+		}
+	}
+
+	rt, _ := ParseRetag(ActionDelete, []string{"missing"}, "")
+	if _, changed := rt.Apply(tags); changed {
+		t.Error("retag without matches reported a change")
+	}
+}
+
+func TestParseRetag(t *testing.T) {
+	bad := []struct {
+		action  string
+		sources []string
+		target  string
+	}{
+		{ActionRename, []string{"a", "b"}, "c"},
+		{ActionMerge, []string{"a"}, ""},
+		{ActionMerge, nil, "c"},
+		{"shuffle", []string{"a"}, "c"},
+		{ActionRename, []string{"a"}, "9x"},
+	}
+	for _, b := range bad {
+		if _, err := ParseRetag(b.action, b.sources, b.target); err == nil {
+			t.Errorf("ParseRetag(%s %v %q) accepted", b.action, b.sources, b.target)
+		}
+	}
+}
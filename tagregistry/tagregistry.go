@@ -0,0 +1,174 @@
+// Package tagregistry describes the tag keys a board accepts and validates
+// tag writes against them.
+package tagregistry
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Colors are the daisyUI badge variants a tag key can be shown with.
+var Colors = []string{"neutral", "primary", "secondary", "accent", "info", "success", "warning", "error"}
+
+// BuiltinKeys are handled by task fields rather than tags. They are never
+// valid tags but are offered as suggestions for typos such as "priorty".
+var BuiltinKeys = []string{"priority"}
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// Definition describes one tag key.
+type Definition struct {
+	Key           string
+	DisplayName   string
+	Emoji         string
+	Color         string
+	AllowedValues []string // empty means free text
+	MultiValued   bool
+}
+
+// Name is the display name, falling back to the key.
+func (d Definition) Name() string {
+	if d.DisplayName != "" {
+		return d.DisplayName
+	}
+	return d.Key
+}
+
+// Check reports whether the definition itself is usable.
+func (d Definition) Check() error {
+	if !keyPattern.MatchString(d.Key) {
+		return fmt.Errorf("key %q must start with a letter and contain only letters, digits, - and _", d.Key)
+	}
+	for _, builtin := range BuiltinKeys {
+		if d.Key == builtin {
+			return fmt.Errorf("%q is a task field, not a tag", d.Key)
+		}
+	}
+	if d.Color != "" && !contains(Colors, d.Color) {
+		return fmt.Errorf("unknown color %q", d.Color)
+	}
+	return nil
+}
+
+// Allows reports whether value is permitted for the key.
+func (d Definition) Allows(value string) bool {
+	return len(d.AllowedValues) == 0 || contains(d.AllowedValues, value)
+}
+
+// Tag is a key:value pair being written to a task.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Registry is the ordered set of tag definitions. Order decides which tag
+// provides a card's category badge.
+type Registry []Definition
+
+// Lookup finds the definition of a key.
+func (r Registry) Lookup(key string) (Definition, bool) {
+	for _, d := range r {
+		if d.Key == key {
+			return d, true
+		}
+	}
+	return Definition{}, false
+}
+
+// Category returns the first definition, in registry order, that has an
+// emoji and names one of the tags by key or by value, so both bug:login and
+// type:bug show the bug category.
+func (r Registry) Category(tags []Tag) (Definition, bool) {
+	for _, d := range r {
+		if d.Emoji == "" {
+			continue
+		}
+		for _, tag := range tags {
+			if tag.Key == d.Key || tag.Value == d.Key {
+				return d, true
+			}
+		}
+	}
+	return Definition{}, false
+}
+
+// Validate checks tags against the registry: keys must be defined, values
+// allowed, and single-valued keys used at most once. An empty registry
+// accepts anything.
+func (r Registry) Validate(tags []Tag) error {
+	if len(r) == 0 {
+		return nil
+	}
+
+	var errs []error
+	seen := make(map[string]int)
+	for _, tag := range tags {
+		d, ok := r.Lookup(tag.Key)
+		if !ok {
+			if s := r.Suggest(tag.Key); s != "" {
+				errs = append(errs, fmt.Errorf("unknown tag %q (did you mean %q?)", tag.Key, s))
+			} else {
+				errs = append(errs, fmt.Errorf("unknown tag %q", tag.Key))
+			}
+			continue
+		}
+		if !d.Allows(tag.Value) {
+			errs = append(errs, fmt.Errorf("%s must be one of %s", tag.Key, strings.Join(d.AllowedValues, ", ")))
+			continue
+		}
+		seen[tag.Key]++
+		if seen[tag.Key] == 2 && !d.MultiValued {
+			errs = append(errs, fmt.Errorf("%s can only have one value", tag.Key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Suggest returns the registered or builtin key closest to an unknown key, or
+// "" when nothing is within two edits.
+func (r Registry) Suggest(key string) string {
+	candidates := append([]string(nil), BuiltinKeys...)
+	for _, d := range r {
+		candidates = append(candidates, d.Key)
+	}
+
+	best, bestDist := "", 3
+	for _, c := range candidates {
+		if dist := levenshtein(strings.ToLower(key), strings.ToLower(c)); dist < bestDist {
+			best, bestDist = c, dist
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package tagregistry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	reg := Registry{
+		{Key: "type", AllowedValues: []string{"bug", "feature"}},
+		{Key: "project", MultiValued: true},
+	}
+
+	if err := reg.Validate([]Tag{{"type", "bug"}, {"project", "a"}, {"project", "b"}}); err != nil {
+		t.Fatalf("valid tags rejected: %v", err)
+	}
+
+	cases := map[string][]Tag{
+		`did you mean "priority"`: {{"priorty", "high"}},
+		`did you mean "type"`:     {{"tpye", "bug"}},
+		"must be one of":          {{"type", "chore"}},
+		"only have one value":     {{"type", "bug"}, {"type", "feature"}},
+		`unknown tag "zzz"`:       {{"zzz", "1"}},
+	}
+	for want, tags := range cases {
+		err := reg.Validate(tags)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate(%v) = %v, want error containing %q", tags, err, want)
+		}
+	}
+
+	if err := (Registry{}).Validate([]Tag{{"anything", "goes"}}); err != nil {
+		t.Errorf("empty registry should accept anything, got %v", err)
+	}
+}
+
+func TestCategory(t *testing.T) {
+	reg := Registry{
+		{Key: "type", AllowedValues: []string{"bug", "feature"}},
+		{Key: "auth", Emoji: "🔐"},
+		{Key: "bug", Emoji: "🐛"},
+		{Key: "area"},
+	}
+
+	cases := []struct {
+		tags []Tag
+		want string // "" for no category
+	}{
+		{[]Tag{{"bug", "login"}}, "bug"},
+		{[]Tag{{"type", "bug"}}, "bug"},
+		{[]Tag{{"area", "auth"}}, "auth"},
+		{[]Tag{{"type", "bug"}, {"area", "auth"}}, "auth"}, // registry order wins
+		{[]Tag{{"type", "feature"}}, ""},
+		{nil, ""},
+	}
+	for _, c := range cases {
+		d, ok := reg.Category(c.tags)
+		if ok != (c.want != "") || d.Key != c.want {
+			t.Errorf("Category(%v) = %q, %v, want %q", c.tags, d.Key, ok, c.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package wip checks work-in-progress limits on board columns.
+package wip
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Mode is how a limit is enforced once it is exceeded.
+type Mode string
+
+const (
+	// Warn allows the change and highlights the column.
+	Warn Mode = "warn"
+	// SoftBlock asks for confirmation before allowing the change.
+	SoftBlock Mode = "soft"
+	// HardBlock rejects the change.
+	HardBlock Mode = "hard"
+)
+
+// Modes lists the enforcement modes from least to most strict.
+var Modes = []Mode{Warn, SoftBlock, HardBlock}
+
+func (m Mode) severity() int {
+	switch m {
+	case SoftBlock:
+		return 1
+	case HardBlock:
+		return 2
+	}
+	return 0
+}
+
+// Label describes the mode in the UI.
+func (m Mode) Label() string {
+	switch m {
+	case SoftBlock:
+		return "Soft block (confirm)"
+	case HardBlock:
+		return "Hard block"
+	}
+	return "Warn"
+}
+
+// EachAssignee as a limit's assignee applies the limit to every assignee
+// separately.
+const EachAssignee = "*"
+
+// Limit caps the number of tasks in a column. With an empty Assignee it
+// counts the whole column, otherwise only tasks of that assignee (or of each
+// assignee, for EachAssignee).
+type Limit struct {
+	Column   string
+	Assignee string
+	Max      int
+	Mode     Mode
+}
+
+// Scope describes who the limit counts.
+func (l Limit) Scope() string {
+	switch l.Assignee {
+	case "":
+		return "whole column"
+	case EachAssignee:
+		return "each assignee"
+	}
+	return l.Assignee
+}
+
+// Breach is a limit a change would exceed.
+type Breach struct {
+	Limit    Limit
+	Assignee string // set for per-assignee limits
+	Count    int    // tasks counted after the change
+}
+
+func (b Breach) Error() string {
+	if b.Assignee == "" {
+		return fmt.Sprintf("WIP limit for %s is %d, this would make %d", b.Limit.Column, b.Limit.Max, b.Count)
+	}
+	return fmt.Sprintf("WIP limit for %s in %s is %d, this would make %d", b.Assignee, b.Limit.Column, b.Limit.Max, b.Count)
+}
+
+// Blocks reports whether the breach stops the change. Soft blocks can be
+// overridden once the user has confirmed.
+func (b Breach) Blocks(override bool) bool {
+	return b.Limit.Mode == HardBlock || (b.Limit.Mode == SoftBlock && !override)
+}
+
+// Check returns the most severe limit a task would exceed by entering column
+// with assignee. columnCount and assigneeCount are the counts including the
+// task; assigneeCount is ignored for unassigned tasks.
+func Check(limits []Limit, column, assignee string, columnCount, assigneeCount int) (Breach, bool) {
+	var worst Breach
+	found := false
+	for _, l := range limits {
+		if l.Column != column {
+			continue
+		}
+		b := Breach{Limit: l, Count: columnCount}
+		if l.Assignee != "" {
+			if assignee == "" || (l.Assignee != EachAssignee && l.Assignee != assignee) {
+				continue
+			}
+			b = Breach{Limit: l, Assignee: assignee, Count: assigneeCount}
+		}
+		if b.Count <= l.Max {
+			continue
+		}
+		if !found || l.Mode.severity() > worst.Limit.Mode.severity() {
+			worst, found = b, true
+		}
+	}
+	return worst, found
+}
+
+// ColumnLimit returns the whole-column limit of a column, if any.
+func ColumnLimit(limits []Limit, column string) (Limit, bool) {
+	for _, l := range limits {
+		if l.Column == column && l.Assignee == "" {
+			return l, true
+		}
+	}
+	return Limit{}, false
+}
+
+// Usage formats a column count against its limit, e.g. "4/3".
+func Usage(count int, l Limit) string {
+	return strconv.Itoa(count) + "/" + strconv.Itoa(l.Max)
+}
@@ -0,0 +1,52 @@
+package wip
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	limits := []Limit{
+		{Column: "in_progress", Max: 3, Mode: Warn},
+		{Column: "in_progress", Assignee: EachAssignee, Max: 1, Mode: SoftBlock},
+		{Column: "in_progress", Assignee: "bot", Max: 5, Mode: HardBlock},
+		{Column: "review", Max: 2, Mode: HardBlock},
+	}
+
+	cases := []struct {
+		name          string
+		column        string
+		assignee      string
+		columnCount   int
+		assigneeCount int
+		want          Mode
+		breached      bool
+	}{
+		{"under every limit", "in_progress", "john", 2, 1, "", false},
+		{"column over", "in_progress", "", 4, 0, Warn, true},
+		{"assignee over beats column", "in_progress", "john", 4, 2, SoftBlock, true},
+		{"named assignee is most severe", "in_progress", "bot", 7, 6, HardBlock, true},
+		{"other column untouched", "backlog", "john", 50, 50, "", false},
+		{"hard block", "review", "", 3, 0, HardBlock, true},
+	}
+	for _, c := range cases {
+		b, ok := Check(limits, c.column, c.assignee, c.columnCount, c.assigneeCount)
+		if ok != c.breached || b.Limit.Mode != c.want {
+			t.Errorf("%s: got %v %v, want %v %v", c.name, b.Limit.Mode, ok, c.want, c.breached)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	for _, c := range []struct {
+		mode     Mode
+		override bool
+		want     bool
+	}{
+		{Warn, false, false},
+		{SoftBlock, false, true},
+		{SoftBlock, true, false},
+		{HardBlock, true, true},
+	} {
+		if got := (Breach{Limit: Limit{Mode: c.mode}}).Blocks(c.override); got != c.want {
+			t.Errorf("%s override=%v: Blocks = %v, want %v", c.mode, c.override, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Package workflow decides which column moves are allowed and what a task
+// needs before it may make them.
+package workflow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Any as a rule's From matches every source column.
+const Any = "*"
+
+// Guard kinds.
+const (
+	// GuardTag requires a tag: "tag:pr" for any value, "tag:pr:merged" for one.
+	GuardTag = "tag"
+	// GuardDescription requires a non-empty description.
+	GuardDescription = "description"
+	// GuardAssignee requires the task to be assigned.
+	GuardAssignee = "assignee"
+	// GuardHumans rejects moves unless a person is known to be making them.
+	GuardHumans = "humans"
+)
+
+// Guard is a condition a task must meet to make a move.
+type Guard struct {
+	Kind  string
+	Key   string // GuardTag only
+	Value string // GuardTag only, empty for any value
+}
+
+// ParseGuard parses the stored form of a guard, e.g. "tag:pr" or "humans".
+func ParseGuard(s string) (Guard, error) {
+	s = strings.TrimSpace(s)
+	kind, arg, _ := strings.Cut(s, ":")
+	switch kind {
+	case GuardDescription, GuardAssignee, GuardHumans:
+		if arg != "" {
+			return Guard{}, fmt.Errorf("guard %q takes no argument", kind)
+		}
+		return Guard{Kind: kind}, nil
+	case GuardTag:
+		key, value, _ := strings.Cut(arg, ":")
+		if key == "" {
+			return Guard{}, fmt.Errorf("guard %q needs a tag key, e.g. tag:pr", s)
+		}
+		return Guard{Kind: kind, Key: key, Value: value}, nil
+	}
+	return Guard{}, fmt.Errorf("unknown guard %q", s)
+}
+
+func (g Guard) String() string {
+	if g.Kind != GuardTag {
+		return g.Kind
+	}
+	if g.Value == "" {
+		return GuardTag + ":" + g.Key
+	}
+	return GuardTag + ":" + g.Key + ":" + g.Value
+}
+
+// Subject is what guards are checked against: the task and who moves it.
+type Subject struct {
+	Description string
+	Assignee    string
+	Tags        []string // "key:value" pairs
+	Actor       string
+	// ActorIsHuman is set only when the caller is known to be a person, so
+	// a humans guard fails closed for bots and unidentified callers alike.
+	ActorIsHuman bool
+}
+
+// check returns why the subject fails the guard, or "".
+func (g Guard) check(to string, s Subject) string {
+	switch g.Kind {
+	case GuardTag:
+		for _, tag := range s.Tags {
+			key, value, _ := strings.Cut(tag, ":")
+			if key == g.Key && (g.Value == "" || value == g.Value) {
+				return ""
+			}
+		}
+		if g.Value == "" {
+			return fmt.Sprintf("%s requires a %s tag", to, g.Key)
+		}
+		return fmt.Sprintf("%s requires the tag %s:%s", to, g.Key, g.Value)
+	case GuardDescription:
+		if strings.TrimSpace(s.Description) == "" {
+			return fmt.Sprintf("%s requires a description", to)
+		}
+	case GuardAssignee:
+		if s.Assignee == "" {
+			return fmt.Sprintf("%s requires an assignee", to)
+		}
+	case GuardHumans:
+		if !s.ActorIsHuman {
+			return fmt.Sprintf("only humans may move tasks to %s", to)
+		}
+	}
+	return ""
+}
+
+// Rule allows moves from one column (or Any) to another, provided the guards
+// pass.
+type Rule struct {
+	From   string
+	To     string
+	Guards []Guard
+}
+
+// Rejection explains why a move isn't allowed.
+type Rejection struct {
+	From, To string
+	Reason   string
+}
+
+func (r *Rejection) Error() string {
+	return r.Reason
+}
+
+// Check reports whether a task may move between columns. With no rules every
+// move is allowed. Otherwise a rule must allow the move and the guards of
+// every matching rule must pass. Staying in the same column is always allowed.
+func Check(rules []Rule, from, to string, s Subject) error {
+	if len(rules) == 0 || from == to {
+		return nil
+	}
+	allowed := false
+	for _, rule := range rules {
+		if rule.To != to || (rule.From != from && rule.From != Any) {
+			continue
+		}
+		allowed = true
+		for _, g := range rule.Guards {
+			if reason := g.check(to, s); reason != "" {
+				return &Rejection{From: from, To: to, Reason: reason}
+			}
+		}
+	}
+	if !allowed {
+		return &Rejection{From: from, To: to, Reason: fmt.Sprintf("moving from %s to %s is not allowed", from, to)}
+	}
+	return nil
+}
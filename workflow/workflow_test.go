@@ -0,0 +1,67 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	mustGuard := func(s string) Guard {
+		g, err := ParseGuard(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return g
+	}
+	rules := []Rule{
+		{From: "backlog", To: "in_progress"},
+		{From: "in_progress", To: "review", Guards: []Guard{mustGuard("tag:pr")}},
+		{From: "review", To: "done", Guards: []Guard{mustGuard("description")}},
+		{From: Any, To: "done", Guards: []Guard{mustGuard("humans")}},
+		{From: Any, To: "backlog"},
+	}
+	ready := Subject{Description: "ship it", Tags: []string{"pr:42"}, Actor: "peter", ActorIsHuman: true}
+
+	cases := []struct {
+		name     string
+		from, to string
+		subject  Subject
+		want     string // substring of the rejection, "" if allowed
+	}{
+		{"allowed", "backlog", "in_progress", Subject{}, ""},
+		{"not in graph", "backlog", "review", ready, "not allowed"},
+		{"same column", "review", "review", Subject{}, ""},
+		{"missing tag", "in_progress", "review", Subject{}, "requires a pr tag"},
+		{"tag present", "in_progress", "review", ready, ""},
+		{"empty description", "review", "done", Subject{Description: " "}, "requires a description"},
+		{"wildcard guard applies", "review", "done", Subject{Description: "x", Actor: "deploy-bot"}, "only humans"},
+		{"unknown caller", "review", "done", Subject{Description: "x"}, "only humans"},
+		{"human to done", "review", "done", ready, ""},
+		{"wildcard source", "done", "backlog", Subject{}, ""},
+	}
+	for _, c := range cases {
+		err := Check(rules, c.from, c.to, c.subject)
+		switch {
+		case c.want == "" && err != nil:
+			t.Errorf("%s: unexpected rejection %v", c.name, err)
+		case c.want != "" && (err == nil || !strings.Contains(err.Error(), c.want)):
+			t.Errorf("%s: got %v, want rejection containing %q", c.name, err, c.want)
+		}
+	}
+
+	if err := Check(nil, "backlog", "done", Subject{}); err != nil {
+		t.Errorf("no rules should allow every move, got %v", err)
+	}
+}
+
+func TestParseGuard(t *testing.T) {
+	g, err := ParseGuard("tag:pr:merged")
+	if err != nil || g.Key != "pr" || g.Value != "merged" || g.String() != "tag:pr:merged" {
+		t.Errorf("ParseGuard(tag:pr:merged) = %+v, %v", g, err)
+	}
+	for _, bad := range []string{"tag", "tag:", "humans:yes", "reviewed"} {
+		if _, err := ParseGuard(bad); err == nil {
+			t.Errorf("ParseGuard(%q) accepted", bad)
+		}
+	}
+}